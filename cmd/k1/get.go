@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/renato0307/k1/internal/headless"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/screens"
+)
+
+// runGet runs `k1 get <resource> [flags]`: lists a resource through its
+// screen without the TUI and prints it to stdout. Returns the exit code.
+func runGet(args []string) int {
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: k1 get <resource> [-n namespace] [-filter expr] [-o table|json|yaml|csv] [-context name]...")
+		fmt.Fprintln(os.Stderr)
+		fs.PrintDefaults()
+	}
+
+	var namespace string
+	fs.StringVar(&namespace, "n", "", "Namespace (default: all namespaces)")
+	fs.StringVar(&namespace, "namespace", "", "Namespace (default: all namespaces)")
+	filter := fs.String("filter", "", "Screen filter: fuzzy search or column comparisons (e.g. 'restarts>3 status!=Running')")
+	var output string
+	fs.StringVar(&output, "o", headless.FormatTable, "Output format ("+strings.Join(headless.Formats, ", ")+")")
+	fs.StringVar(&output, "output", headless.FormatTable, "Output format ("+strings.Join(headless.Formats, ", ")+")")
+	kubeconfigFlag := fs.String("kubeconfig", "", "Path to kubeconfig file (default: $HOME/.kube/config)")
+	var contexts contextList
+	fs.Var(&contexts, "context", "Kubernetes context (can be specified multiple times, rows get a Context column)")
+	timeout := fs.Duration("timeout", k8s.InformerSyncTimeout, "Maximum time to wait for the cache to sync")
+
+	// The resource may come before or after the flags
+	resource := ""
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		resource, args = args[0], args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if resource == "" {
+		resource = fs.Arg(0)
+	}
+	if resource == "" {
+		fs.Usage()
+		return 2
+	}
+	if _, ok := screens.GetListScreenConfig(resource); !ok {
+		fmt.Fprintf(os.Stderr, "Error: unknown resource type %q\n", resource)
+		return 2
+	}
+	if !slices.Contains(headless.Formats, output) {
+		fmt.Fprintf(os.Stderr, "Error: unknown output format %q (use %s)\n", output, strings.Join(headless.Formats, ", "))
+		return 2
+	}
+
+	kubeconfig := *kubeconfigFlag
+	if kubeconfig == "" {
+		if home := os.Getenv("HOME"); home != "" {
+			kubeconfig = filepath.Join(home, ".kube", "config")
+		}
+	}
+	if len(contexts) == 0 {
+		currentCtx, err := k8s.GetCurrentContext(kubeconfig)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		contexts = contextList{currentCtx}
+	}
+
+	pool, err := k8s.NewRepositoryPool(kubeconfig, len(contexts))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing pool: %v\n", err)
+		return 1
+	}
+	defer pool.Close()
+
+	for _, contextName := range contexts {
+		if err := pool.LoadContext(contextName, nil); err != nil {
+			fmt.Fprintf(os.Stderr, "Error connecting to context %s: %v\n", contextName, err)
+			return 1
+		}
+	}
+	if err := pool.SetActive(contexts[0]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error setting active context: %v\n", err)
+		return 1
+	}
+	if len(contexts) > 1 {
+		// Same merged list as the TUI multi-context mode
+		if err := pool.SetAggregated(true, contexts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	result, err := headless.Run(ctx, pool, headless.Query{
+		Resource:  resource,
+		Namespace: namespace,
+		Filter:    *filter,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if err := headless.Write(os.Stdout, result, output); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -12,7 +12,11 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/renato0307/k1/internal/app"
+	"github.com/renato0307/k1/internal/audit"
+	"github.com/renato0307/k1/internal/config"
+	"github.com/renato0307/k1/internal/history"
 	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/llm"
 	"github.com/renato0307/k1/internal/logging"
 	"github.com/renato0307/k1/internal/types"
 	"github.com/renato0307/k1/internal/ui"
@@ -43,11 +47,29 @@ func main() {
 	flag.Set("stderrthreshold", "FATAL") // Only show FATAL errors
 	flag.Set("v", "0")                   // Minimum verbosity
 
+	// Headless query mode (no TUI): k1 get <resource> [flags]
+	if len(os.Args) > 1 && os.Args[1] == "get" {
+		os.Exit(runGet(os.Args[2:]))
+	}
+
+	// Snapshot capture (no TUI): k1 snapshot -o <file> [flags]
+	if len(os.Args) > 1 && os.Args[1] == "snapshot" {
+		os.Exit(runSnapshot(os.Args[2:]))
+	}
+
 	// Parse flags
 	themeFlag := flag.String("theme", "charm", "Theme to use (dark: charm, dracula, catppuccin, nord, gruvbox, tokyo-night, solarized, monokai | light: catppuccin-latte, solarized-light, gruvbox-light)")
 	kubeconfigFlag := flag.String("kubeconfig", "", "Path to kubeconfig file (default: $HOME/.kube/config)")
 	maxContexts := flag.Int("max-contexts", 10, "Maximum number of contexts to keep loaded (1-20)")
 	flag.Var(&contextFlags, "context", "Kubernetes context to use (can be specified multiple times)")
+	configFlag := flag.String("config", config.DefaultPath(), "Path to k1 config file")
+	readOnlyFlag := flag.Bool("readonly", false, "Read-only mode: hide and refuse commands that change the cluster")
+	objectHistoryFlag := flag.Bool("object-history", false, "Record the versions of objects that change during the session (see /history)")
+	snapshotFlag := flag.String("snapshot", "", "Browse a snapshot file captured with 'k1 snapshot' instead of a cluster (read-only)")
+
+	// LLM flags (override the llm section of the config file)
+	llmEndpoint := flag.String("llm-endpoint", "", "OpenAI-compatible API base URL for /ai commands (e.g. http://localhost:11434/v1)")
+	llmModel := flag.String("llm-model", "", "Model used for /ai commands")
 
 	// Logging flags
 	logFile := flag.String("log-file", "", "Path to log file (empty = no logging)")
@@ -81,10 +103,59 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Load config file
+	cfg, err := config.Load(*configFlag)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	if *readOnlyFlag {
+		cfg.ReadOnly = true
+	}
+	if *objectHistoryFlag {
+		cfg.ObjectHistory.Enabled = true
+	}
+	llmProvider, err := newLLMProvider(cfg.LLM, *llmEndpoint, *llmModel)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Open audit log of mutating operations
+	auditLog, err := audit.Open(audit.Config{
+		FilePath:   cfg.Audit.AuditPath(),
+		MaxSizeMB:  cfg.Audit.MaxSizeMB,
+		MaxBackups: cfg.Audit.MaxBackups,
+	})
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer auditLog.Close()
+
+	// Command and output history persisted per context
+	var historyStore *history.Store
+	if stateDir := config.StateDir(); stateDir != "" {
+		historyStore = history.NewStore(filepath.Join(stateDir, "history"))
+	}
+
 	// Load theme
 	theme := ui.GetTheme(*themeFlag)
 	logging.Debug("Config loaded", "duration", time.Since(startTime).String(), "ms", time.Since(startTime).Milliseconds())
 
+	// Browse a snapshot offline (no cluster access, nothing can be changed)
+	if *snapshotFlag != "" {
+		pool, err := openSnapshot(*snapshotFlag)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		defer pool.Close()
+		cfg.ReadOnly = true
+		runUI(pool, theme, cfg, llmProvider, auditLog, historyStore, nil)
+		return
+	}
+
 	// Check if kubectl is available (needed for resource commands)
 	if err := checkKubectlAvailable(); err != nil {
 		fmt.Printf("Warning: %v\n", err)
@@ -126,6 +197,12 @@ func main() {
 		os.Exit(1)
 	}
 	defer pool.Close()
+	if cfg.ObjectHistory.Enabled {
+		pool.SetObjectHistory(k8s.ObjectHistoryOptions{
+			MaxVersions: cfg.ObjectHistory.MaxVersions,
+			MaxObjects:  cfg.ObjectHistory.MaxObjects,
+		})
+	}
 	poolDuration := time.Since(poolStart)
 	logging.Debug("Repository pool created", "duration", poolDuration.String(), "ms", poolDuration.Milliseconds())
 	fmt.Printf("Repository pool created (took %v)\n", poolDuration)
@@ -168,8 +245,19 @@ func main() {
 	logging.Info("Starting UI", "total_startup_duration", totalStartupDuration.String(), "ms", totalStartupDuration.Milliseconds())
 	fmt.Printf("Cache synced! Starting UI... (took %v)\n", totalStartupDuration)
 
+	runUI(pool, theme, cfg, llmProvider, auditLog, historyStore, contexts[1:])
+}
+
+// runUI runs the TUI on a loaded pool, loading the background contexts (if
+// any) once it starts
+func runUI(pool *k8s.RepositoryPool, theme *ui.Theme, cfg *config.Config, llmProvider llm.Provider,
+	auditLog *audit.Log, historyStore *history.Store, backgroundContexts []string) {
 	// Create the app model with theme
 	model := app.NewModel(pool, theme)
+	model.SetLLMProvider(llmProvider)
+	model.SetAuditLog(auditLog)
+	model.SetHistoryStore(historyStore)
+	model.SetPolicies(cfg.PolicyFor)
 
 	// Start the Bubble Tea program
 	p := tea.NewProgram(
@@ -178,8 +266,8 @@ func main() {
 	)
 
 	// Load remaining contexts in background (non-blocking)
-	if len(contexts) > 1 {
-		go loadBackgroundContexts(pool, contexts[1:], p)
+	if len(backgroundContexts) > 0 {
+		go loadBackgroundContexts(pool, backgroundContexts, p)
 	}
 
 	// Run UI
@@ -221,6 +309,35 @@ func loadBackgroundContexts(pool *k8s.RepositoryPool, contexts []string, program
 	}
 }
 
+// newLLMProvider creates the /ai provider from the config file and flags
+// (nil when no endpoint is configured)
+func newLLMProvider(cfg config.LLMConfig, endpoint, model string) (llm.Provider, error) {
+	if endpoint != "" {
+		cfg.Endpoint = endpoint
+	}
+	if model != "" {
+		cfg.Model = model
+	}
+	if cfg.Endpoint == "" {
+		return nil, nil
+	}
+
+	llmConfig := llm.Config{
+		Endpoint: cfg.Endpoint,
+		Model:    cfg.Model,
+		Timeout:  time.Duration(cfg.TimeoutSeconds) * time.Second,
+	}
+	if cfg.APIKeyEnv != "" {
+		llmConfig.APIKey = os.Getenv(cfg.APIKeyEnv)
+	}
+	provider, err := llm.NewOpenAIProvider(llmConfig)
+	if err != nil {
+		return nil, err
+	}
+	logging.Info("LLM provider configured", "endpoint", cfg.Endpoint, "model", cfg.Model)
+	return provider, nil
+}
+
 // checkKubectlAvailable checks if kubectl is available in PATH
 func checkKubectlAvailable() error {
 	cmd := exec.Command("kubectl", "version", "--client", "--short")
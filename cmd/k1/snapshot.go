@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/renato0307/k1/internal/k8s"
+)
+
+// snapshotContextPrefix marks snapshot contexts in the UI (they are not live)
+const snapshotContextPrefix = "snapshot:"
+
+// runSnapshot runs `k1 snapshot -o <file> [flags]`: captures the state of a
+// context into a compressed file that `k1 -snapshot <file>` browses offline.
+// Returns the exit code.
+func runSnapshot(args []string) int {
+	fs := flag.NewFlagSet("snapshot", flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: k1 snapshot -o <file> [-context name] [-kubeconfig path]")
+		fmt.Fprintln(os.Stderr)
+		fs.PrintDefaults()
+	}
+
+	var output string
+	fs.StringVar(&output, "o", "", "Snapshot file to write (e.g. cluster.k1snap)")
+	fs.StringVar(&output, "output", "", "Snapshot file to write (e.g. cluster.k1snap)")
+	kubeconfigFlag := fs.String("kubeconfig", "", "Path to kubeconfig file (default: $HOME/.kube/config)")
+	contextFlag := fs.String("context", "", "Kubernetes context (default: current context)")
+	timeout := fs.Duration("timeout", 10*time.Minute, "Maximum time to capture the snapshot")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if output == "" {
+		fs.Usage()
+		return 2
+	}
+
+	kubeconfig := *kubeconfigFlag
+	if kubeconfig == "" {
+		if home := os.Getenv("HOME"); home != "" {
+			kubeconfig = filepath.Join(home, ".kube", "config")
+		}
+	}
+	contextName := *contextFlag
+	if contextName == "" {
+		currentCtx, err := k8s.GetCurrentContext(kubeconfig)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		contextName = currentCtx
+	}
+
+	fmt.Fprintf(os.Stderr, "Capturing snapshot of %s...\n", contextName)
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	snapshot, err := k8s.CaptureSnapshot(ctx, kubeconfig, contextName, func(message string) {
+		fmt.Fprintf(os.Stderr, "  %s\n", message)
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	// Snapshots hold the cluster state (but no secret values): owner only
+	file, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if err := k8s.WriteSnapshot(file, snapshot); err != nil {
+		file.Close()
+		os.Remove(output)
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if err := file.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	fmt.Fprintf(os.Stderr, "Snapshot written to %s (browse with: k1 -snapshot %s)\n", output, output)
+	return 0
+}
+
+// openSnapshot loads a snapshot file into a pool holding its replay
+// repository (the only context, named after the captured one)
+func openSnapshot(path string) (*k8s.RepositoryPool, error) {
+	snapshot, err := k8s.LoadSnapshot(path)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := k8s.NewSnapshotRepository(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot %s: %w", path, err)
+	}
+
+	contextName := snapshotContextPrefix + snapshot.Context
+	fmt.Printf("Browsing snapshot of %s captured at %s\n", snapshot.Context, snapshot.CapturedAt.Format(time.RFC3339))
+	return k8s.NewRepositoryPoolFromRepos(map[string]k8s.Repository{contextName: repo})
+}
@@ -2,16 +2,21 @@ package app
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/renato0307/k1/internal/audit"
 	"github.com/renato0307/k1/internal/commands"
 	"github.com/renato0307/k1/internal/components"
 	"github.com/renato0307/k1/internal/components/commandbar"
+	"github.com/renato0307/k1/internal/config"
+	"github.com/renato0307/k1/internal/history"
 	"github.com/renato0307/k1/internal/k8s"
 	"github.com/renato0307/k1/internal/keyboard"
+	"github.com/renato0307/k1/internal/llm"
 	"github.com/renato0307/k1/internal/logging"
 	"github.com/renato0307/k1/internal/messages"
 	"github.com/renato0307/k1/internal/screens"
@@ -60,6 +65,10 @@ type Model struct {
 	theme             *ui.Theme
 	messageID         int // Track current message to prevent old timers from clearing new messages
 	outputBuffer      *components.OutputBuffer
+	auditLog          *audit.Log     // Mutating operations on disk (nil = disabled)
+	historyStore      *history.Store // Command and output history on disk (nil = disabled)
+	policyFor         func(context string) config.Policy
+	loadedOutputs     map[string]bool
 	keys              *keyboard.Keys // Keyboard configuration
 }
 
@@ -91,16 +100,52 @@ func NewModel(pool *k8s.RepositoryPool, theme *ui.Theme) Model {
 	// Tier 1 (Phase 2): Additional high-value resources
 	registry.Register(screens.NewConfigScreen(screens.GetReplicaSetsScreenConfig(), repo, theme))
 	registry.Register(screens.NewConfigScreen(screens.GetPVCsScreenConfig(), repo, theme))
+	registry.Register(screens.NewConfigScreen(screens.GetPVsScreenConfig(), repo, theme))
+	registry.Register(screens.NewConfigScreen(screens.GetStorageClassesScreenConfig(), repo, theme))
+	registry.Register(screens.NewConfigScreen(screens.GetVolumeAttachmentsScreenConfig(), repo, theme))
 	registry.Register(screens.NewConfigScreen(screens.GetIngressesScreenConfig(), repo, theme))
 	registry.Register(screens.NewConfigScreen(screens.GetEndpointsScreenConfig(), repo, theme))
 	registry.Register(screens.NewConfigScreen(screens.GetHPAsScreenConfig(), repo, theme))
 
+	// RBAC resources
+	registry.Register(screens.NewConfigScreen(screens.GetServiceAccountsScreenConfig(), repo, theme))
+	registry.Register(screens.NewConfigScreen(screens.GetRolesScreenConfig(), repo, theme))
+	registry.Register(screens.NewConfigScreen(screens.GetClusterRolesScreenConfig(), repo, theme))
+	registry.Register(screens.NewConfigScreen(screens.GetRoleBindingsScreenConfig(), repo, theme))
+	registry.Register(screens.NewConfigScreen(screens.GetClusterRoleBindingsScreenConfig(), repo, theme))
+
 	// System screen
 	registry.Register(screens.NewSystemScreen(repo, theme))
 
 	// Help screen
 	registry.Register(screens.NewConfigScreen(screens.GetHelpScreenConfig(), repo, theme))
 
+	// Decoded Secret viewer (opened via /decode)
+	registry.Register(screens.NewConfigScreen(screens.GetSecretDataScreenConfig(), repo, theme))
+
+	// ConfigMap key browser (opened via enter or /data on a configmap)
+	registry.Register(screens.NewConfigScreen(screens.GetConfigMapDataScreenConfig(), repo, theme))
+
+	// Ownership tree (opened via /tree)
+	registry.Register(screens.NewConfigScreen(screens.GetOwnershipTreeScreenConfig(), repo, theme))
+
+	// Object history (opened via /history)
+	registry.Register(screens.NewConfigScreen(screens.GetObjectHistoryScreenConfig(), repo, theme))
+
+	// Node detail (opened via alt+enter on a node or /show-node)
+	registry.Register(screens.NewNodeDetailScreen(repo, theme))
+
+	// Containers of a pod (opened via enter on a pod)
+	registry.Register(screens.NewConfigScreen(screens.GetContainersScreenConfig(), repo, theme))
+
+	// Cluster pulse (opened via :pulse)
+	registry.Register(screens.NewConfigScreen(screens.GetPulseScreenConfig(), repo, theme))
+
+	// RBAC explorer (opened via enter on a role, /who-can and /what-can)
+	registry.Register(screens.NewConfigScreen(screens.GetPolicyRulesScreenConfig(), repo, theme))
+	registry.Register(screens.NewConfigScreen(screens.GetWhoCanScreenConfig(), repo, theme))
+	registry.Register(screens.NewConfigScreen(screens.GetSubjectPermissionsScreenConfig(), repo, theme))
+
 	// Output screen (special - uses outputBuffer)
 	outputBuffer := components.NewOutputBuffer()
 	registry.Register(screens.NewConfigScreen(screens.GetOutputScreenConfig(outputBuffer), pool, theme))
@@ -108,6 +153,9 @@ func NewModel(pool *k8s.RepositoryPool, theme *ui.Theme) Model {
 	// Contexts screen (special - uses pool directly)
 	registry.Register(screens.NewConfigScreen(screens.GetContextsScreenConfig(), pool, theme))
 
+	// Audit log screen (log set later via SetAuditLog)
+	registry.Register(screens.NewConfigScreen(screens.GetAuditScreenConfig(nil), pool, theme))
+
 	// Start with pods screen
 	initialScreen, _ := registry.Get("pods")
 
@@ -157,6 +205,65 @@ func NewModel(pool *k8s.RepositoryPool, theme *ui.Theme) Model {
 	}
 }
 
+// SetLLMProvider sets the provider that translates /ai prompts (nil
+// disables /ai with an explanatory error)
+func (m *Model) SetLLMProvider(provider llm.Provider) {
+	m.commandBar.SetLLMProvider(provider)
+}
+
+// SetAuditLog sets the log that records mutating operations (nil disables
+// auditing).
+func (m *Model) SetAuditLog(log *audit.Log) {
+	m.auditLog = log
+	m.registry.Register(screens.NewConfigScreen(screens.GetAuditScreenConfig(log), m.repoPool, m.theme))
+}
+
+// SetHistoryStore sets the store persisting command and output history
+// per context (nil disables persistence) and loads the active context's
+// history.
+func (m *Model) SetHistoryStore(store *history.Store) {
+	m.historyStore = store
+	m.loadedOutputs = make(map[string]bool)
+	context := m.repoPool.GetActiveContext()
+	m.commandBar.SetHistoryStore(store, context)
+	m.loadOutputHistory(context)
+}
+
+// SetPolicies sets the function resolving the protection policy of a
+// context (read-only and protected contexts).
+func (m *Model) SetPolicies(policyFor func(context string) config.Policy) {
+	m.policyFor = policyFor
+	m.commandBar.SetPolicies(policyFor)
+	m.applyPolicy()
+}
+
+// applyPolicy applies the policy of the active context to the command bar
+// and the header badge.
+func (m *Model) applyPolicy() {
+	context := m.repoPool.GetActiveContext()
+	var policy config.Policy
+	if m.policyFor != nil {
+		policy = m.policyFor(context)
+	}
+	m.commandBar.SetContext(context)
+	m.header.SetBadge(policy.Badge())
+}
+
+// loadOutputHistory merges the persisted output history of a context into
+// the output buffer, once per context.
+func (m *Model) loadOutputHistory(context string) {
+	if m.historyStore == nil || m.loadedOutputs[context] {
+		return
+	}
+	m.loadedOutputs[context] = true
+	outputs, err := m.historyStore.LoadOutputs(context)
+	if err != nil {
+		logging.Warn("Failed to load output history", "context", context, "error", err)
+		return
+	}
+	m.outputBuffer.Merge(outputs)
+}
+
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.currentScreen.Init(),
@@ -274,29 +381,30 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 			// Try to find command by shortcut dynamically (only when command bar is hidden)
-			if cmd := m.commandBar.GetCommandByShortcut(msg.String()); cmd != nil {
+			for _, cmd := range m.commandBar.GetCommandsByShortcut(msg.String()) {
 				// Check if command is applicable to current screen's resource type
 				if !m.isCommandApplicable(cmd) {
 					// Don't execute command if not applicable to this resource type
 					// Let the key pass through to the screen (for navigation, etc.)
-				} else {
-					// Update selection context before executing command
-					if screenWithSel, ok := m.currentScreen.(types.ScreenWithSelection); ok {
-						m.commandBar.SetSelectedResource(screenWithSel.GetSelectedResource())
-					}
-
-					// Execute command
-					updatedBar, barCmd := m.commandBar.ExecuteCommand(cmd.Name, cmd.Category)
-					m.commandBar = updatedBar
-
-					// Recalculate body height if command bar expanded (e.g., for confirmation)
-					bodyHeight := m.layout.CalculateBodyHeightWithCommandBar(m.commandBar.GetTotalHeight())
-					if screenWithSize, ok := m.currentScreen.(interface{ SetSize(int, int) }); ok {
-						screenWithSize.SetSize(m.state.Width, bodyHeight)
-					}
-
-					return m, barCmd
+					continue
+				}
+
+				// Update selection context before executing command
+				if screenWithSel, ok := m.currentScreen.(types.ScreenWithSelection); ok {
+					m.commandBar.SetSelectedResource(screenWithSel.GetSelectedResource())
 				}
+
+				// Execute command
+				updatedBar, barCmd := m.commandBar.ExecuteCommand(cmd.Name, cmd.Category)
+				m.commandBar = updatedBar
+
+				// Recalculate body height if command bar expanded (e.g., for confirmation)
+				bodyHeight := m.layout.CalculateBodyHeightWithCommandBar(m.commandBar.GetTotalHeight())
+				if screenWithSize, ok := m.currentScreen.(interface{ SetSize(int, int) }); ok {
+					screenWithSize.SetSize(m.state.Width, bodyHeight)
+				}
+
+				return m, barCmd
 			}
 		}
 
@@ -383,6 +491,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.Update(types.ScreenSwitchMsg{
 			ScreenID:    screenID,
 			PushHistory: true,
+			SelectKey:   msg.SelectKey,
 		})
 
 	case types.ScreenSwitchMsg:
@@ -397,12 +506,24 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Clear any sticky error/status messages when switching screens
 			m.userMessage.ClearMessage()
 
+			// Let the screen being left drop the state it holds (decoded Secret values)
+			if leaving, ok := m.currentScreen.(interface{ Leave() }); ok && m.currentScreen != screen {
+				leaving.Leave()
+			}
+
 			m.currentScreen = screen
 			m.state.CurrentScreen = msg.ScreenID
 
 			// Apply FilterContext (or clear it if nil)
-			if configScreen, ok := screen.(*screens.ConfigScreen); ok {
-				configScreen.ApplyFilterContext(msg.FilterContext)
+			if filterScreen, ok := screen.(filterContextScreen); ok {
+				filterScreen.ApplyFilterContext(msg.FilterContext)
+			}
+
+			// Select the requested resource (e.g. owner or tree node jumped to)
+			if msg.SelectKey != "" {
+				if selectable, ok := screen.(interface{ SelectResource(string) }); ok {
+					selectable.SelectResource(msg.SelectKey)
+				}
 			}
 
 			// Update command bar with current screen context for command filtering
@@ -475,7 +596,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				Timestamp:      msg.HistoryMetadata.Timestamp,
 				Duration:       msg.HistoryMetadata.Duration,
 			}
+			m.loadOutputHistory(entry.Context)
 			m.outputBuffer.Add(entry)
+			if err := m.historyStore.AppendOutput(entry); err != nil {
+				logging.Warn("Failed to save output history", "error", err)
+			}
+			if msg.HistoryMetadata.Mutating {
+				m.recordAudit(msg)
+			}
 
 			// Debug logging to verify history tracking (remove after Phase 2)
 			logging.Info("History entry added",
@@ -628,8 +756,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case types.ContextSwitchCompleteMsg:
 		// Context switch completed
 		// Update header and layout with new context
-		m.header.SetContext(msg.NewContext)
+		m.header.SetContext(m.contextLabel(msg.NewContext))
 		m.layout.SetContext(msg.NewContext)
+		m.loadOutputHistory(msg.NewContext)
 
 		// Special handling for contexts screen - navigate to pods after switching
 		if m.currentScreen.ID() == "contexts" {
@@ -684,18 +813,52 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		return m, m.currentScreen.Init()
 
+	case types.LLMTranslationMsg:
+		// Command bar already showed the analysis, its height changed
+		bodyHeight := m.layout.CalculateBodyHeightWithCommandBar(m.commandBar.GetTotalHeight())
+		if screenWithSize, ok := m.currentScreen.(interface{ SetSize(int, int) }); ok {
+			screenWithSize.SetSize(m.state.Width, bodyHeight)
+		}
+		return m, tea.Batch(cmds...)
+
+	case types.ContextsAggregatedMsg:
+		// Re-register screens so list screens read through the pool (or not)
+		m.header.SetContext(m.contextLabel(m.repoPool.GetActiveContext()))
+		m.registry = types.NewScreenRegistry()
+		m.initializeScreens()
+
+		if screen, ok := m.registry.Get(m.currentScreen.ID()); ok {
+			m.currentScreen = screen
+			m.header.SetScreenTitle(screen.Title())
+		}
+
+		bodyHeight := m.layout.CalculateBodyHeightWithCommandBar(m.commandBar.GetTotalHeight())
+		if screenWithSize, ok := m.currentScreen.(interface{ SetSize(int, int) }); ok {
+			screenWithSize.SetSize(m.state.Width, bodyHeight)
+		}
+
+		status := messages.InfoCmd("Showing context %s only", m.repoPool.GetActiveContext())
+		if len(msg.Contexts) > 0 {
+			status = messages.InfoCmd("Showing %d contexts: %s", len(msg.Contexts), strings.Join(msg.Contexts, ", "))
+		}
+		return m, tea.Batch(status, m.currentScreen.Init())
+
 	case types.ContextRetryMsg:
 		// Retry failed context
 		return m, m.retryContextCmd(msg.ContextName)
 
 	case types.ShowFullScreenMsg:
 		// Create full-screen view
-		m.fullScreen = components.NewFullScreen(
-			components.FullScreenViewType(msg.ViewType),
-			msg.ResourceName,
-			msg.Content,
-			m.theme,
-		)
+		if msg.Diff != nil {
+			m.fullScreen = components.NewDiffFullScreen(msg.Diff, m.theme)
+		} else {
+			m.fullScreen = components.NewFullScreen(
+				components.FullScreenViewType(msg.ViewType),
+				msg.ResourceName,
+				msg.Content,
+				m.theme,
+			)
+		}
 		m.fullScreen.SetSize(m.state.Width, m.state.Height)
 		m.fullScreenMode = true
 		return m, nil
@@ -728,12 +891,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// filterContextScreen is implemented by screens that take a FilterContext
+// (config screens and custom screens like node detail)
+type filterContextScreen interface {
+	ApplyFilterContext(ctx *types.FilterContext)
+	GetFilterContext() *types.FilterContext
+}
+
 // pushNavigationHistory saves the current screen state to history
 func (m *Model) pushNavigationHistory() {
 	// Get current filter context if available
 	var filterContext *types.FilterContext
-	if configScreen, ok := m.currentScreen.(*screens.ConfigScreen); ok {
-		filterContext = configScreen.GetFilterContext()
+	if filterScreen, ok := m.currentScreen.(filterContextScreen); ok {
+		filterContext = filterScreen.GetFilterContext()
 	}
 
 	// Capture command bar filter (input may exist even if not in filter mode anymore,
@@ -870,9 +1040,48 @@ func (m Model) retryContextCmd(contextName string) tea.Cmd {
 	}
 }
 
-// initializeScreens registers all screens with active repository
+// recordAudit appends a mutating operation to the audit log (with inline
+// secrets redacted, like the history)
+func (m Model) recordAudit(msg types.StatusMsg) {
+	metadata := msg.HistoryMetadata
+	result := audit.ResultSuccess
+	if msg.Type == types.MessageTypeError {
+		result = audit.ResultError
+	}
+	err := m.auditLog.Record(audit.Entry{
+		Timestamp:      metadata.Timestamp,
+		Context:        metadata.Context,
+		Server:         m.repoPool.GetContextServer(metadata.Context),
+		Namespace:      metadata.Namespace,
+		ResourceType:   string(metadata.ResourceType),
+		ResourceName:   metadata.ResourceName,
+		Command:        history.Redact(metadata.Command),
+		KubectlCommand: history.Redact(metadata.KubectlCommand),
+		Result:         result,
+		Message:        history.Redact(msg.Message),
+		DurationMS:     metadata.Duration.Milliseconds(),
+	})
+	if err != nil {
+		logging.Error("Failed to record audit entry", "command", metadata.Command, "error", err)
+	}
+}
+
+// contextLabel returns the context shown in the header (the active context,
+// with the number of merged contexts in aggregated mode)
+func (m *Model) contextLabel(active string) string {
+	if !m.repoPool.IsAggregated() {
+		return active
+	}
+	return fmt.Sprintf("%s (all %d)", active, len(m.repoPool.GetAggregatedContexts()))
+}
+
+// initializeScreens registers all screens with active repository (with the
+// pool itself in aggregated mode, it merges all loaded contexts)
 func (m *Model) initializeScreens() {
 	repo := m.repoPool.GetActiveRepository()
+	if m.repoPool.IsAggregated() {
+		repo = m.repoPool
+	}
 
 	// Register all screens using config-driven approach
 	// Tier 1: Critical (Pods)
@@ -896,21 +1105,51 @@ func (m *Model) initializeScreens() {
 	// Tier 1 (Phase 2): Additional high-value resources
 	m.registry.Register(screens.NewConfigScreen(screens.GetReplicaSetsScreenConfig(), repo, m.theme))
 	m.registry.Register(screens.NewConfigScreen(screens.GetPVCsScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetPVsScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetStorageClassesScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetVolumeAttachmentsScreenConfig(), repo, m.theme))
 	m.registry.Register(screens.NewConfigScreen(screens.GetIngressesScreenConfig(), repo, m.theme))
 	m.registry.Register(screens.NewConfigScreen(screens.GetEndpointsScreenConfig(), repo, m.theme))
 	m.registry.Register(screens.NewConfigScreen(screens.GetHPAsScreenConfig(), repo, m.theme))
 
+	// RBAC resources
+	m.registry.Register(screens.NewConfigScreen(screens.GetServiceAccountsScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetRolesScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetClusterRolesScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetRoleBindingsScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetClusterRoleBindingsScreenConfig(), repo, m.theme))
+
 	// System screen
 	m.registry.Register(screens.NewSystemScreen(repo, m.theme))
 
 	// Help screen
 	m.registry.Register(screens.NewConfigScreen(screens.GetHelpScreenConfig(), repo, m.theme))
 
+	// Decoded Secret viewer (opened via /decode)
+	m.registry.Register(screens.NewConfigScreen(screens.GetSecretDataScreenConfig(), repo, m.theme))
+
+	// ConfigMap key browser (opened via enter or /data on a configmap)
+	m.registry.Register(screens.NewConfigScreen(screens.GetConfigMapDataScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetOwnershipTreeScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetObjectHistoryScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewNodeDetailScreen(repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetContainersScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetPulseScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetPolicyRulesScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetWhoCanScreenConfig(), repo, m.theme))
+	m.registry.Register(screens.NewConfigScreen(screens.GetSubjectPermissionsScreenConfig(), repo, m.theme))
+
 	// Output screen (special - uses outputBuffer from model)
 	m.registry.Register(screens.NewConfigScreen(screens.GetOutputScreenConfig(m.outputBuffer), m.repoPool, m.theme))
 
 	// Contexts screen (special - uses pool directly)
 	m.registry.Register(screens.NewConfigScreen(screens.GetContextsScreenConfig(), m.repoPool, m.theme))
+
+	// Audit log screen (special - uses auditLog from model)
+	m.registry.Register(screens.NewConfigScreen(screens.GetAuditScreenConfig(m.auditLog), m.repoPool, m.theme))
+
+	// New screens (and possibly a new context) need the active policy
+	m.applyPolicy()
 }
 
 // isCommandApplicable checks if a command is applicable to the current screen's resource type
@@ -933,10 +1172,20 @@ func (m Model) isCommandApplicable(cmd *commands.Command) bool {
 	if len(cmd.ResourceTypes) == 0 {
 		// But still need to check if this is a real K8s resource, not help/system/output/contexts
 		nonK8sResources := map[k8s.ResourceType]bool{
-			k8s.ResourceType("help"):     true,
-			k8s.ResourceType("system"):   true,
-			k8s.ResourceType("output"):   true,
-			k8s.ResourceType("contexts"): true,
+			k8s.ResourceType("help"):                             true,
+			k8s.ResourceType("system"):                           true,
+			k8s.ResourceType("output"):                           true,
+			k8s.ResourceType("contexts"):                         true,
+			k8s.ResourceType(screens.SecretDataScreenID):         true,
+			k8s.ResourceType(screens.ConfigMapDataScreenID):      true,
+			k8s.ResourceType(screens.OwnershipTreeScreenID):      true,
+			k8s.ResourceType(screens.ObjectHistoryScreenID):      true,
+			k8s.ResourceTypeContainer:                            true,
+			k8s.ResourceType(screens.PulseScreenID):              true,
+			k8s.ResourceType(screens.AuditScreenID):              true,
+			k8s.ResourceType(screens.PolicyRulesScreenID):        true,
+			k8s.ResourceType(screens.WhoCanScreenID):             true,
+			k8s.ResourceType(screens.SubjectPermissionsScreenID): true,
 		}
 		return !nonK8sResources[currentResourceType]
 	}
@@ -4,8 +4,14 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/audit"
+	"github.com/renato0307/k1/internal/commands"
+	"github.com/renato0307/k1/internal/components"
+	"github.com/renato0307/k1/internal/config"
+	"github.com/renato0307/k1/internal/history"
 	"github.com/renato0307/k1/internal/k8s"
 	"github.com/renato0307/k1/internal/screens"
 	"github.com/renato0307/k1/internal/types"
@@ -277,3 +283,179 @@ func TestESCKey_TriggersBackNavigation(t *testing.T) {
 	assert.Equal(t, "deployments", switchMsg.ScreenID,
 		"Should navigate to previous screen")
 }
+
+func dummyPods(t *testing.T) []k8s.Pod {
+	pods, err := k8s.NewDummyRepository().GetPods()
+	require.NoError(t, err)
+	return pods
+}
+
+// TestContextsAggregatedMsg_RecreatesScreens verifies list screens read through
+// the pool in aggregated mode and the header shows the merged context count
+func TestContextsAggregatedMsg_RecreatesScreens(t *testing.T) {
+	pool, err := k8s.NewRepositoryPoolFromRepos(map[string]k8s.Repository{
+		"eu": k8s.NewDummyRepository(),
+		"us": k8s.NewDummyRepository(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, pool.SetActive("eu"))
+	model := NewModel(pool, ui.ThemeCharm())
+
+	require.NoError(t, pool.SetAggregated(true, nil))
+	updatedModel, cmd := model.Update(types.ContextsAggregatedMsg{Contexts: []string{"eu", "us"}})
+	model = updatedModel.(Model)
+	require.NotNil(t, cmd)
+
+	assert.Equal(t, "pods", model.currentScreen.ID())
+	assert.Equal(t, "eu (all 2)", model.contextLabel(pool.GetActiveContext()))
+	screen, ok := model.currentScreen.(*screens.ConfigScreen)
+	require.True(t, ok)
+	screen.Refresh()()
+	assert.Equal(t, 2*len(dummyPods(t)), screen.GetItemCount())
+
+	require.NoError(t, pool.SetAggregated(false, nil))
+	updatedModel, _ = model.Update(types.ContextsAggregatedMsg{})
+	model = updatedModel.(Model)
+	assert.Equal(t, "eu", model.contextLabel(pool.GetActiveContext()))
+}
+
+// TestStatusMsg_RecordsMutatingOperationsInAuditLog verifies only mutating
+// operations reach the audit log, with the cluster server of their context
+func TestStatusMsg_RecordsMutatingOperationsInAuditLog(t *testing.T) {
+	pool := createTestPool(t)
+	model := NewModel(pool, ui.ThemeCharm())
+	auditLog, err := audit.Open(audit.Config{FilePath: filepath.Join(t.TempDir(), "audit.jsonl")})
+	require.NoError(t, err)
+	defer auditLog.Close()
+	model.SetAuditLog(auditLog)
+
+	metadata := &types.CommandMetadata{
+		Command:      "/delete",
+		Context:      "test-context",
+		ResourceType: k8s.ResourceTypePod,
+		ResourceName: "nginx",
+		Namespace:    "web",
+		Duration:     250 * time.Millisecond,
+		Timestamp:    time.Now(),
+	}
+	readOnly := *metadata
+	updatedModel, _ := model.Update(types.StatusMsg{Type: types.MessageTypeSuccess, Message: "described", TrackInHistory: true, HistoryMetadata: &readOnly})
+	model = updatedModel.(Model)
+
+	metadata.Mutating = true
+	updatedModel, _ = model.Update(types.StatusMsg{Type: types.MessageTypeError, Message: "forbidden", TrackInHistory: true, HistoryMetadata: metadata})
+	model = updatedModel.(Model)
+
+	entries, err := auditLog.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "/delete", entries[0].Command)
+	assert.Equal(t, "https://localhost:6443", entries[0].Server)
+	assert.Equal(t, "pods", entries[0].ResourceType)
+	assert.Equal(t, audit.ResultError, entries[0].Result)
+	assert.Equal(t, "forbidden", entries[0].Message)
+	assert.Equal(t, int64(250), entries[0].DurationMS)
+
+	// The audit screen reads the same log
+	screen, ok := model.registry.Get(screens.AuditScreenID)
+	require.True(t, ok)
+	screen.(*screens.ConfigScreen).Refresh()()
+	assert.Equal(t, 1, screen.(*screens.ConfigScreen).GetItemCount())
+}
+
+// TestStatusMsg_RedactsAuditLog verifies inline secrets never reach the audit log
+func TestStatusMsg_RedactsAuditLog(t *testing.T) {
+	pool := createTestPool(t)
+	model := NewModel(pool, ui.ThemeCharm())
+	auditLog, err := audit.Open(audit.Config{FilePath: filepath.Join(t.TempDir(), "audit.jsonl")})
+	require.NoError(t, err)
+	defer auditLog.Close()
+	model.SetAuditLog(auditLog)
+
+	model.Update(types.StatusMsg{
+		Type:           types.MessageTypeError,
+		Message:        "failed: password=hunter2 rejected",
+		TrackInHistory: true,
+		HistoryMetadata: &types.CommandMetadata{
+			Command:        "/shell create secret generic db --from-literal=password=hunter2",
+			KubectlCommand: "kubectl create secret generic db --from-literal=password=hunter2 --token abc123",
+			Context:        "test-context",
+			Mutating:       true,
+			Timestamp:      time.Now(),
+		},
+	})
+
+	entries, err := auditLog.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "/shell create secret generic db --from-literal=password=***", entries[0].Command)
+	assert.Equal(t, "kubectl create secret generic db --from-literal=password=*** --token ***", entries[0].KubectlCommand)
+	assert.Equal(t, "failed: password=*** rejected", entries[0].Message)
+}
+
+func TestStatusMsg_PersistsOutputHistory(t *testing.T) {
+	pool := createTestPool(t)
+	store := history.NewStore(t.TempDir())
+	require.NoError(t, store.AppendOutput(components.CommandOutput{
+		Command:   "/scale 3",
+		Context:   pool.GetActiveContext(),
+		Timestamp: time.Now().Add(-time.Hour),
+	}))
+
+	model := NewModel(pool, ui.ThemeCharm())
+	model.SetHistoryStore(store)
+	assert.Equal(t, 1, model.outputBuffer.Count(), "previous session output is loaded")
+
+	metadata := &types.CommandMetadata{
+		Command:        "/restart",
+		KubectlCommand: "kubectl rollout restart deployment api --token=abc123",
+		Context:        pool.GetActiveContext(),
+		Timestamp:      time.Now(),
+	}
+	updatedModel, _ := model.Update(types.StatusMsg{Type: types.MessageTypeSuccess, Message: "restarted", TrackInHistory: true, HistoryMetadata: metadata})
+	model = updatedModel.(Model)
+	assert.Equal(t, 2, model.outputBuffer.Count())
+
+	outputs, err := store.LoadOutputs(pool.GetActiveContext())
+	require.NoError(t, err)
+	require.Len(t, outputs, 2)
+	assert.Equal(t, "/restart", outputs[1].Command)
+	assert.Equal(t, "kubectl rollout restart deployment api --token=***", outputs[1].KubectlCommand)
+
+	// A new session sees both outputs
+	next := NewModel(pool, ui.ThemeCharm())
+	next.SetHistoryStore(store)
+	assert.Equal(t, "/restart", next.outputBuffer.GetAll()[0].Command)
+}
+
+func TestSetPolicies_ReadOnly(t *testing.T) {
+	pool := createTestPool(t)
+	model := NewModel(pool, ui.ThemeCharm())
+	assert.NotContains(t, model.header.View(), "READ-ONLY")
+
+	cfg := &config.Config{ReadOnly: true}
+	model.SetPolicies(cfg.PolicyFor)
+	assert.Contains(t, model.header.View(), "READ-ONLY")
+
+	// Shortcuts of mutating commands are refused
+	model.commandBar.SetSelectedResource(map[string]any{"name": "nginx", "namespace": "default"})
+	_, cmd := model.commandBar.ExecuteCommand("delete", commands.CategoryAction)
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Contains(t, msg.Message, "/delete refused")
+
+	// Screens recreated on context switch keep the policy
+	updatedModel, _ := model.Update(types.ContextSwitchCompleteMsg{OldContext: "test-context", NewContext: "test-context"})
+	model = updatedModel.(Model)
+	assert.Contains(t, model.header.View(), "READ-ONLY")
+
+	// Keys of the configmap key browser are edited through /edit-key
+	updatedModel, _ = model.Update(types.ScreenSwitchMsg{ScreenID: "configmap-data"})
+	model = updatedModel.(Model)
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	require.NotNil(t, cmd)
+	msg, ok = cmd().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Contains(t, msg.Message, "/edit-key refused")
+}
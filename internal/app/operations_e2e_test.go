@@ -205,15 +205,9 @@ func TestPodOwnerNavigation(t *testing.T) {
 	tp.SendKey(tea.KeyEnter)
 	time.Sleep(500 * time.Millisecond)
 
-	// Check if feature is implemented or coming soon
-	output := tp.Output()
-	if tp.WaitForOutput("Coming soon", 1*time.Second) {
-		t.Skip("jump-owner command not yet implemented - skipping test")
-	}
-
 	// Should navigate to Deployments screen
 	if !tp.WaitForScreen("Deployments", 3*time.Second) {
-		t.Logf("Output:\n%s", output)
+		t.Logf("Output:\n%s", tp.Output())
 		t.Error("Did not navigate to Deployments screen after jump-owner")
 	}
 
@@ -0,0 +1,207 @@
+// Package audit records mutating operations in an append-only JSONL file
+// with size-based rotation, so changes made from k1 outlive the session.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	// ResultSuccess marks operations that succeeded
+	ResultSuccess = "success"
+	// ResultError marks operations that failed
+	ResultError = "error"
+
+	// DefaultMaxSizeMB is the file size that triggers rotation
+	DefaultMaxSizeMB = 10
+	// DefaultMaxBackups is the number of rotated files kept
+	DefaultMaxBackups = 5
+)
+
+// Entry is one line of the audit log
+type Entry struct {
+	Timestamp      time.Time `json:"timestamp"`
+	User           string    `json:"user"`
+	Context        string    `json:"context"`
+	Server         string    `json:"server,omitempty"`
+	Namespace      string    `json:"namespace,omitempty"`
+	ResourceType   string    `json:"resourceType,omitempty"`
+	ResourceName   string    `json:"resourceName,omitempty"`
+	Command        string    `json:"command"`
+	KubectlCommand string    `json:"kubectlCommand,omitempty"`
+	Result         string    `json:"result"`
+	Message        string    `json:"message,omitempty"`
+	DurationMS     int64     `json:"durationMs"`
+}
+
+// Config holds configuration for the audit log
+type Config struct {
+	// FilePath is the path to the audit file (empty = audit disabled)
+	FilePath string
+	// MaxSizeMB is the maximum size in MB before rotation
+	MaxSizeMB int
+	// MaxBackups is the maximum number of rotated files to keep
+	MaxBackups int
+}
+
+// Log appends entries to the audit file. A nil *Log is a disabled log:
+// Record does nothing and Entries returns nothing.
+type Log struct {
+	mu     sync.Mutex
+	path   string
+	writer *lumberjack.Logger
+	user   string
+}
+
+// Open opens (creating if needed) the audit log at config.FilePath. It
+// returns nil when the path is empty.
+func Open(config Config) (*Log, error) {
+	if config.FilePath == "" {
+		return nil, nil
+	}
+	if config.MaxSizeMB <= 0 {
+		config.MaxSizeMB = DefaultMaxSizeMB
+	}
+	if config.MaxBackups <= 0 {
+		config.MaxBackups = DefaultMaxBackups
+	}
+	if err := os.MkdirAll(filepath.Dir(config.FilePath), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create audit directory: %w", err)
+	}
+
+	return &Log{
+		path: config.FilePath,
+		writer: &lumberjack.Logger{
+			Filename:   config.FilePath,
+			MaxSize:    config.MaxSizeMB,
+			MaxBackups: config.MaxBackups,
+			LocalTime:  true,
+		},
+		user: currentUser(),
+	}, nil
+}
+
+// Path returns the path of the audit file
+func (l *Log) Path() string {
+	if l == nil {
+		return ""
+	}
+	return l.path
+}
+
+// Record appends entry to the log. The timestamp and OS user are filled in
+// when empty.
+func (l *Log) Record(entry Entry) error {
+	if l == nil {
+		return nil
+	}
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now()
+	}
+	if entry.User == "" {
+		entry.User = l.user
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.writer.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	return nil
+}
+
+// Entries returns all entries of the current and rotated files, newest
+// first. Lines that cannot be decoded are skipped.
+func (l *Log) Entries() ([]Entry, error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var entries []Entry
+	for _, path := range l.files() {
+		fileEntries, err := readEntries(path)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fileEntries...)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.After(entries[j].Timestamp)
+	})
+	return entries, nil
+}
+
+// Close closes the audit file
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.writer.Close()
+}
+
+// files returns the rotated files (named <name>-<timestamp><ext> by
+// lumberjack) followed by the current file
+func (l *Log) files() []string {
+	ext := filepath.Ext(l.path)
+	prefix := strings.TrimSuffix(l.path, ext) + "-"
+	backups, _ := filepath.Glob(prefix + "*" + ext)
+	sort.Strings(backups)
+	return append(backups, l.path)
+}
+
+// readEntries decodes the JSONL file at path (missing file = no entries)
+func readEntries(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return entries, nil
+}
+
+// currentUser returns the OS user name
+func currentUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
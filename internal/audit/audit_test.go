@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog_RecordAndEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "audit.jsonl")
+	log, err := Open(Config{FilePath: path})
+	require.NoError(t, err)
+	defer log.Close()
+
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, log.Record(Entry{
+		Timestamp:    start,
+		Context:      "prod",
+		Server:       "https://prod:6443",
+		Namespace:    "web",
+		ResourceType: "deployments",
+		ResourceName: "api",
+		Command:      "/scale 3",
+		Result:       ResultSuccess,
+		DurationMS:   120,
+	}))
+	require.NoError(t, log.Record(Entry{
+		Timestamp: start.Add(time.Minute),
+		Context:   "prod",
+		Command:   "/delete",
+		Result:    ResultError,
+	}))
+
+	// Lines are appended as JSON objects
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"server":"https://prod:6443"`)
+	assert.Contains(t, string(data), `"durationMs":120`)
+
+	entries, err := log.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "/delete", entries[0].Command, "newest first")
+	assert.Equal(t, "api", entries[1].ResourceName)
+	assert.NotEmpty(t, entries[1].User, "OS user filled in")
+}
+
+func TestLog_EntriesIncludeRotatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit.jsonl")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "audit-2025-01-01T00-00-00.000.jsonl"),
+		[]byte(`{"timestamp":"2025-01-01T00:00:00Z","command":"/cordon","result":"success"}`+"\n"+"not json\n"), 0o600))
+
+	log, err := Open(Config{FilePath: path})
+	require.NoError(t, err)
+	defer log.Close()
+	require.NoError(t, log.Record(Entry{Command: "/drain", Result: ResultSuccess}))
+
+	entries, err := log.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "/drain", entries[0].Command)
+	assert.Equal(t, "/cordon", entries[1].Command)
+}
+
+func TestLog_Disabled(t *testing.T) {
+	log, err := Open(Config{})
+	require.NoError(t, err)
+	assert.Nil(t, log)
+
+	assert.NoError(t, log.Record(Entry{Command: "/delete"}))
+	entries, err := log.Entries()
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+	assert.NoError(t, log.Close())
+}
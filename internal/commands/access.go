@@ -0,0 +1,77 @@
+package commands
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/messages"
+)
+
+// accessTarget returns the resource a command acts on for RBAC checks
+// (container rows act on their pod)
+func accessTarget(ctx CommandContext) (schema.GroupVersionResource, string, bool) {
+	namespace, _ := ctx.Selected["namespace"].(string)
+	if ctx.ResourceType == k8s.ResourceTypeContainer {
+		config, ok := k8s.GetResourceConfig(k8s.ResourceTypePod)
+		return config.GVR, namespace, ok
+	}
+	gvr, ok := selectedGVR(ctx)
+	return gvr, namespace, ok
+}
+
+// AccessDenial returns why the user cannot run cmd on the selected resource,
+// or "" when allowed (commands without Verb are not checked)
+func AccessDenial(pool *k8s.RepositoryPool, cmd Command, ctx CommandContext) string {
+	if cmd.Verb == "" || pool == nil {
+		return ""
+	}
+	if name, _ := ctx.Selected["name"].(string); name == "" {
+		return ""
+	}
+	gvr, namespace, ok := accessTarget(ctx)
+	if !ok {
+		return ""
+	}
+	repo := selectedRepository(pool, ctx)
+	if repo == nil {
+		return ""
+	}
+	decision := repo.CheckAccess(cmd.Verb, gvr, cmd.Subresource, namespace)
+	if decision.Allowed {
+		return ""
+	}
+	return decision.Reason
+}
+
+// AccessDenials returns the action commands the user cannot run on the
+// selected resource (command name → reason)
+func (r *Registry) AccessDenials(ctx CommandContext) map[string]string {
+	candidates := r.FilterByResourceType(r.GetByCategory(CategoryAction), ctx.ResourceType)
+	denials := make(map[string]string)
+	for _, cmd := range candidates {
+		if reason := AccessDenial(r.pool, cmd, ctx); reason != "" {
+			denials[cmd.Name] = reason
+		}
+	}
+	return denials
+}
+
+// withAccessCheck wraps the execution of a command so it is refused when
+// the user cannot perform its verb (checked off the UI goroutine, reviews
+// may hit the API server)
+func withAccessCheck(pool *k8s.RepositoryPool, cmd Command) ExecuteFunc {
+	execute := cmd.Execute
+	return func(ctx CommandContext) tea.Cmd {
+		return func() tea.Msg {
+			if reason := AccessDenial(pool, cmd, ctx); reason != "" {
+				return messages.ErrorCmd("/%s refused: %s", cmd.Name, reason)()
+			}
+			run := execute(ctx)
+			if run == nil {
+				return nil
+			}
+			return run()
+		}
+	}
+}
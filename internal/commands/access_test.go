@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/keyboard"
+	"github.com/renato0307/k1/internal/types"
+)
+
+func newDeniedPool() *k8s.RepositoryPool {
+	return newTestRepositoryPool(&mockRepository{
+		context: "test-context",
+		denied: map[string]string{
+			"delete pods":      "cannot delete pods in namespace web",
+			"create pods/exec": "cannot create pods/exec in namespace web",
+		},
+	})
+}
+
+func TestAccessDenial(t *testing.T) {
+	pool := newDeniedPool()
+	registry := NewRegistry(pool, keyboard.GetKeys())
+	podCtx := CommandContext{
+		ResourceType: k8s.ResourceTypePod,
+		Selected:     map[string]any{"name": "api-1", "namespace": "web"},
+	}
+	containerCtx := CommandContext{
+		ResourceType: k8s.ResourceTypeContainer,
+		Selected:     map[string]any{"name": "app", "pod": "api-1", "namespace": "web"},
+	}
+
+	tests := []struct {
+		name    string
+		command string
+		ctx     CommandContext
+		reason  string
+	}{
+		{"denied verb", "delete", podCtx, "cannot delete pods in namespace web"},
+		{"allowed verb", "yaml", podCtx, ""},
+		{"denied subresource", "shell", podCtx, "cannot create pods/exec in namespace web"},
+		{"container acts on its pod", "shell", containerCtx, "cannot create pods/exec in namespace web"},
+		{"other subresource allowed", "logs", podCtx, ""},
+		{"no verb not checked", "tree", podCtx, ""},
+		{"no selection", "delete", CommandContext{ResourceType: k8s.ResourceTypePod, Selected: map[string]any{}}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := registry.Get(tt.command, CategoryAction)
+			require.NotNil(t, cmd)
+			assert.Equal(t, tt.reason, AccessDenial(pool, *cmd, tt.ctx))
+		})
+	}
+}
+
+func TestRegistry_AccessDenials(t *testing.T) {
+	registry := NewRegistry(newDeniedPool(), keyboard.GetKeys())
+
+	denials := registry.AccessDenials(CommandContext{
+		ResourceType: k8s.ResourceTypePod,
+		Selected:     map[string]any{"name": "api-1", "namespace": "web"},
+	})
+	assert.Equal(t, map[string]string{
+		"delete": "cannot delete pods in namespace web",
+		"shell":  "cannot create pods/exec in namespace web",
+	}, denials)
+}
+
+func TestRegistry_RefusesDeniedCommand(t *testing.T) {
+	registry := NewRegistry(newDeniedPool(), keyboard.GetKeys())
+	cmd := registry.Get("delete", CategoryAction)
+	require.NotNil(t, cmd)
+
+	run := cmd.Execute(CommandContext{
+		ResourceType: k8s.ResourceTypePod,
+		Selected:     map[string]any{"name": "api-1", "namespace": "web"},
+	})
+	require.NotNil(t, run)
+	msg, ok := run().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Equal(t, types.MessageTypeError, msg.Type)
+	assert.Equal(t, "/delete refused: cannot delete pods in namespace web", msg.Message)
+}
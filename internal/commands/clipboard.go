@@ -8,8 +8,16 @@ import (
 
 // CopyToClipboard copies text to system clipboard and returns a user-friendly message
 func CopyToClipboard(text string) (string, error) {
-	if err := clipboard.WriteAll(text); err != nil {
-		return "", fmt.Errorf("failed to copy to clipboard: %w", err)
+	if err := WriteClipboard(text); err != nil {
+		return "", err
 	}
 	return fmt.Sprintf("Command copied to clipboard: %s", text), nil
 }
+
+// WriteClipboard copies text to the system clipboard
+func WriteClipboard(text string) error {
+	if err := clipboard.WriteAll(text); err != nil {
+		return fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+	return nil
+}
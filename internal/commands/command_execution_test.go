@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,6 +17,8 @@ import (
 type mockRepository struct {
 	kubeconfig string
 	context    string
+	denied     map[string]string // "verb resource[/subresource]" → denial reason
+	patches    []string          // ConfigMap patches ("namespace/name patch")
 }
 
 func (m *mockRepository) GetKubeconfig() string { return m.kubeconfig }
@@ -63,6 +67,43 @@ func (m *mockRepository) GetPodsUsingConfigMap(namespace, name string) ([]k8s.Po
 func (m *mockRepository) GetPodsUsingSecret(namespace, name string) ([]k8s.Pod, error) {
 	return nil, nil
 }
+func (m *mockRepository) GetNodeDetail(name string) (*k8s.NodeDetail, error) {
+	return nil, nil
+}
+func (m *mockRepository) GetOwnershipTree(gvr schema.GroupVersionResource, namespace, name string) (*k8s.OwnershipNode, error) {
+	return nil, nil
+}
+func (m *mockRepository) GetTopLevelOwner(gvr schema.GroupVersionResource, namespace, name string) (k8s.ResourceRef, error) {
+	return k8s.ResourceRef{}, nil
+}
+func (m *mockRepository) GetBindingsForSubject(kind, namespace, name string) ([]k8s.RoleBinding, error) {
+	return nil, nil
+}
+func (m *mockRepository) GetRulesForRole(kind, namespace, name string) ([]k8s.PolicyRule, error) {
+	return nil, nil
+}
+func (m *mockRepository) GetSubjectsWhoCan(verb, resource, namespace string) ([]k8s.RBACGrant, error) {
+	return nil, nil
+}
+func (m *mockRepository) GetSubjectPermissions(kind, namespace, name string) ([]k8s.RBACGrant, error) {
+	return nil, nil
+}
+func (m *mockRepository) GetObjectHistory(gvr schema.GroupVersionResource, namespace, name string) (*k8s.ObjectHistory, error) {
+	return nil, nil
+}
+func (m *mockRepository) GetSecretData(namespace, name string) (map[string][]byte, error) {
+	return nil, nil
+}
+func (m *mockRepository) GetConfigMapData(namespace, name string) (*k8s.ConfigMapData, error) {
+	return nil, nil
+}
+func (m *mockRepository) PatchConfigMap(namespace, name string, patch []byte) error {
+	m.patches = append(m.patches, namespace+"/"+name+" "+string(patch))
+	return nil
+}
+func (m *mockRepository) GetPreviousContainerLogs(namespace, podName, container string, tailLines int64) (*k8s.PreviousContainerLogs, error) {
+	return nil, nil
+}
 func (m *mockRepository) GetPodsForReplicaSet(namespace, name string) ([]k8s.Pod, error) {
 	return nil, nil
 }
@@ -72,6 +113,15 @@ func (m *mockRepository) GetReplicaSetsForDeployment(namespace, name string) ([]
 func (m *mockRepository) GetPodsForPVC(namespace, name string) ([]k8s.Pod, error) {
 	return nil, nil
 }
+func (m *mockRepository) DiagnosePod(namespace, name string) (*k8s.PodDiagnosis, error) {
+	return nil, nil
+}
+func (m *mockRepository) GetClusterPulse() (*k8s.ClusterPulse, error) {
+	return nil, nil
+}
+func (m *mockRepository) GetContainersForPod(namespace, name string) ([]k8s.Container, error) {
+	return nil, nil
+}
 func (m *mockRepository) GetResourceYAML(gvr schema.GroupVersionResource, namespace, name string) (string, error) {
 	return "", nil
 }
@@ -81,6 +131,16 @@ func (m *mockRepository) DescribeResource(gvr schema.GroupVersionResource, names
 func (m *mockRepository) GetResourceStats() []k8s.ResourceStats {
 	return nil
 }
+func (m *mockRepository) CheckAccess(verb string, gvr schema.GroupVersionResource, subresource, namespace string) k8s.AccessDecision {
+	key := verb + " " + gvr.Resource
+	if subresource != "" {
+		key += "/" + subresource
+	}
+	if reason, ok := m.denied[key]; ok {
+		return k8s.AccessDecision{Reason: reason}
+	}
+	return k8s.AccessDecision{Allowed: true}
+}
 func (m *mockRepository) Close() {}
 func (m *mockRepository) EnsureCRInformer(gvr schema.GroupVersionResource) error {
 	return nil
@@ -322,3 +382,161 @@ func TestRestartCommand_MissingResource(t *testing.T) {
 		assert.Contains(t, statusMsg.Message, "unknown")
 	}
 }
+
+func TestShowNodeCommand(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType k8s.ResourceType
+		selected     map[string]any
+		expectedNode string
+	}{
+		{
+			name:         "pod opens its node",
+			resourceType: k8s.ResourceTypePod,
+			selected:     map[string]any{"name": "nginx", "namespace": "default", "node": "node-1"},
+			expectedNode: "node-1",
+		},
+		{
+			name:         "node opens itself",
+			resourceType: k8s.ResourceTypeNode,
+			selected:     map[string]any{"name": "node-2"},
+			expectedNode: "node-2",
+		},
+		{
+			name:         "unscheduled pod",
+			resourceType: k8s.ResourceTypePod,
+			selected:     map[string]any{"name": "pending", "namespace": "default", "node": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := ShowNodeCommand(nil)(CommandContext{
+				ResourceType: tt.resourceType,
+				Selected:     tt.selected,
+			})
+			require.NotNil(t, cmd)
+
+			msg := cmd()
+			if tt.expectedNode == "" {
+				statusMsg, ok := msg.(types.StatusMsg)
+				require.True(t, ok, "expected StatusMsg, got %T", msg)
+				assert.Equal(t, types.MessageTypeError, statusMsg.Type)
+				return
+			}
+
+			switchMsg, ok := msg.(types.ScreenSwitchMsg)
+			require.True(t, ok, "expected ScreenSwitchMsg, got %T", msg)
+			assert.Equal(t, "node-detail", switchMsg.ScreenID)
+			require.NotNil(t, switchMsg.FilterContext)
+			assert.Equal(t, "detail", switchMsg.FilterContext.Field)
+			assert.Equal(t, tt.expectedNode, switchMsg.FilterContext.Value)
+		})
+	}
+}
+
+func TestLogsPreviousCommand(t *testing.T) {
+	cmd := LogsPreviousCommand(newTestRepositoryPool(k8s.NewDummyRepository()))(CommandContext{
+		ResourceType: k8s.ResourceTypePod,
+		Selected:     map[string]any{"name": "web-0", "namespace": "shop"},
+	})
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	fullScreenMsg, ok := msg.(types.ShowFullScreenMsg)
+	require.True(t, ok, "expected ShowFullScreenMsg, got %T", msg)
+	assert.Equal(t, 2, fullScreenMsg.ViewType)
+	assert.Equal(t, "shop/web-0 (app, previous)", fullScreenMsg.ResourceName)
+	assert.Contains(t, fullScreenMsg.Content, "Last termination: Error, exit code 1")
+	assert.Contains(t, fullScreenMsg.Content, "Restarts:         5")
+	assert.Contains(t, fullScreenMsg.Content, "connection refused")
+}
+
+func TestFormatPreviousLogs(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	content := formatPreviousLogs(&k8s.PreviousContainerLogs{
+		Container:    "app",
+		RestartCount: 3,
+		Reason:       "OOMKilled",
+		ExitCode:     137,
+		Signal:       9,
+		FinishedAt:   now.Add(-90 * time.Second),
+		Logs:         "allocating buffers\n",
+	}, now)
+
+	assert.Contains(t, content, "Last termination: OOMKilled, exit code 137, signal 9\n")
+	assert.Contains(t, content, "(90s ago)")
+	assert.True(t, strings.HasSuffix(content, "allocating buffers\n"))
+
+	empty := formatPreviousLogs(&k8s.PreviousContainerLogs{Container: "app"}, now)
+	assert.Contains(t, empty, "Last termination: Terminated, exit code 0")
+	assert.NotContains(t, empty, "Finished at")
+	assert.Contains(t, empty, "<no logs>")
+}
+
+func TestSelectedPodContainer(t *testing.T) {
+	tests := []struct {
+		name              string
+		ctx               CommandContext
+		argContainer      string
+		expectedNamespace string
+		expectedPod       string
+		expectedContainer string
+	}{
+		{
+			name: "pod without container arg",
+			ctx: CommandContext{
+				ResourceType: k8s.ResourceTypePod,
+				Selected:     map[string]any{"name": "web-0", "namespace": "shop"},
+			},
+			expectedNamespace: "shop",
+			expectedPod:       "web-0",
+		},
+		{
+			name: "pod with container arg",
+			ctx: CommandContext{
+				ResourceType: k8s.ResourceTypePod,
+				Selected:     map[string]any{"name": "web-0", "namespace": "shop"},
+			},
+			argContainer:      "proxy",
+			expectedNamespace: "shop",
+			expectedPod:       "web-0",
+			expectedContainer: "proxy",
+		},
+		{
+			name: "selected container",
+			ctx: CommandContext{
+				ResourceType: k8s.ResourceTypeContainer,
+				Selected:     map[string]any{"name": "app", "pod": "web-0", "namespace": "shop"},
+			},
+			expectedNamespace: "shop",
+			expectedPod:       "web-0",
+			expectedContainer: "app",
+		},
+		{
+			name: "container arg overrides selected container",
+			ctx: CommandContext{
+				ResourceType: k8s.ResourceTypeContainer,
+				Selected:     map[string]any{"name": "app", "pod": "web-0", "namespace": "shop"},
+			},
+			argContainer:      "proxy",
+			expectedNamespace: "shop",
+			expectedPod:       "web-0",
+			expectedContainer: "proxy",
+		},
+		{
+			name:              "no selection",
+			ctx:               CommandContext{ResourceType: k8s.ResourceTypePod, Selected: map[string]any{}},
+			expectedNamespace: "default",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespace, podName, container := selectedPodContainer(tt.ctx, tt.argContainer)
+			assert.Equal(t, tt.expectedNamespace, namespace)
+			assert.Equal(t, tt.expectedPod, podName)
+			assert.Equal(t, tt.expectedContainer, container)
+		})
+	}
+}
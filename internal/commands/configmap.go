@@ -0,0 +1,238 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// ExportKeyArgs defines arguments for export-key command
+type ExportKeyArgs struct {
+	Path string `form:"path" title:"File Path" optional:"true"`
+}
+
+// DataCommand returns execute function for opening the ConfigMap key browser
+func DataCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		name, _ := ctx.Selected["name"].(string)
+		if name == "" {
+			return messages.ErrorCmd("No configmap selected")
+		}
+		namespace := "default"
+		if ns, ok := ctx.Selected["namespace"].(string); ok {
+			namespace = ns
+		}
+
+		if cmd := requireActiveContext(pool, ctx); cmd != nil {
+			return cmd
+		}
+
+		// Payload is fetched by the key browser itself (never cached by informers)
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: "configmap-data",
+				FilterContext: &types.FilterContext{
+					Field: "data",
+					Value: name,
+					Metadata: map[string]string{
+						"namespace": namespace,
+						"kind":      "ConfigMap",
+					},
+				},
+			}
+		}
+	}
+}
+
+// ShowPodsCommand returns execute function for jumping to the pods that
+// mount or reference the selected ConfigMap
+func ShowPodsCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		name, _ := ctx.Selected["name"].(string)
+		if name == "" {
+			return messages.ErrorCmd("No configmap selected")
+		}
+		namespace := "default"
+		if ns, ok := ctx.Selected["namespace"].(string); ok {
+			namespace = ns
+		}
+
+		if cmd := requireActiveContext(pool, ctx); cmd != nil {
+			return cmd
+		}
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: "pods",
+				FilterContext: &types.FilterContext{
+					Field: "configmap",
+					Value: name,
+					Metadata: map[string]string{
+						"namespace": namespace,
+						"kind":      "ConfigMap",
+					},
+				},
+			}
+		}
+	}
+}
+
+// ExportKeyCommand returns execute function for writing the selected
+// ConfigMap key to a local file (defaults to the key name in the current
+// directory; existing files are never overwritten)
+func ExportKeyCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		var args ExportKeyArgs
+		if err := ctx.ParseArgs(&args); err != nil {
+			return messages.ErrorCmd("Invalid args: %v", err)
+		}
+
+		key, _ := ctx.Selected["key"].(string)
+		name, _ := ctx.Selected["configmap"].(string)
+		namespace, _ := ctx.Selected["namespace"].(string)
+		if key == "" || name == "" {
+			return messages.ErrorCmd("No configmap key selected")
+		}
+
+		path := args.Path
+		if path == "" {
+			path = key
+		}
+
+		return func() tea.Msg {
+			data, err := pool.GetConfigMapData(namespace, name)
+			if err != nil {
+				return messages.ErrorCmd("Export failed: %v", err)()
+			}
+			value, ok := data.Values[key]
+			if !ok {
+				return messages.ErrorCmd("Key %s not found in configmap %s/%s", key, namespace, name)()
+			}
+
+			written, err := WriteNewFile(path, value)
+			if err != nil {
+				return messages.ErrorCmd("Export failed: %v", err)()
+			}
+			return messages.SuccessCmd("Exported %s to %s", key, written)()
+		}
+	}
+}
+
+// EditKeyCommand returns execute function for editing the selected
+// ConfigMap key in the user's editor. The key browser opens the editor and
+// patches the key; running it as a command applies the read-only and
+// protected policies of the ConfigMap's context.
+func EditKeyCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		key, _ := ctx.Selected["key"].(string)
+		if key == "" {
+			return messages.ErrorCmd("No configmap key selected")
+		}
+
+		if cmd := requireActiveContext(pool, ctx); cmd != nil {
+			return cmd
+		}
+
+		return func() tea.Msg {
+			return types.EditConfigMapKeyMsg{Key: key}
+		}
+	}
+}
+
+// WriteNewFile writes data to path, refusing to overwrite an existing file,
+// and returns the absolute path written
+func WriteNewFile(path string, data []byte) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid path %s: %w", path, err)
+	}
+
+	file, err := os.OpenFile(absPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		if os.IsExist(err) {
+			return "", fmt.Errorf("file %s already exists", absPath)
+		}
+		return "", fmt.Errorf("failed to create %s: %w", absPath, err)
+	}
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return "", fmt.Errorf("failed to write %s: %w", absPath, err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", absPath, err)
+	}
+	return absPath, nil
+}
+
+// PatchConfigMapKeyCmd returns a command that sets a single ConfigMap key
+// with a merge patch (other keys are left untouched). Keys of binaryData are
+// patched there, as a key cannot be in both data and binaryData.
+func PatchConfigMapKeyCmd(repo k8s.Repository, namespace, name, key string, value []byte, binary bool) tea.Cmd {
+	return func() tea.Msg {
+		start := time.Now() // Track start time for history
+		if repo == nil {
+			return messages.ErrorCmd("No active repository")()
+		}
+
+		patch, err := configMapKeyPatch(key, value, binary)
+		if err != nil {
+			return messages.ErrorCmd("Failed to build patch: %v", err)()
+		}
+
+		// Patched through the API: values up to 1 MiB do not fit in a
+		// kubectl argument. The kubectl equivalent (for history and audit)
+		// leaves the value out.
+		field := "data"
+		if binary {
+			field = "binaryData"
+		}
+		cmdStr := fmt.Sprintf("kubectl patch configmap %s --namespace %s --type merge --patch '{\"%s\":{\"%s\":…}}'", name, namespace, field, key)
+
+		err = repo.PatchConfigMap(namespace, name, patch)
+
+		// Build history metadata
+		metadata := &types.CommandMetadata{
+			Command:        "edit-key " + key,
+			KubectlCommand: cmdStr,
+			Context:        repo.GetContext(),
+			ResourceType:   k8s.ResourceTypeConfigMap,
+			ResourceName:   name,
+			Namespace:      namespace,
+			Duration:       time.Since(start),
+			Timestamp:      time.Now(),
+			Mutating:       true,
+		}
+
+		if err != nil {
+			return messages.WithHistory(
+				messages.ErrorCmd("Patch failed: %v", err),
+				metadata,
+			)()
+		}
+
+		return messages.WithHistory(
+			messages.SuccessCmd("Updated %s in configmap %s/%s", key, namespace, name),
+			metadata,
+		)()
+	}
+}
+
+// configMapKeyPatch builds the merge patch setting a key in data, or in
+// binaryData (base64 encoded)
+func configMapKeyPatch(key string, value []byte, binary bool) ([]byte, error) {
+	if binary {
+		return json.Marshal(map[string]interface{}{
+			"binaryData": map[string][]byte{key: value},
+		})
+	}
+	return json.Marshal(map[string]interface{}{
+		"data": map[string]string{key: string(value)},
+	})
+}
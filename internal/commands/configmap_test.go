@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/types"
+)
+
+func TestWriteNewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.yaml")
+
+	written, err := WriteNewFile(path, []byte("port: 8080\n"))
+	require.NoError(t, err)
+	assert.Equal(t, path, written)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "port: 8080\n", string(content))
+
+	// Existing files are never overwritten
+	_, err = WriteNewFile(path, []byte("port: 9090\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "port: 8080\n", string(content))
+}
+
+func TestEditKeyCommand(t *testing.T) {
+	pool := newAggregatedTestPool()
+
+	cmd := EditKeyCommand(pool)(CommandContext{
+		Selected: map[string]any{"key": "app.yaml", "configmap": "app-config", "namespace": "web", "context": "eu"},
+	})
+	require.NotNil(t, cmd)
+	assert.Equal(t, types.EditConfigMapKeyMsg{Key: "app.yaml"}, cmd())
+
+	// The key browser edits through the active context only
+	cmd = EditKeyCommand(pool)(CommandContext{
+		Selected: map[string]any{"key": "app.yaml", "configmap": "app-config", "namespace": "web", "context": "us"},
+	})
+	require.NotNil(t, cmd)
+	statusMsg, ok := cmd().(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg")
+	assert.Contains(t, statusMsg.Message, "context us")
+
+	statusMsg, ok = EditKeyCommand(pool)(CommandContext{})().(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg")
+	assert.Equal(t, "No configmap key selected", statusMsg.Message)
+}
+
+func TestConfigMapKeyPatch(t *testing.T) {
+	patch, err := configMapKeyPatch("app.yaml", []byte("port: 8080\n"), false)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":{"app.yaml":"port: 8080\n"}}`, string(patch))
+
+	// binaryData keys stay there (base64 encoded), even when the edited
+	// value is text
+	patch, err = configMapKeyPatch("banner.txt", []byte("hello"), true)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"binaryData":{"banner.txt":"aGVsbG8="}}`, string(patch))
+}
+
+func TestPatchConfigMapKeyCmd_LargeValue(t *testing.T) {
+	repo := &mockRepository{context: "prod"}
+
+	// Larger than a single exec argument (128 KiB on Linux)
+	value := []byte(strings.Repeat("x", 512*1024))
+	msg, ok := PatchConfigMapKeyCmd(repo, "web", "app-config", "app.yaml", value, false)().(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg")
+	assert.Equal(t, types.MessageTypeSuccess, msg.Type)
+
+	require.Len(t, repo.patches, 1)
+	assert.True(t, strings.HasPrefix(repo.patches[0], `web/app-config {"data":{"app.yaml":"xxx`))
+	require.NotNil(t, msg.HistoryMetadata)
+	assert.Equal(t, `kubectl patch configmap app-config --namespace web --type merge --patch '{"data":{"app.yaml":…}}'`, msg.HistoryMetadata.KubectlCommand)
+}
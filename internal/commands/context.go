@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"strings"
+
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/renato0307/k1/internal/k8s"
@@ -13,6 +15,67 @@ type ContextArgs struct {
 	ContextName string `form:"context" title:"Context Name"`
 }
 
+// AllContextsArgs defines arguments for the all-contexts command
+type AllContextsArgs struct {
+	Contexts string `form:"contexts" title:"Contexts (comma-separated)" optional:"true"`
+}
+
+// AllContextsCommand toggles aggregated mode: list screens merge the
+// resources of all loaded contexts (or only the given ones) and show a
+// Context column. Without arguments it turns aggregated mode off when on.
+func AllContextsCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		var args AllContextsArgs
+		if err := ctx.ParseArgs(&args); err != nil {
+			return messages.ErrorCmd("Invalid args: %v", err)
+		}
+
+		var contexts []string
+		for _, name := range strings.Split(args.Contexts, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				contexts = append(contexts, name)
+			}
+		}
+
+		if pool.IsAggregated() && len(contexts) == 0 {
+			if err := pool.SetAggregated(false, nil); err != nil {
+				return messages.ErrorCmd("Failed to leave all contexts mode: %v", err)
+			}
+			return func() tea.Msg {
+				return types.ContextsAggregatedMsg{}
+			}
+		}
+
+		if err := pool.SetAggregated(true, contexts); err != nil {
+			return messages.ErrorCmd("Failed to show all contexts: %v", err)
+		}
+		merged := pool.GetAggregatedContexts()
+		return func() tea.Msg {
+			return types.ContextsAggregatedMsg{Contexts: merged}
+		}
+	}
+}
+
+// selectedRepository returns the repository of the selected row: the row's
+// context in aggregated (all contexts) lists, the active context otherwise
+func selectedRepository(pool *k8s.RepositoryPool, ctx CommandContext) k8s.Repository {
+	if contextName, _ := ctx.Selected["context"].(string); contextName != "" {
+		return pool.GetRepository(contextName)
+	}
+	return pool.GetActiveRepository()
+}
+
+// requireActiveContext returns an error command when the selected row of an
+// aggregated list belongs to another context than the active one (drill-down
+// screens read the active context only), nil otherwise
+func requireActiveContext(pool *k8s.RepositoryPool, ctx CommandContext) tea.Cmd {
+	contextName, _ := ctx.Selected["context"].(string)
+	if contextName == "" || pool == nil || contextName == pool.GetActiveContext() {
+		return nil
+	}
+	return messages.ErrorCmd("Selected resource is in context %s (switch with /context %s)", contextName, contextName)
+}
+
 // ContextCommand creates a command to switch Kubernetes context
 func ContextCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 	return func(ctx CommandContext) tea.Cmd {
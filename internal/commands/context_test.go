@@ -135,3 +135,72 @@ func createTestRepositoryPool(t *testing.T) *k8s.RepositoryPool {
 
 	return pool
 }
+
+func newAggregatedTestPool() *k8s.RepositoryPool {
+	pool := new(k8s.RepositoryPool)
+	pool.SetTestRepository("us", &mockRepository{context: "us"})
+	pool.SetTestRepository("eu", &mockRepository{context: "eu"}) // Active
+	return pool
+}
+
+func TestAllContextsCommand(t *testing.T) {
+	pool := newAggregatedTestPool()
+
+	// Turn on for all loaded contexts
+	msg := AllContextsCommand(pool)(CommandContext{})()
+	aggregated, ok := msg.(types.ContextsAggregatedMsg)
+	require.True(t, ok, "expected ContextsAggregatedMsg, got %T", msg)
+	assert.Equal(t, []string{"eu", "us"}, aggregated.Contexts)
+	assert.True(t, pool.IsAggregated())
+
+	// Restrict to some contexts
+	msg = AllContextsCommand(pool)(CommandContext{Args: "us"})()
+	aggregated, ok = msg.(types.ContextsAggregatedMsg)
+	require.True(t, ok)
+	assert.Equal(t, []string{"us"}, aggregated.Contexts)
+
+	// Unknown context
+	msg = AllContextsCommand(pool)(CommandContext{Args: "eu,missing"})()
+	statusMsg, ok := msg.(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg, got %T", msg)
+	assert.Equal(t, types.MessageTypeError, statusMsg.Type)
+	assert.Contains(t, statusMsg.Message, "missing")
+
+	// No args toggles off
+	msg = AllContextsCommand(pool)(CommandContext{})()
+	aggregated, ok = msg.(types.ContextsAggregatedMsg)
+	require.True(t, ok)
+	assert.Empty(t, aggregated.Contexts)
+	assert.False(t, pool.IsAggregated())
+}
+
+func TestSelectedRepository(t *testing.T) {
+	pool := newAggregatedTestPool()
+
+	repo := selectedRepository(pool, CommandContext{Selected: map[string]any{"name": "nginx"}})
+	require.NotNil(t, repo)
+	assert.Equal(t, "eu", repo.GetContext())
+
+	repo = selectedRepository(pool, CommandContext{Selected: map[string]any{"name": "nginx", "context": "us"}})
+	require.NotNil(t, repo)
+	assert.Equal(t, "us", repo.GetContext())
+
+	assert.Nil(t, selectedRepository(pool, CommandContext{Selected: map[string]any{"context": "missing"}}))
+}
+
+func TestRequireActiveContext(t *testing.T) {
+	pool := newAggregatedTestPool()
+
+	assert.Nil(t, requireActiveContext(pool, CommandContext{Selected: map[string]any{"name": "nginx"}}))
+	assert.Nil(t, requireActiveContext(pool, CommandContext{Selected: map[string]any{"context": "eu"}}))
+
+	cmd := DecodeCommand(pool)(CommandContext{
+		ResourceType: k8s.ResourceTypeSecret,
+		Selected:     map[string]any{"name": "tls", "namespace": "web", "context": "us"},
+	})
+	require.NotNil(t, cmd)
+	statusMsg, ok := cmd().(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg")
+	assert.Equal(t, types.MessageTypeError, statusMsg.Type)
+	assert.Contains(t, statusMsg.Message, "context us")
+}
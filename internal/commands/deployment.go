@@ -49,7 +49,7 @@ func ScaleCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 		// Bubble Tea will run this in a separate goroutine
 		return func() tea.Msg {
 			start := time.Now() // Track start time for history
-			repo := pool.GetActiveRepository()
+			repo := selectedRepository(pool, ctx)
 			if repo == nil {
 				return messages.ErrorCmd("No active repository")()
 			}
@@ -70,6 +70,7 @@ func ScaleCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 				Namespace:      namespace,
 				Duration:       time.Since(start),
 				Timestamp:      time.Now(),
+				Mutating:       true,
 			}
 
 			if err != nil {
@@ -115,7 +116,7 @@ func RestartCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 		// Return a command that executes kubectl asynchronously
 		return func() tea.Msg {
 			start := time.Now() // Track start time for history
-			repo := pool.GetActiveRepository()
+			repo := selectedRepository(pool, ctx)
 			if repo == nil {
 				return messages.ErrorCmd("No active repository")()
 			}
@@ -136,6 +137,7 @@ func RestartCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 				Namespace:      namespace,
 				Duration:       time.Since(start),
 				Timestamp:      time.Now(),
+				Mutating:       true,
 			}
 
 			if err != nil {
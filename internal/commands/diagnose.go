@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// DiagnoseCommand returns execute function for explaining why the selected
+// pod is unhealthy (ranked findings with suggested next actions)
+func DiagnoseCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		namespace, podName, _ := selectedPodContainer(ctx, "")
+		if podName == "" {
+			return messages.ErrorCmd("No pod selected")
+		}
+
+		// Diagnosis fetches recent events from the API server - run off the UI loop
+		return func() tea.Msg {
+			repo := selectedRepository(pool, ctx)
+			if repo == nil {
+				return messages.ErrorCmd("No active repository")()
+			}
+			diagnosis, err := repo.DiagnosePod(namespace, podName)
+			if err != nil {
+				return messages.ErrorCmd("Failed to diagnose pod: %v", err)()
+			}
+
+			return types.ShowFullScreenMsg{
+				ViewType:     4, // Diagnosis
+				ResourceName: namespace + "/" + podName,
+				Content:      formatDiagnosis(diagnosis),
+			}
+		}
+	}
+}
+
+// formatDiagnosis renders a diagnosis as numbered findings, most severe first
+func formatDiagnosis(d *k8s.PodDiagnosis) string {
+	var b strings.Builder
+
+	readiness := "Ready"
+	if !d.Ready {
+		readiness = "not Ready"
+	}
+	fmt.Fprintf(&b, "Pod %s/%s is %s (phase %s)\n", d.Namespace, d.Name, readiness, d.Phase)
+
+	for i, finding := range d.Findings {
+		fmt.Fprintf(&b, "\n%d. [%s] %s\n", i+1, finding.Severity, finding.Title)
+		for _, detail := range finding.Details {
+			fmt.Fprintf(&b, "     %s\n", detail)
+		}
+		if len(finding.Actions) > 0 {
+			b.WriteString("   Next:\n")
+			for _, action := range finding.Actions {
+				fmt.Fprintf(&b, "     → %s\n", action)
+			}
+		}
+	}
+	return b.String()
+}
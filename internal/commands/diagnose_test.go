@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+)
+
+func TestDiagnoseCommand(t *testing.T) {
+	cmd := DiagnoseCommand(newTestRepositoryPool(k8s.NewDummyRepository()))(CommandContext{
+		ResourceType: k8s.ResourceTypePod,
+		Selected:     map[string]any{"name": "web-0", "namespace": "shop"},
+	})
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	fullScreenMsg, ok := msg.(types.ShowFullScreenMsg)
+	require.True(t, ok, "expected ShowFullScreenMsg, got %T", msg)
+	assert.Equal(t, 4, fullScreenMsg.ViewType)
+	assert.Equal(t, "shop/web-0", fullScreenMsg.ResourceName)
+	assert.Contains(t, fullScreenMsg.Content, "1. [CRITICAL] Container app was OOMKilled")
+	assert.Contains(t, fullScreenMsg.Content, "→ /logs-previous app")
+}
+
+func TestDiagnoseCommand_NoSelection(t *testing.T) {
+	cmd := DiagnoseCommand(nil)(CommandContext{
+		ResourceType: k8s.ResourceTypePod,
+		Selected:     map[string]any{},
+	})
+	require.NotNil(t, cmd)
+
+	statusMsg, ok := cmd().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Equal(t, types.MessageTypeError, statusMsg.Type)
+}
+
+func TestFormatDiagnosis(t *testing.T) {
+	content := formatDiagnosis(&k8s.PodDiagnosis{
+		Namespace: "shop",
+		Name:      "web-0",
+		Phase:     "Pending",
+		Findings: []k8s.DiagnosisFinding{
+			{
+				Severity: k8s.DiagnosisCritical,
+				Title:    "Pod cannot be scheduled",
+				Details:  []string{"0/3 nodes are available"},
+				Actions:  []string{"Check node taints"},
+			},
+			{Severity: k8s.DiagnosisInfo, Title: "Warning event FailedScheduling"},
+		},
+	})
+
+	assert.Equal(t, `Pod shop/web-0 is not Ready (phase Pending)
+
+1. [CRITICAL] Pod cannot be scheduled
+     0/3 nodes are available
+   Next:
+     → Check node taints
+
+2. [INFO] Warning event FailedScheduling
+`, content)
+}
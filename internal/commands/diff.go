@@ -0,0 +1,172 @@
+package commands
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// DiffArgs defines arguments for the diff command
+type DiffArgs struct {
+	Context string `form:"context" title:"Compare With Context" optional:"true"`
+}
+
+// diffTarget identifies one side of a resource diff
+type diffTarget struct {
+	context   string
+	gvr       schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+// title returns the display name of the target (prefixed by its context
+// when the diff spans contexts)
+func (t diffTarget) title(withContext bool) string {
+	title := t.gvr.Resource + "/" + t.name
+	if t.namespace != "" {
+		title = t.namespace + "/" + title
+	}
+	if withContext {
+		title = t.context + ": " + title
+	}
+	return title
+}
+
+// DiffMark holds the resource marked with /mark as the left side of the next
+// /diff (shared by both commands, kept across screens and contexts)
+type DiffMark struct {
+	target *diffTarget
+}
+
+// selectedDiffTarget builds the diff target of the selected resource
+func selectedDiffTarget(pool *k8s.RepositoryPool, ctx CommandContext) (diffTarget, tea.Cmd) {
+	if ctx.ResourceType == secretDataScreenID {
+		return diffTarget{}, messages.ErrorCmd("Secret values cannot be diffed")
+	}
+	name, _ := ctx.Selected["name"].(string)
+	if name == "" {
+		return diffTarget{}, messages.ErrorCmd("No resource selected")
+	}
+	gvr, ok := selectedGVR(ctx)
+	if !ok {
+		return diffTarget{}, messages.ErrorCmd("Unknown resource type: %s", ctx.ResourceType)
+	}
+
+	target := diffTarget{gvr: gvr, name: name}
+	if !isClusterScoped(ctx.ResourceType) {
+		target.namespace, _ = ctx.Selected["namespace"].(string)
+	}
+	target.context, _ = ctx.Selected["context"].(string)
+	if target.context == "" {
+		target.context = pool.GetActiveContext()
+	}
+	return target, nil
+}
+
+// MarkCommand returns execute function for marking the selected resource as
+// the left side of the next diff
+func MarkCommand(pool *k8s.RepositoryPool, mark *DiffMark) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		target, errCmd := selectedDiffTarget(pool, ctx)
+		if errCmd != nil {
+			return errCmd
+		}
+		mark.target = &target
+		return messages.InfoCmd("Marked %s (select another resource and run /diff)", target.title(false))
+	}
+}
+
+// DiffCommand returns execute function for diffing the marked resource (or,
+// with a context argument, the same resource in that context) against the
+// selected resource
+func DiffCommand(pool *k8s.RepositoryPool, mark *DiffMark) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		var args DiffArgs
+		if err := ctx.ParseArgs(&args); err != nil {
+			return messages.ErrorCmd("Invalid args: %v", err)
+		}
+
+		right, errCmd := selectedDiffTarget(pool, ctx)
+		if errCmd != nil {
+			return errCmd
+		}
+
+		var left diffTarget
+		switch {
+		case args.Context != "":
+			// Same object in another context: other context on the right
+			left = right
+			right.context = args.Context
+		case mark.target != nil:
+			left = *mark.target
+		default:
+			return messages.ErrorCmd("No resource marked (run /mark on the first resource, or /diff <context>)")
+		}
+		if left == right {
+			return messages.ErrorCmd("Both sides are %s (select a different resource)", right.title(true))
+		}
+
+		// YAML fetches may hit the API server - resolve off the UI loop
+		return func() tea.Msg {
+			diff, err := buildResourceDiff(pool, left, right)
+			if err != nil {
+				return messages.ErrorCmd("Diff failed: %v", err)()
+			}
+			return types.ShowFullScreenMsg{
+				ViewType:     5, // Diff
+				ResourceName: diff.LeftTitle + " ↔ " + diff.RightTitle,
+				Diff:         diff,
+			}
+		}
+	}
+}
+
+// buildResourceDiff fetches and cleans the YAML of both targets
+func buildResourceDiff(pool *k8s.RepositoryPool, left, right diffTarget) (*types.DiffContent, error) {
+	crossContext := left.context != right.context
+	diff := &types.DiffContent{
+		LeftTitle:  left.title(crossContext),
+		RightTitle: right.title(crossContext),
+	}
+
+	var err error
+	if diff.Left, diff.LeftSpec, err = cleanedTargetYAML(pool, left); err != nil {
+		return nil, err
+	}
+	if diff.Right, diff.RightSpec, err = cleanedTargetYAML(pool, right); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// cleanedTargetYAML returns the cleaned full and spec-only YAML of a target
+func cleanedTargetYAML(pool *k8s.RepositoryPool, target diffTarget) (string, string, error) {
+	repo := pool.GetRepository(target.context)
+	if repo == nil {
+		return "", "", fmt.Errorf("context %s not loaded", target.context)
+	}
+	// The other context may not watch this resource type yet; start its
+	// informer for later diffs (GetResourceYAML fetches directly until synced)
+	if err := repo.EnsureCRInformer(target.gvr); err != nil {
+		return "", "", fmt.Errorf("%s: %w", target.title(true), err)
+	}
+
+	manifest, err := repo.GetResourceYAML(target.gvr, target.namespace, target.name)
+	if err != nil {
+		return "", "", fmt.Errorf("%s: %w", target.title(true), err)
+	}
+	full, err := k8s.CleanManifestYAML(manifest, false)
+	if err != nil {
+		return "", "", err
+	}
+	spec, err := k8s.CleanManifestYAML(manifest, true)
+	if err != nil {
+		return "", "", err
+	}
+	return full, spec, nil
+}
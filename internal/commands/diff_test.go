@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+)
+
+func newDiffTestPool(t *testing.T) *k8s.RepositoryPool {
+	pool, err := k8s.NewRepositoryPoolFromRepos(map[string]k8s.Repository{
+		"staging": k8s.NewDummyRepository(),
+		"prod":    k8s.NewDummyRepository(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, pool.SetActive("staging"))
+	return pool
+}
+
+func TestDiffCommand_Marked(t *testing.T) {
+	pool := newDiffTestPool(t)
+	mark := &DiffMark{}
+	first := CommandContext{
+		ResourceType: k8s.ResourceTypePod,
+		Selected:     map[string]any{"name": "nginx", "namespace": "web"},
+	}
+	second := CommandContext{
+		ResourceType: k8s.ResourceTypePod,
+		Selected:     map[string]any{"name": "nginx", "namespace": "api"},
+	}
+
+	// Nothing marked yet
+	statusMsg, ok := DiffCommand(pool, mark)(second)().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Contains(t, statusMsg.Message, "No resource marked")
+
+	statusMsg, ok = MarkCommand(pool, mark)(first)().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Contains(t, statusMsg.Message, "Marked web/pods/nginx")
+
+	// Diffing the marked resource with itself is refused
+	statusMsg, ok = DiffCommand(pool, mark)(first)().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Contains(t, statusMsg.Message, "Both sides are")
+
+	msg := DiffCommand(pool, mark)(second)()
+	fullScreenMsg, ok := msg.(types.ShowFullScreenMsg)
+	require.True(t, ok, "expected ShowFullScreenMsg, got %T", msg)
+	require.NotNil(t, fullScreenMsg.Diff)
+	assert.Equal(t, "web/pods/nginx", fullScreenMsg.Diff.LeftTitle)
+	assert.Equal(t, "api/pods/nginx", fullScreenMsg.Diff.RightTitle)
+	assert.Contains(t, fullScreenMsg.Diff.Left, "namespace: web")
+	assert.Contains(t, fullScreenMsg.Diff.Right, "namespace: api")
+	assert.NotContains(t, fullScreenMsg.Diff.Left, "status:")
+	assert.NotContains(t, fullScreenMsg.Diff.LeftSpec, "metadata:")
+}
+
+func TestDiffCommand_OtherContext(t *testing.T) {
+	pool := newDiffTestPool(t)
+	ctx := CommandContext{
+		ResourceType: k8s.ResourceTypePod,
+		Selected:     map[string]any{"name": "nginx", "namespace": "web"},
+		Args:         "prod",
+	}
+
+	msg := DiffCommand(pool, &DiffMark{})(ctx)()
+	fullScreenMsg, ok := msg.(types.ShowFullScreenMsg)
+	require.True(t, ok, "expected ShowFullScreenMsg, got %T", msg)
+	assert.Equal(t, "staging: web/pods/nginx", fullScreenMsg.Diff.LeftTitle)
+	assert.Equal(t, "prod: web/pods/nginx", fullScreenMsg.Diff.RightTitle)
+	assert.Equal(t, fullScreenMsg.Diff.Left, fullScreenMsg.Diff.Right)
+
+	ctx.Args = "missing"
+	statusMsg, ok := DiffCommand(pool, &DiffMark{})(ctx)().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Contains(t, statusMsg.Message, "context missing not loaded")
+}
+
+func TestDiffCommand_SecretData(t *testing.T) {
+	pool := newDiffTestPool(t)
+	ctx := CommandContext{
+		ResourceType: k8s.ResourceType(secretDataScreenID),
+		Selected:     map[string]any{"key": "password", "value": "s3cr3t"},
+	}
+
+	statusMsg, ok := MarkCommand(pool, &DiffMark{})(ctx)().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Contains(t, statusMsg.Message, "cannot be diffed")
+}
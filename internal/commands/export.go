@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// ExportFormats lists the formats supported by the export command
+var ExportFormats = []string{"csv", "json", "md"}
+
+// ExportArgs defines arguments for export command
+type ExportArgs struct {
+	Format    string `form:"format" title:"Format (csv, json, md)" default:"csv" optional:"true"`
+	Path      string `form:"path" title:"File Path (or clipboard)" default:"clipboard" optional:"true"`
+	Formatted bool   `form:"formatted" title:"Use Display Strings" default:"false" optional:"true"`
+}
+
+// ExportCommand returns execute function for exporting the current table
+// view (the screen renders the rows, as only it knows the filter and sort)
+func ExportCommand() ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		var args ExportArgs
+		if err := ctx.ParseArgs(&args); err != nil {
+			return messages.ErrorCmd("Invalid args: %v", err)
+		}
+
+		format := strings.ToLower(args.Format)
+		if format == "markdown" {
+			format = "md"
+		}
+		if !slices.Contains(ExportFormats, format) {
+			return messages.ErrorCmd("Unknown export format %q (expected %s)",
+				args.Format, strings.Join(ExportFormats, ", "))
+		}
+
+		path := args.Path
+		if path == "clipboard" {
+			path = ""
+		}
+
+		return func() tea.Msg {
+			return types.ExportTableMsg{Format: format, Path: path, Formatted: args.Formatted}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/types"
+)
+
+func TestExportCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     string
+		expected types.ExportTableMsg
+	}{
+		{"defaults to csv on clipboard", "", types.ExportTableMsg{Format: "csv"}},
+		{"json to file", "json pods.json", types.ExportTableMsg{Format: "json", Path: "pods.json"}},
+		{"markdown alias", "markdown", types.ExportTableMsg{Format: "md"}},
+		{"formatted to clipboard", "md clipboard true", types.ExportTableMsg{Format: "md", Formatted: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := ExportCommand()(CommandContext{Args: tt.args})()
+			exportMsg, ok := msg.(types.ExportTableMsg)
+			require.True(t, ok, "expected ExportTableMsg, got %T", msg)
+			assert.Equal(t, tt.expected, exportMsg)
+		})
+	}
+}
+
+func TestExportCommand_UnknownFormat(t *testing.T) {
+	msg := ExportCommand()(CommandContext{Args: "xml"})()
+	statusMsg, ok := msg.(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg, got %T", msg)
+	assert.Equal(t, types.MessageTypeError, statusMsg.Type)
+	assert.Contains(t, statusMsg.Message, "xml")
+}
@@ -1,47 +1,155 @@
 package commands
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
+
 	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/llm"
 	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
 )
 
-// LLMDeleteFailingPodsCommand returns execute function for LLM example command.
-// TODO: Implement local LLM integration (see research docs in thoughts/shared/research/).
-func LLMDeleteFailingPodsCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+// kubectlRunner runs kubectl with args against the target context
+type kubectlRunner func(args []string) (string, error)
+
+// AICommand returns execute function for translating a natural-language
+// prompt (the command args) into a kubectl command with the configured LLM
+// provider. The translated command goes through the safety gate (allowlist,
+// classification and server dry-run) before the preview offers to run it.
+// The result is always a types.LLMTranslationMsg (errors included) so the
+// commandbar can leave its preview state.
+func AICommand(pool *k8s.RepositoryPool, provider func() llm.Provider) ExecuteFunc {
 	return func(ctx CommandContext) tea.Cmd {
-		return messages.InfoCmd("LLM commands not yet implemented")
+		prompt := ctx.Args
+		p := provider()
+		req := newLLMRequest(pool, ctx, prompt)
+
+		return func() tea.Msg {
+			if p == nil {
+				return types.LLMTranslationMsg{
+					Prompt: prompt,
+					Err:    fmt.Errorf("no LLM endpoint configured (set llm.endpoint and llm.model in the config file)"),
+				}
+			}
+			translation, err := p.Translate(context.Background(), req)
+			if err != nil {
+				return types.LLMTranslationMsg{Prompt: prompt, Err: err}
+			}
+
+			repo := selectedRepository(pool, ctx)
+			if repo == nil {
+				return types.LLMTranslationMsg{Prompt: prompt, Err: fmt.Errorf("no active repository")}
+			}
+			executor := NewKubectlExecutor(repo.GetKubeconfig(), repo.GetContext())
+			run := func(args []string) (string, error) {
+				return executor.Execute(args, ExecuteOptions{})
+			}
+
+			analysis := analyzeTranslation(translation, run)
+			msg := types.LLMTranslationMsg{
+				Prompt:      prompt,
+				Translation: translation,
+				Analysis:    analysis,
+				Context:     repo.GetContext(),
+			}
+			if analysis.Runnable() {
+				msg.Run = runAIOperation(ctx, repo.GetContext(), analysis.Operation, run)
+			}
+			return msg
+		}
 	}
 }
 
-// LLMScaleNginxCommand returns execute function for LLM example command.
-// TODO: Implement local LLM integration (see research docs in thoughts/shared/research/).
-func LLMScaleNginxCommand(pool *k8s.RepositoryPool) ExecuteFunc {
-	return func(ctx CommandContext) tea.Cmd {
-		return messages.InfoCmd("LLM commands not yet implemented")
+// newLLMRequest describes the current screen, context, namespace and
+// selected resource for the prompt
+func newLLMRequest(pool *k8s.RepositoryPool, ctx CommandContext, prompt string) llm.Request {
+	req := llm.Request{
+		Prompt: prompt,
+		Screen: string(ctx.ResourceType),
+	}
+	// Decoded Secret values never reach the provider
+	if ctx.ResourceType != secretDataScreenID {
+		req.Selected = ctx.Selected
+	}
+	req.Namespace, _ = req.Selected["namespace"].(string)
+	req.Context, _ = req.Selected["context"].(string)
+	if req.Context == "" && pool != nil {
+		req.Context = pool.GetActiveContext()
 	}
+	return req
 }
 
-// LLMGetPodLogsCommand returns execute function for LLM example command.
-// TODO: Implement local LLM integration (see research docs in thoughts/shared/research/).
-func LLMGetPodLogsCommand(pool *k8s.RepositoryPool) ExecuteFunc {
-	return func(ctx CommandContext) tea.Cmd {
-		return messages.InfoCmd("LLM commands not yet implemented")
+// analyzeTranslation parses and classifies the translated command, and
+// dry-runs mutating operations on the server to list the objects they would
+// change. A failing dry-run blocks the command (the real run would fail too).
+func analyzeTranslation(translation *llm.Translation, run kubectlRunner) *llm.Analysis {
+	op, err := llm.ParseCommand(translation.Command)
+	if err != nil {
+		return &llm.Analysis{Rejection: err}
 	}
-}
 
-// LLMRestartDeploymentCommand returns execute function for LLM example command.
-// TODO: Implement local LLM integration (see research docs in thoughts/shared/research/).
-func LLMRestartDeploymentCommand(pool *k8s.RepositoryPool) ExecuteFunc {
-	return func(ctx CommandContext) tea.Cmd {
-		return messages.InfoCmd("LLM commands not yet implemented")
+	analysis := &llm.Analysis{Operation: op}
+	if op.Class == llm.ClassReadOnly {
+		return analysis
+	}
+
+	dryRunArgs := op.DryRunArgs()
+	if dryRunArgs == nil {
+		analysis.DryRun = "Server dry-run not supported for kubectl " + op.Verb
+		return analysis
 	}
+	output, err := run(dryRunArgs)
+	if err != nil {
+		analysis.Rejection = fmt.Errorf("server dry-run failed: %w", err)
+		return analysis
+	}
+	analysis.DryRun = strings.TrimSpace(output)
+	return analysis
 }
 
-// LLMShowPodEventsCommand returns execute function for LLM example command.
-// TODO: Implement local LLM integration (see research docs in thoughts/shared/research/).
-func LLMShowPodEventsCommand(pool *k8s.RepositoryPool) ExecuteFunc {
-	return func(ctx CommandContext) tea.Cmd {
-		return messages.InfoCmd("LLM commands not yet implemented")
+// runAIOperation returns the command that runs a vetted operation: read-only
+// output opens in full screen, mutations report status and go to history
+func runAIOperation(ctx CommandContext, contextName string, op *llm.Operation, run kubectlRunner) tea.Cmd {
+	return func() tea.Msg {
+		start := time.Now()
+		output, err := run(op.Args)
+
+		if op.Class == llm.ClassReadOnly {
+			if err != nil {
+				return messages.ErrorCmd("AI command failed: %v", err)()
+			}
+			return types.ShowFullScreenMsg{
+				ViewType:     6, // Output
+				ResourceName: op.String(),
+				Content:      output,
+			}
+		}
+
+		metadata := &types.CommandMetadata{
+			Command:        ctx.OriginalCommand,
+			KubectlCommand: op.String(),
+			Context:        contextName,
+			ResourceType:   ctx.ResourceType,
+			Namespace:      op.Namespace,
+			Duration:       time.Since(start),
+			Timestamp:      time.Now(),
+			Mutating:       true,
+		}
+		if err != nil {
+			return messages.WithHistory(
+				messages.ErrorCmd("AI command failed: %v (cmd: %s)", err, op.String()),
+				metadata,
+			)()
+		}
+		result := strings.TrimSpace(output)
+		if result == "" {
+			result = "Ran " + op.String()
+		}
+		return messages.WithHistory(messages.SuccessCmd("%s", result), metadata)()
 	}
 }
@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/llm"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// fakeKubectl records the arguments of each run and returns fixed output
+type fakeKubectl struct {
+	runs   [][]string
+	output string
+	err    error
+}
+
+func (f *fakeKubectl) run(args []string) (string, error) {
+	f.runs = append(f.runs, args)
+	return f.output, f.err
+}
+
+func TestNewLLMRequest(t *testing.T) {
+	selected := map[string]any{"name": "nginx", "namespace": "web", "context": "prod"}
+	req := newLLMRequest(nil, CommandContext{ResourceType: k8s.ResourceTypePod, Selected: selected}, "restart it")
+	assert.Equal(t, selected, req.Selected)
+	assert.Equal(t, "web", req.Namespace)
+	assert.Equal(t, "prod", req.Context)
+
+	// Revealed Secret values are not sent to the provider
+	secretRow := map[string]any{"key": "password", "value": "s3cr3t"}
+	req = newLLMRequest(nil, CommandContext{ResourceType: k8s.ResourceType(secretDataScreenID), Selected: secretRow}, "what is this")
+	assert.Nil(t, req.Selected)
+	assert.Equal(t, "secret-data", req.Screen)
+}
+
+func TestAnalyzeTranslation(t *testing.T) {
+	tests := []struct {
+		name      string
+		command   string
+		output    string
+		err       error
+		class     llm.OperationClass
+		dryRuns   int
+		dryRun    string
+		rejection string
+	}{
+		{
+			name:    "read-only is not dry-run",
+			command: "kubectl get pods -n web",
+			class:   llm.ClassReadOnly,
+		},
+		{
+			name:    "mutating is dry-run on the server",
+			command: "kubectl scale deployment api --replicas=0 -n web",
+			output:  "deployment.apps/api scaled (server dry run)\n",
+			class:   llm.ClassMutating,
+			dryRuns: 1,
+			dryRun:  "deployment.apps/api scaled (server dry run)",
+		},
+		{
+			name:    "verbs without dry-run are flagged",
+			command: "kubectl rollout pause deployment/api",
+			class:   llm.ClassMutating,
+			dryRun:  "Server dry-run not supported for kubectl rollout pause",
+		},
+		{
+			name:      "failed dry-run rejects",
+			command:   "kubectl delete pod missing",
+			err:       errors.New("pods \"missing\" not found"),
+			dryRuns:   1,
+			rejection: "server dry-run failed: pods \"missing\" not found",
+		},
+		{
+			name:      "disallowed verb rejects without running",
+			command:   "kubectl exec nginx -- sh",
+			rejection: "kubectl exec is not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubectl := &fakeKubectl{output: tt.output, err: tt.err}
+			analysis := analyzeTranslation(&llm.Translation{Command: tt.command}, kubectl.run)
+
+			require.Len(t, kubectl.runs, tt.dryRuns)
+			for _, args := range kubectl.runs {
+				assert.Equal(t, "--dry-run=server", args[len(args)-1])
+			}
+			if tt.rejection != "" {
+				assert.False(t, analysis.Runnable())
+				assert.EqualError(t, analysis.Rejection, tt.rejection)
+				return
+			}
+			require.True(t, analysis.Runnable())
+			assert.Equal(t, tt.class, analysis.Operation.Class)
+			assert.Equal(t, tt.dryRun, analysis.DryRun)
+		})
+	}
+}
+
+func TestRunAIOperation(t *testing.T) {
+	ctx := CommandContext{ResourceType: k8s.ResourceTypePod, OriginalCommand: "/ai scale api to zero"}
+
+	op, err := llm.ParseCommand("kubectl get pods -n web")
+	require.NoError(t, err)
+	kubectl := &fakeKubectl{output: "NAME    READY\nnginx   1/1\n"}
+	msg := runAIOperation(ctx, "kind-dev", op, kubectl.run)()
+	fullScreenMsg, ok := msg.(types.ShowFullScreenMsg)
+	require.True(t, ok, "expected ShowFullScreenMsg, got %T", msg)
+	assert.Equal(t, 6, fullScreenMsg.ViewType)
+	assert.Equal(t, "kubectl get pods -n web", fullScreenMsg.ResourceName)
+	assert.Contains(t, fullScreenMsg.Content, "nginx")
+
+	op, err = llm.ParseCommand("kubectl scale deployment api --replicas=0 -n web")
+	require.NoError(t, err)
+	kubectl = &fakeKubectl{output: "deployment.apps/api scaled\n"}
+	msg = runAIOperation(ctx, "kind-dev", op, kubectl.run)()
+	statusMsg, ok := msg.(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg, got %T", msg)
+	assert.Equal(t, types.MessageTypeSuccess, statusMsg.Type)
+	assert.Equal(t, "deployment.apps/api scaled", statusMsg.Message)
+	require.NotNil(t, statusMsg.HistoryMetadata)
+	assert.Equal(t, "kubectl scale deployment api --replicas=0 -n web", statusMsg.HistoryMetadata.KubectlCommand)
+	assert.Equal(t, "kind-dev", statusMsg.HistoryMetadata.Context)
+	assert.Equal(t, "web", statusMsg.HistoryMetadata.Namespace)
+	assert.Equal(t, [][]string{op.Args}, kubectl.runs)
+}
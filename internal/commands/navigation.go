@@ -8,6 +8,7 @@ import (
 	"github.com/renato0307/k1/internal/k8s"
 	"github.com/renato0307/k1/internal/messages"
 	"github.com/renato0307/k1/internal/types"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // navigationRegistry maps screen IDs to their names
@@ -27,7 +28,9 @@ var navigationRegistry = map[string]string{
 	"customresourcedefinitions":   "customresourcedefinitions",
 	"crds":                        "customresourcedefinitions", // Alias
 	"system-resources":            "system-resources",
+	"pulse":                       "pulse",
 	"output":                      "output",
+	"audit":                       "audit",
 	"contexts":                    "contexts",
 }
 
@@ -40,6 +43,54 @@ func NavigationCommand(screenID string) ExecuteFunc {
 	}
 }
 
+// NavigateToResourceCmd switches to the list screen of a resource's kind with
+// the resource selected. Kinds without a built-in screen (CRs and built-ins
+// k1 has no screen for) open a dynamic screen, using the CRD printer columns
+// when the CRD is known.
+func NavigateToResourceCmd(repo k8s.Repository, gvr schema.GroupVersionResource, kind, namespace, name string) tea.Cmd {
+	selectKey := namespace + "/" + name
+
+	if resourceType, ok := k8s.FindResourceTypeByGVR(gvr); ok {
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID:    string(resourceType),
+				PushHistory: true,
+				SelectKey:   selectKey,
+			}
+		}
+	}
+
+	scope := "Namespaced"
+	if namespace == "" {
+		scope = "Cluster"
+	}
+	crd := k8s.CustomResourceDefinition{
+		Group:   gvr.Group,
+		Version: gvr.Version,
+		Kind:    kind,
+		Plural:  gvr.Resource,
+		Scope:   scope,
+	}
+	if repo != nil {
+		if crds, err := repo.GetResources(k8s.ResourceTypeCRD); err == nil {
+			for _, item := range crds {
+				if known, ok := item.(k8s.CustomResourceDefinition); ok &&
+					known.Group == gvr.Group && known.Plural == gvr.Resource {
+					crd = known
+					break
+				}
+			}
+		}
+	}
+
+	return func() tea.Msg {
+		return types.DynamicScreenCreateMsg{
+			CRD:       crd,
+			SelectKey: selectKey,
+		}
+	}
+}
+
 // Legacy navigation command functions for backward compatibility
 // These now delegate to the table-driven NavigationCommand
 
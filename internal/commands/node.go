@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/renato0307/k1/internal/k8s"
 	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
 )
 
 // DrainArgs defines arguments for drain command
@@ -33,21 +35,23 @@ func CordonCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 
 		// Return a command that executes kubectl asynchronously
 		return func() tea.Msg {
-			repo := pool.GetActiveRepository()
+			start := time.Now() // Track start time for history
+			repo := selectedRepository(pool, ctx)
 			if repo == nil {
 				return messages.ErrorCmd("No active repository")()
 			}
 			executor := NewKubectlExecutor(repo.GetKubeconfig(), repo.GetContext())
 			output, err := executor.Execute(kubectlArgs, ExecuteOptions{})
+			metadata := nodeCommandMetadata(ctx, repo.GetContext(), resourceName, kubectlArgs, start)
 
 			if err != nil {
-				return messages.ErrorCmd("Cordon failed: %v", err)()
+				return messages.WithHistory(messages.ErrorCmd("Cordon failed: %v", err), metadata)()
 			}
 			msg := fmt.Sprintf("Cordoned node/%s", resourceName)
 			if output != "" {
 				msg = strings.TrimSpace(output)
 			}
-			return messages.SuccessCmd("%s", msg)()
+			return messages.WithHistory(messages.SuccessCmd("%s", msg), metadata)()
 		}
 	}
 }
@@ -84,21 +88,37 @@ func DrainCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 
 		// Return a command that executes kubectl asynchronously
 		return func() tea.Msg {
-			repo := pool.GetActiveRepository()
+			start := time.Now() // Track start time for history
+			repo := selectedRepository(pool, ctx)
 			if repo == nil {
 				return messages.ErrorCmd("No active repository")()
 			}
 			executor := NewKubectlExecutor(repo.GetKubeconfig(), repo.GetContext())
 			output, err := executor.Execute(kubectlArgs, ExecuteOptions{})
+			metadata := nodeCommandMetadata(ctx, repo.GetContext(), resourceName, kubectlArgs, start)
 
 			if err != nil {
-				return messages.ErrorCmd("Drain failed: %v", err)()
+				return messages.WithHistory(messages.ErrorCmd("Drain failed: %v", err), metadata)()
 			}
 			msg := fmt.Sprintf("Drained node/%s", resourceName)
 			if output != "" {
 				msg = strings.TrimSpace(output)
 			}
-			return messages.SuccessCmd("%s", msg)()
+			return messages.WithHistory(messages.SuccessCmd("%s", msg), metadata)()
 		}
 	}
 }
+
+// nodeCommandMetadata builds the history metadata of a node operation
+func nodeCommandMetadata(ctx CommandContext, contextName, nodeName string, kubectlArgs []string, start time.Time) *types.CommandMetadata {
+	return &types.CommandMetadata{
+		Command:        ctx.OriginalCommand,
+		KubectlCommand: "kubectl " + strings.Join(kubectlArgs, " "),
+		Context:        contextName,
+		ResourceType:   k8s.ResourceTypeNode,
+		ResourceName:   nodeName,
+		Duration:       time.Since(start),
+		Timestamp:      time.Now(),
+		Mutating:       true,
+	}
+}
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// HistoryCommand returns execute function for opening the versions of the
+// selected resource observed during the session (the history screen diffs
+// them)
+func HistoryCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		name, _ := ctx.Selected["name"].(string)
+		if name == "" {
+			return messages.ErrorCmd("No resource selected")
+		}
+		namespace, _ := ctx.Selected["namespace"].(string)
+
+		gvr, ok := selectedGVR(ctx)
+		if !ok {
+			return messages.ErrorCmd("Unknown resource type: %s", ctx.ResourceType)
+		}
+
+		if cmd := requireActiveContext(pool, ctx); cmd != nil {
+			return cmd
+		}
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: "object-history",
+				FilterContext: &types.FilterContext{
+					Field: "history",
+					Value: name,
+					Metadata: map[string]string{
+						"namespace": namespace,
+						"group":     gvr.Group,
+						"version":   gvr.Version,
+						"resource":  gvr.Resource,
+					},
+				},
+			}
+		}
+	}
+}
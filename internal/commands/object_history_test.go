@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+)
+
+func TestHistoryCommand(t *testing.T) {
+	cmd := HistoryCommand(nil)(CommandContext{
+		ResourceType: k8s.ResourceTypeDeployment,
+		Selected:     map[string]any{"name": "nginx", "namespace": "web"},
+	})
+	require.NotNil(t, cmd)
+
+	switchMsg, ok := cmd().(types.ScreenSwitchMsg)
+	require.True(t, ok, "expected ScreenSwitchMsg")
+	assert.Equal(t, "object-history", switchMsg.ScreenID)
+	require.NotNil(t, switchMsg.FilterContext)
+	assert.Equal(t, "nginx", switchMsg.FilterContext.Value)
+	assert.Equal(t, map[string]string{
+		"namespace": "web",
+		"group":     "apps",
+		"version":   "v1",
+		"resource":  "deployments",
+	}, switchMsg.FilterContext.Metadata)
+}
+
+func TestHistoryCommand_NoSelection(t *testing.T) {
+	cmd := HistoryCommand(nil)(CommandContext{
+		ResourceType: k8s.ResourceTypeDeployment,
+		Selected:     map[string]any{},
+	})
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	statusMsg, ok := msg.(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg, got %T", msg)
+	assert.Equal(t, types.MessageTypeError, statusMsg.Type)
+}
@@ -1,12 +1,16 @@
 package commands
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/renato0307/k1/internal/k8s"
 	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 // ShellArgs defines arguments for shell command
@@ -22,11 +26,39 @@ type LogsArgs struct {
 	Follow    bool   `form:"follow" title:"Follow" default:"false" optional:"true"`
 }
 
+// LogsPreviousArgs defines arguments for logs-previous command
+type LogsPreviousArgs struct {
+	Container string `form:"container" title:"Container" optional:"true"`
+	Tail      int    `form:"tail" title:"Tail Lines" default:"500" optional:"true" validate:"min=0"`
+}
+
 // PortForwardArgs defines arguments for port-forward command
 type PortForwardArgs struct {
 	Ports string `form:"ports" title:"Port Mapping (local:remote)" validate:"required"`
 }
 
+// selectedPodContainer returns the namespace, pod and container of the
+// selection. On the containers screen the selected row is a container (its
+// pod is in "pod"); elsewhere the container is only known from args.
+// An explicit container argument always wins.
+func selectedPodContainer(ctx CommandContext, argContainer string) (namespace, podName, container string) {
+	namespace = "default"
+	if ns, ok := ctx.Selected["namespace"].(string); ok {
+		namespace = ns
+	}
+
+	podName, _ = ctx.Selected["name"].(string)
+	if ctx.ResourceType == k8s.ResourceTypeContainer {
+		podName, _ = ctx.Selected["pod"].(string)
+		container, _ = ctx.Selected["name"].(string)
+	}
+
+	if argContainer != "" {
+		container = argContainer
+	}
+	return namespace, podName, container
+}
+
 // ShellCommand returns execute function for opening shell in pod (clipboard mode)
 func ShellCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 	return func(ctx CommandContext) tea.Cmd {
@@ -36,18 +68,14 @@ func ShellCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 			return messages.ErrorCmd("Invalid args: %v", err)
 		}
 
-		// Get pod info
-		podName := "unknown"
-		namespace := "default"
-		if name, ok := ctx.Selected["name"].(string); ok {
-			podName = name
-		}
-		if ns, ok := ctx.Selected["namespace"].(string); ok {
-			namespace = ns
+		// Get pod and container info
+		namespace, podName, container := selectedPodContainer(ctx, args.Container)
+		if podName == "" {
+			podName = "unknown"
 		}
 
-		// Get active repository at execution time
-		repo := pool.GetActiveRepository()
+		// Get repository of selected row at execution time
+		repo := selectedRepository(pool, ctx)
 		if repo == nil {
 			return messages.ErrorCmd("No active repository")
 		}
@@ -59,10 +87,10 @@ func ShellCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 		kubectlCmd.WriteString(" --namespace ")
 		kubectlCmd.WriteString(namespace)
 
-		// Add container if specified
-		if args.Container != "" {
+		// Add container if specified (or selected on the containers screen)
+		if container != "" {
 			kubectlCmd.WriteString(" -c ")
-			kubectlCmd.WriteString(args.Container)
+			kubectlCmd.WriteString(container)
 		}
 
 		// Add kubeconfig/context if set
@@ -100,18 +128,14 @@ func LogsCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 			return messages.ErrorCmd("Invalid args: %v", err)
 		}
 
-		// Get pod info
-		podName := "unknown"
-		namespace := "default"
-		if name, ok := ctx.Selected["name"].(string); ok {
-			podName = name
-		}
-		if ns, ok := ctx.Selected["namespace"].(string); ok {
-			namespace = ns
+		// Get pod and container info
+		namespace, podName, container := selectedPodContainer(ctx, args.Container)
+		if podName == "" {
+			podName = "unknown"
 		}
 
-		// Get active repository at execution time
-		repo := pool.GetActiveRepository()
+		// Get repository of selected row at execution time
+		repo := selectedRepository(pool, ctx)
 		if repo == nil {
 			return messages.ErrorCmd("No active repository")
 		}
@@ -123,10 +147,10 @@ func LogsCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 		kubectlCmd.WriteString(" --namespace ")
 		kubectlCmd.WriteString(namespace)
 
-		// Add container if specified
-		if args.Container != "" {
+		// Add container if specified (or selected on the containers screen)
+		if container != "" {
 			kubectlCmd.WriteString(" -c ")
-			kubectlCmd.WriteString(args.Container)
+			kubectlCmd.WriteString(container)
 		}
 
 		// Add tail
@@ -160,17 +184,73 @@ func LogsCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 	}
 }
 
-// LogsPreviousCommand returns execute function for viewing previous pod logs
+// LogsPreviousCommand returns execute function for viewing the logs of the
+// previous container instance (the crashed one) in the full-screen viewer
 func LogsPreviousCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 	return func(ctx CommandContext) tea.Cmd {
-		resourceName := "unknown"
-		if name, ok := ctx.Selected["name"].(string); ok {
-			resourceName = name
+		// Parse args
+		var args LogsPreviousArgs
+		if err := ctx.ParseArgs(&args); err != nil {
+			return messages.ErrorCmd("Invalid args: %v", err)
+		}
+
+		namespace, podName, container := selectedPodContainer(ctx, args.Container)
+		if podName == "" {
+			return messages.ErrorCmd("No pod selected")
+		}
+
+		// Logs come from the kubelet - fetch off the UI loop
+		return func() tea.Msg {
+			repo := selectedRepository(pool, ctx)
+			if repo == nil {
+				return messages.ErrorCmd("No active repository")()
+			}
+			logs, err := repo.GetPreviousContainerLogs(namespace, podName, container, int64(args.Tail))
+			if err != nil {
+				return messages.ErrorCmd("Failed to get previous logs: %v", err)()
+			}
+
+			return types.ShowFullScreenMsg{
+				ViewType:     2, // Logs
+				ResourceName: namespace + "/" + podName + " (" + logs.Container + ", previous)",
+				Content:      formatPreviousLogs(logs, time.Now()),
+			}
 		}
-		return messages.InfoCmd("Previous logs for pod/%s - Coming soon", resourceName)
 	}
 }
 
+// formatPreviousLogs prefixes previous container logs with how that
+// container instance terminated
+func formatPreviousLogs(logs *k8s.PreviousContainerLogs, now time.Time) string {
+	var b strings.Builder
+
+	reason := logs.Reason
+	if reason == "" {
+		reason = "Terminated"
+	}
+	fmt.Fprintf(&b, "Last termination: %s, exit code %d", reason, logs.ExitCode)
+	if logs.Signal != 0 {
+		fmt.Fprintf(&b, ", signal %d", logs.Signal)
+	}
+	b.WriteString("\n")
+	if !logs.FinishedAt.IsZero() {
+		fmt.Fprintf(&b, "Finished at:      %s (%s ago)\n",
+			logs.FinishedAt.Local().Format(time.RFC3339), duration.HumanDuration(now.Sub(logs.FinishedAt)))
+	}
+	fmt.Fprintf(&b, "Restarts:         %d\n", logs.RestartCount)
+	if logs.Message != "" {
+		fmt.Fprintf(&b, "Message:          %s\n", strings.TrimSpace(logs.Message))
+	}
+	b.WriteString(strings.Repeat("─", 60) + "\n")
+
+	if logs.Logs == "" {
+		b.WriteString("<no logs>\n")
+	} else {
+		b.WriteString(logs.Logs)
+	}
+	return b.String()
+}
+
 // PortForwardCommand returns execute function for port forwarding to pod (clipboard mode)
 func PortForwardCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 	return func(ctx CommandContext) tea.Cmd {
@@ -181,17 +261,13 @@ func PortForwardCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 		}
 
 		// Get pod info
-		podName := "unknown"
-		namespace := "default"
-		if name, ok := ctx.Selected["name"].(string); ok {
-			podName = name
-		}
-		if ns, ok := ctx.Selected["namespace"].(string); ok {
-			namespace = ns
+		namespace, podName, _ := selectedPodContainer(ctx, "")
+		if podName == "" {
+			podName = "unknown"
 		}
 
-		// Get active repository at execution time
-		repo := pool.GetActiveRepository()
+		// Get repository of selected row at execution time
+		repo := selectedRepository(pool, ctx)
 		if repo == nil {
 			return messages.ErrorCmd("No active repository")
 		}
@@ -227,28 +303,33 @@ func PortForwardCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 	}
 }
 
-// JumpOwnerCommand returns execute function for jumping to owner resource
-func JumpOwnerCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+// ShowNodeCommand returns execute function for opening the node detail view
+// (the selected node, or the node the selected pod runs on)
+func ShowNodeCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 	return func(ctx CommandContext) tea.Cmd {
-		resourceName := "unknown"
-		if name, ok := ctx.Selected["name"].(string); ok {
-			resourceName = name
+		nodeName, _ := ctx.Selected["node"].(string)
+		if ctx.ResourceType == k8s.ResourceTypeNode {
+			nodeName, _ = ctx.Selected["name"].(string)
+		}
+		if nodeName == "" {
+			return messages.ErrorCmd("Pod is not scheduled on a node")
 		}
-		return messages.InfoCmd("Jump to owner of pod/%s - Coming soon", resourceName)
-	}
-}
 
-// ShowNodeCommand returns execute function for showing node details
-func ShowNodeCommand(pool *k8s.RepositoryPool) ExecuteFunc {
-	return func(ctx CommandContext) tea.Cmd {
-		resourceName := "unknown"
-		nodeName := "unknown"
-		if name, ok := ctx.Selected["name"].(string); ok {
-			resourceName = name
+		if cmd := requireActiveContext(pool, ctx); cmd != nil {
+			return cmd
 		}
-		if node, ok := ctx.Selected["node"].(string); ok {
-			nodeName = node
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: "node-detail",
+				FilterContext: &types.FilterContext{
+					Field: "detail",
+					Value: nodeName,
+					Metadata: map[string]string{
+						"kind": "Node",
+					},
+				},
+			}
 		}
-		return messages.InfoCmd("Show node %s for pod/%s - Coming soon", nodeName, resourceName)
 	}
 }
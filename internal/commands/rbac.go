@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// WhoCanArgs defines arguments for who-can command
+type WhoCanArgs struct {
+	Verb      string `form:"verb" title:"Verb (get, list, delete, ...)"`
+	Resource  string `form:"resource" title:"Resource (pods, deployments.apps, pods/log, /metrics)"`
+	Namespace string `form:"namespace" title:"Namespace (empty = any)" optional:"true"`
+}
+
+// WhatCanArgs defines arguments for what-can command
+type WhatCanArgs struct {
+	Subject string `form:"subject" title:"Subject (user:NAME, group:NAME, sa:NS/NAME)" optional:"true"`
+}
+
+// WhoCanCommand returns execute function for listing the subjects allowed
+// to do something (computed from the cached roles and bindings)
+func WhoCanCommand() ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		var args WhoCanArgs
+		if err := ctx.ParseArgs(&args); err != nil {
+			return messages.ErrorCmd("Invalid args: %v", err)
+		}
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: "who-can",
+				FilterContext: &types.FilterContext{
+					Field: "who-can",
+					Value: args.Resource,
+					Metadata: map[string]string{
+						"verb":      strings.ToLower(args.Verb),
+						"namespace": args.Namespace,
+					},
+				},
+			}
+		}
+	}
+}
+
+// WhatCanCommand returns execute function for listing the permissions of a
+// subject, the selected ServiceAccount when none is given
+func WhatCanCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		var args WhatCanArgs
+		if err := ctx.ParseArgs(&args); err != nil {
+			return messages.ErrorCmd("Invalid args: %v", err)
+		}
+
+		var subject k8s.RBACSubject
+		if args.Subject != "" {
+			var err error
+			if subject, err = parseRBACSubject(args.Subject); err != nil {
+				return messages.ErrorCmd("%v", err)
+			}
+		} else {
+			name, _ := ctx.Selected["name"].(string)
+			if ctx.ResourceType != k8s.ResourceTypeServiceAccount || name == "" {
+				return messages.ErrorCmd("Select a ServiceAccount or give a subject (user:NAME, group:NAME, sa:NS/NAME)")
+			}
+			if cmd := requireActiveContext(pool, ctx); cmd != nil {
+				return cmd
+			}
+			namespace, _ := ctx.Selected["namespace"].(string)
+			subject = k8s.RBACSubject{Kind: k8s.SubjectServiceAccount, Namespace: namespace, Name: name}
+		}
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: "subject-permissions",
+				FilterContext: &types.FilterContext{
+					Field: "what-can",
+					Value: subject.Name,
+					Metadata: map[string]string{
+						"namespace": subject.Namespace,
+						"kind":      subject.Kind,
+					},
+				},
+			}
+		}
+	}
+}
+
+// parseRBACSubject parses "user:NAME", "group:NAME", "sa:NS/NAME" or
+// "system:serviceaccount:NS:NAME" (the username of service accounts). A
+// bare name is a user.
+func parseRBACSubject(value string) (k8s.RBACSubject, error) {
+	if rest, ok := strings.CutPrefix(value, "system:serviceaccount:"); ok {
+		namespace, name, _ := strings.Cut(rest, ":")
+		return serviceAccountSubject(value, namespace, name)
+	}
+
+	prefix, name, ok := strings.Cut(value, ":")
+	if !ok {
+		return k8s.RBACSubject{Kind: k8s.SubjectUser, Name: value}, nil
+	}
+	switch strings.ToLower(prefix) {
+	case "user":
+		return k8s.RBACSubject{Kind: k8s.SubjectUser, Name: name}, nil
+	case "group":
+		return k8s.RBACSubject{Kind: k8s.SubjectGroup, Name: name}, nil
+	case "sa", "serviceaccount":
+		namespace, name, _ := strings.Cut(name, "/")
+		return serviceAccountSubject(value, namespace, name)
+	}
+	// Usernames may contain colons (e.g. OIDC "https://issuer#alice")
+	return k8s.RBACSubject{Kind: k8s.SubjectUser, Name: value}, nil
+}
+
+func serviceAccountSubject(value, namespace, name string) (k8s.RBACSubject, error) {
+	if namespace == "" || name == "" {
+		return k8s.RBACSubject{}, fmt.Errorf("invalid service account %q (expected sa:NAMESPACE/NAME)", value)
+	}
+	return k8s.RBACSubject{Kind: k8s.SubjectServiceAccount, Namespace: namespace, Name: name}, nil
+}
@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+)
+
+func TestWhoCanCommand(t *testing.T) {
+	cmd := WhoCanCommand()(CommandContext{
+		ResourceType: k8s.ResourceTypePod,
+		Args:         "DELETE deployments.apps web",
+	})
+	require.NotNil(t, cmd)
+
+	switchMsg, ok := cmd().(types.ScreenSwitchMsg)
+	require.True(t, ok, "expected ScreenSwitchMsg")
+	assert.Equal(t, "who-can", switchMsg.ScreenID)
+	require.NotNil(t, switchMsg.FilterContext)
+	assert.Equal(t, "deployments.apps", switchMsg.FilterContext.Value)
+	assert.Equal(t, map[string]string{"verb": "delete", "namespace": "web"}, switchMsg.FilterContext.Metadata)
+}
+
+func TestWhoCanCommand_MissingResource(t *testing.T) {
+	cmd := WhoCanCommand()(CommandContext{ResourceType: k8s.ResourceTypePod, Args: "get"})
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	statusMsg, ok := msg.(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg, got %T", msg)
+	assert.Equal(t, types.MessageTypeError, statusMsg.Type)
+}
+
+func TestWhatCanCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      CommandContext
+		wantErr  bool
+		wantName string
+		wantMeta map[string]string
+	}{
+		{
+			name:     "selected service account",
+			ctx:      CommandContext{ResourceType: k8s.ResourceTypeServiceAccount, Selected: map[string]any{"name": "deployer", "namespace": "web"}},
+			wantName: "deployer",
+			wantMeta: map[string]string{"kind": "ServiceAccount", "namespace": "web"},
+		},
+		{
+			name:     "subject argument wins over the selection",
+			ctx:      CommandContext{ResourceType: k8s.ResourceTypeServiceAccount, Selected: map[string]any{"name": "deployer", "namespace": "web"}, Args: "group:developers"},
+			wantName: "developers",
+			wantMeta: map[string]string{"kind": "Group", "namespace": ""},
+		},
+		{
+			name:     "service account username",
+			ctx:      CommandContext{ResourceType: k8s.ResourceTypePod, Args: "system:serviceaccount:ci:runner"},
+			wantName: "runner",
+			wantMeta: map[string]string{"kind": "ServiceAccount", "namespace": "ci"},
+		},
+		{
+			name:    "nothing selected",
+			ctx:     CommandContext{ResourceType: k8s.ResourceTypePod, Selected: map[string]any{"name": "nginx"}},
+			wantErr: true,
+		},
+		{
+			name:    "service account without namespace",
+			ctx:     CommandContext{ResourceType: k8s.ResourceTypePod, Args: "sa:runner"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := WhatCanCommand(nil)(tt.ctx)
+			require.NotNil(t, cmd)
+
+			msg := cmd()
+			if tt.wantErr {
+				statusMsg, ok := msg.(types.StatusMsg)
+				require.True(t, ok, "expected StatusMsg, got %T", msg)
+				assert.Equal(t, types.MessageTypeError, statusMsg.Type)
+				return
+			}
+			switchMsg, ok := msg.(types.ScreenSwitchMsg)
+			require.True(t, ok, "expected ScreenSwitchMsg, got %T", msg)
+			assert.Equal(t, "subject-permissions", switchMsg.ScreenID)
+			require.NotNil(t, switchMsg.FilterContext)
+			assert.Equal(t, tt.wantName, switchMsg.FilterContext.Value)
+			assert.Equal(t, tt.wantMeta, switchMsg.FilterContext.Metadata)
+		})
+	}
+}
+
+func TestParseRBACSubject(t *testing.T) {
+	tests := []struct {
+		value string
+		want  k8s.RBACSubject
+	}{
+		{"alice", k8s.RBACSubject{Kind: k8s.SubjectUser, Name: "alice"}},
+		{"user:alice", k8s.RBACSubject{Kind: k8s.SubjectUser, Name: "alice"}},
+		{"group:system:masters", k8s.RBACSubject{Kind: k8s.SubjectGroup, Name: "system:masters"}},
+		{"sa:web/api", k8s.RBACSubject{Kind: k8s.SubjectServiceAccount, Namespace: "web", Name: "api"}},
+		{"ServiceAccount:web/api", k8s.RBACSubject{Kind: k8s.SubjectServiceAccount, Namespace: "web", Name: "api"}},
+		{"system:serviceaccount:web:api", k8s.RBACSubject{Kind: k8s.SubjectServiceAccount, Namespace: "web", Name: "api"}},
+		{"https://issuer#alice", k8s.RBACSubject{Kind: k8s.SubjectUser, Name: "https://issuer#alice"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			subject, err := parseRBACSubject(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, subject)
+		})
+	}
+
+	_, err := parseRBACSubject("system:serviceaccount:web")
+	assert.Error(t, err)
+}
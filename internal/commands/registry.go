@@ -5,16 +5,22 @@ import (
 
 	"github.com/renato0307/k1/internal/k8s"
 	"github.com/renato0307/k1/internal/keyboard"
+	"github.com/renato0307/k1/internal/llm"
 	"github.com/sahilm/fuzzy"
 )
 
 // Registry holds all available commands and provides filtering
 type Registry struct {
-	commands []Command
+	commands    []Command
+	pool        *k8s.RepositoryPool // For RBAC access checks
+	llmProvider llm.Provider        // nil when no LLM endpoint is configured
 }
 
 // NewRegistry creates a new command registry with default commands
 func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
+	registry := &Registry{pool: pool}
+	diffMark := &DiffMark{} // Shared by /mark and /diff
+
 	commands := []Command{
 		// Navigation commands (: prefix)
 		{
@@ -101,6 +107,36 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			Category:    CategoryResource,
 			Execute:     NavigationCommand("persistentvolumeclaims"),
 		},
+		{
+			Name:        "persistentvolumes",
+			Description: "Switch to PersistentVolumes screen",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("persistentvolumes"),
+		},
+		{
+			Name:        "pvs",
+			Description: "Switch to PersistentVolumes screen (alias)",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("persistentvolumes"),
+		},
+		{
+			Name:        "storageclasses",
+			Description: "Switch to StorageClasses screen",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("storageclasses"),
+		},
+		{
+			Name:        "sc",
+			Description: "Switch to StorageClasses screen (alias)",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("storageclasses"),
+		},
+		{
+			Name:        "volumeattachments",
+			Description: "Switch to VolumeAttachments screen",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("volumeattachments"),
+		},
 		{
 			Name:        "ingresses",
 			Description: "Switch to Ingresses screen",
@@ -137,6 +173,48 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			Category:    CategoryResource,
 			Execute:     NavigationCommand("customresourcedefinitions"),
 		},
+		{
+			Name:        "serviceaccounts",
+			Description: "Switch to ServiceAccounts screen",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("serviceaccounts"),
+		},
+		{
+			Name:        "sa",
+			Description: "Switch to ServiceAccounts screen (alias)",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("serviceaccounts"),
+		},
+		{
+			Name:        "roles",
+			Description: "Switch to Roles screen",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("roles"),
+		},
+		{
+			Name:        "clusterroles",
+			Description: "Switch to ClusterRoles screen",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("clusterroles"),
+		},
+		{
+			Name:        "rolebindings",
+			Description: "Switch to RoleBindings screen",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("rolebindings"),
+		},
+		{
+			Name:        "clusterrolebindings",
+			Description: "Switch to ClusterRoleBindings screen",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("clusterrolebindings"),
+		},
+		{
+			Name:        "pulse",
+			Description: "Cluster health summary (enter drills down)",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("pulse"),
+		},
 		{
 			Name:        "system-resources",
 			Description: "View system resource statistics",
@@ -163,6 +241,7 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			Category:      CategoryAction,
 			ResourceTypes: []k8s.ResourceType{}, // Applies to all resource types
 			Shortcut:      keys.YAML,
+			Verb:          "get",
 			Execute:       YamlCommand(pool),
 		},
 		{
@@ -171,8 +250,23 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			Category:      CategoryAction,
 			ResourceTypes: []k8s.ResourceType{}, // Applies to all resource types
 			Shortcut:      keys.Describe,
+			Verb:          "get",
 			Execute:       DescribeCommand(pool),
 		},
+		{
+			Name:          "tree",
+			Description:   "Show ownership tree",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{}, // Applies to all resource types
+			Execute:       TreeCommand(pool),
+		},
+		{
+			Name:          "history",
+			Description:   "Show versions observed this session",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{}, // Applies to all resource types
+			Execute:       HistoryCommand(pool),
+		},
 		{
 			Name:              "delete",
 			Description:       "Delete selected resource",
@@ -180,6 +274,8 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			ResourceTypes:     []k8s.ResourceType{}, // Applies to all resource types
 			Shortcut:          keys.Delete,
 			NeedsConfirmation: true,
+			Mutating:          true,
+			Verb:              "delete",
 			Execute:           DeleteCommand(pool),
 		},
 		{
@@ -188,55 +284,90 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			Category:      CategoryAction,
 			ResourceTypes: []k8s.ResourceType{}, // Applies to all resource types
 			Shortcut:      keys.Edit,
+			Mutating:      true,
+			Verb:          "patch",
 			Execute:       EditCommand(pool),
 		},
 		{
 			Name:          "logs",
 			Description:   "View pod logs (clipboard)",
 			Category:      CategoryAction,
-			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod}, // Only for pods
+			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod, k8s.ResourceTypeContainer}, // Pods and their containers
 			Shortcut:      keys.Logs,
 			ArgsType:      &LogsArgs{},
 			ArgPattern:    " [container] [tail] [follow]",
+			Verb:          "get",
+			Subresource:   "log",
 			Execute:       LogsCommand(pool),
 		},
 		{
 			Name:          "logs-previous",
-			Description:   "View previous pod logs",
+			Description:   "View logs of previous (crashed) container",
 			Category:      CategoryAction,
-			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod}, // Only for pods
+			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod, k8s.ResourceTypeContainer}, // Pods and their containers
+			ArgsType:      &LogsPreviousArgs{},
+			ArgPattern:    " [container] [tail]",
+			Verb:          "get",
+			Subresource:   "log",
 			Execute:       LogsPreviousCommand(pool),
 		},
 		{
 			Name:          "port-forward",
 			Description:   "Port forward to pod (clipboard)",
 			Category:      CategoryAction,
-			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod}, // Only for pods
+			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod, k8s.ResourceTypeContainer}, // Pods and their containers
 			ArgsType:      &PortForwardArgs{},
 			ArgPattern:    " <local:remote>",
+			Verb:          "create",
+			Subresource:   "portforward",
 			Execute:       PortForwardCommand(pool),
 		},
 		{
 			Name:          "shell",
 			Description:   "Open shell in pod (clipboard)",
 			Category:      CategoryAction,
-			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod}, // Only for pods
+			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod, k8s.ResourceTypeContainer}, // Pods and their containers
 			ArgsType:      &ShellArgs{},
 			ArgPattern:    " [container] [shell]",
+			Verb:          "create",
+			Subresource:   "exec",
 			Execute:       ShellCommand(pool),
 		},
 		{
 			Name:          "jump-owner",
-			Description:   "Jump to owner resource",
+			Description:   "Jump to top-level owner",
 			Category:      CategoryAction,
-			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod}, // Only for pods
+			ResourceTypes: []k8s.ResourceType{}, // Applies to all resource types
 			Execute:       JumpOwnerCommand(pool),
 		},
+		{
+			Name:          "mark",
+			Description:   "Mark resource for diff",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{}, // Applies to all resource types
+			Execute:       MarkCommand(pool, diffMark),
+		},
+		{
+			Name:          "diff",
+			Description:   "Diff marked resource with selected",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{}, // Applies to all resource types
+			ArgsType:      &DiffArgs{},
+			ArgPattern:    " [context]",
+			Execute:       DiffCommand(pool, diffMark),
+		},
+		{
+			Name:          "diagnose",
+			Description:   "Explain why pod is unhealthy",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod, k8s.ResourceTypeContainer}, // Pods and their containers
+			Execute:       DiagnoseCommand(pool),
+		},
 		{
 			Name:          "show-node",
-			Description:   "Show node details",
+			Description:   "Show node details and allocation",
 			Category:      CategoryAction,
-			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod}, // Only for pods
+			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypePod, k8s.ResourceTypeNode}, // For pods and nodes
 			Execute:       ShowNodeCommand(pool),
 		},
 		{
@@ -246,6 +377,9 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypeDeployment, k8s.ResourceTypeStatefulSet}, // For deployments and statefulsets
 			ArgsType:      &ScaleArgs{},
 			ArgPattern:    " <replicas>",
+			Mutating:      true,
+			Verb:          "patch",
+			Subresource:   "scale",
 			Execute:       ScaleCommand(pool),
 		},
 		{
@@ -253,6 +387,8 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			Description:   "Cordon node (mark unschedulable)",
 			Category:      CategoryAction,
 			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypeNode}, // Only for nodes
+			Mutating:      true,
+			Verb:          "patch",
 			Execute:       CordonCommand(pool),
 		},
 		{
@@ -263,6 +399,8 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			ArgsType:          &DrainArgs{},
 			ArgPattern:        " [grace] [force] [ignore-daemonsets]",
 			NeedsConfirmation: true,
+			Mutating:          true,
+			Verb:              "patch",
 			Execute:           DrainCommand(pool),
 		},
 		{
@@ -272,47 +410,91 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypeService}, // Only for services
 			Execute:       EndpointsCommand(pool),
 		},
+		{
+			Name:          "decode",
+			Description:   "View decoded secret data",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypeSecret}, // Only for secrets
+			Verb:          "get",
+			Execute:       DecodeCommand(pool),
+		},
+		{
+			Name:          "data",
+			Description:   "Browse configmap keys",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypeConfigMap}, // Only for configmaps
+			Verb:          "get",
+			Execute:       DataCommand(pool),
+		},
+		{
+			Name:          "show-pods",
+			Description:   "Show pods using configmap",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypeConfigMap}, // Only for configmaps
+			Execute:       ShowPodsCommand(pool),
+		},
+		{
+			Name:          "export-key",
+			Description:   "Export configmap key to file",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{k8s.ResourceType("configmap-data")}, // Only for the configmap key browser
+			ArgsType:      &ExportKeyArgs{},
+			ArgPattern:    " [path]",
+			Execute:       ExportKeyCommand(pool),
+		},
+		{
+			Name:          "edit-key",
+			Description:   "Edit configmap key in $EDITOR",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{k8s.ResourceType("configmap-data")}, // Only for the configmap key browser
+			Shortcut:      keys.Edit,
+			Mutating:      true,
+			Execute:       EditKeyCommand(pool),
+		},
 		{
 			Name:          "restart",
 			Description:   "Restart deployment",
 			Category:      CategoryAction,
 			ResourceTypes: []k8s.ResourceType{k8s.ResourceTypeDeployment}, // Only for deployments
+			Mutating:      true,
+			Verb:          "patch",
 			Execute:       RestartCommand(pool),
 		},
-
-		// LLM commands (/ai prefix) - examples for natural language input
-		{
-			Name:              "delete failing pods",
-			Description:       "Delete all pods in Failed status",
-			Category:          CategoryLLMAction,
-			NeedsConfirmation: true,
-			Execute:           LLMDeleteFailingPodsCommand(pool),
-		},
 		{
-			Name:              "scale nginx to 3",
-			Description:       "Scale nginx deployment to 3 replicas",
-			Category:          CategoryLLMAction,
-			NeedsConfirmation: true,
-			Execute:           LLMScaleNginxCommand(pool),
+			Name:          "who-can",
+			Description:   "List subjects allowed to do something (RBAC)",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{}, // Applies to all resource types
+			ArgsType:      &WhoCanArgs{},
+			ArgPattern:    " <verb> <resource> [namespace]",
+			Execute:       WhoCanCommand(),
 		},
 		{
-			Name:        "get pod logs",
-			Description: "Show logs for the selected pod",
-			Category:    CategoryLLMAction,
-			Execute:     LLMGetPodLogsCommand(pool),
+			Name:          "what-can",
+			Description:   "List permissions of a subject (RBAC)",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{}, // Applies to all resource types
+			ArgsType:      &WhatCanArgs{},
+			ArgPattern:    " [subject]",
+			Execute:       WhatCanCommand(pool),
 		},
 		{
-			Name:              "restart deployment",
-			Description:       "Restart the selected deployment",
-			Category:          CategoryLLMAction,
-			NeedsConfirmation: true,
-			Execute:           LLMRestartDeploymentCommand(pool),
+			Name:          "export",
+			Description:   "Export table view to file or clipboard",
+			Category:      CategoryAction,
+			ResourceTypes: []k8s.ResourceType{}, // Applies to all resource types
+			ArgsType:      &ExportArgs{},
+			ArgPattern:    " [csv|json|md] [path] [formatted]",
+			Execute:       ExportCommand(),
 		},
+
+		// LLM commands (/ai prefix) - natural language translated to kubectl
 		{
-			Name:        "show pod events",
-			Description: "Show events for the selected pod",
+			Name:        "ai",
+			Description: "Natural language AI commands",
 			Category:    CategoryLLMAction,
-			Execute:     LLMShowPodEventsCommand(pool),
+			ArgPattern:  " <prompt>",
+			Execute:     AICommand(pool, registry.LLMProvider),
 		},
 	}
 
@@ -330,6 +512,12 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			Category:    CategoryResource,
 			Execute:     NavigationCommand("output"),
 		},
+		{
+			Name:        "audit",
+			Description: "Browse audit log of mutating operations",
+			Category:    CategoryResource,
+			Execute:     NavigationCommand("audit"),
+		},
 		{
 			Name:        "context",
 			Description: "Switch Kubernetes context",
@@ -338,6 +526,14 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 			ArgPattern:  " <context-name>",
 			Execute:     ContextCommand(pool),
 		},
+		{
+			Name:        "all-contexts",
+			Description: "Toggle listing all loaded contexts",
+			Category:    CategoryAction,
+			ArgsType:    &AllContextsArgs{},
+			ArgPattern:  " [context,...]",
+			Execute:     AllContextsCommand(pool),
+		},
 		{
 			Name:        "next-context",
 			Description: "Switch to next context",
@@ -354,9 +550,25 @@ func NewRegistry(pool *k8s.RepositoryPool, keys *keyboard.Keys) *Registry {
 		},
 	}...)
 
-	return &Registry{
-		commands: commands,
+	// Refuse commands the user cannot perform (RBAC) before running them
+	for i, cmd := range commands {
+		if cmd.Verb != "" {
+			commands[i].Execute = withAccessCheck(pool, cmd)
+		}
 	}
+
+	registry.commands = commands
+	return registry
+}
+
+// SetLLMProvider sets the provider used by /ai commands
+func (r *Registry) SetLLMProvider(provider llm.Provider) {
+	r.llmProvider = provider
+}
+
+// LLMProvider returns the provider used by /ai commands (nil if none)
+func (r *Registry) LLMProvider() llm.Provider {
+	return r.llmProvider
 }
 
 // GetByCategory returns all commands in a category
@@ -433,12 +645,14 @@ func (r *Registry) Get(name string, category CommandCategory) *Command {
 	return nil
 }
 
-// GetByShortcut returns a command by keyboard shortcut, or nil if not found
-func (r *Registry) GetByShortcut(shortcut string) *Command {
+// GetByShortcut returns the commands bound to a keyboard shortcut (a key
+// may run different commands depending on the resource type)
+func (r *Registry) GetByShortcut(shortcut string) []*Command {
+	var matches []*Command
 	for _, cmd := range r.commands {
 		if cmd.Shortcut != "" && cmd.Shortcut == shortcut {
-			return &cmd
+			matches = append(matches, &cmd)
 		}
 	}
-	return nil
+	return matches
 }
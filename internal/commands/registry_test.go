@@ -279,3 +279,27 @@ func TestRegistry_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistry_MutatingCommands(t *testing.T) {
+	registry := NewRegistry(createTestPool(t), keyboard.GetKeys())
+
+	mutating := []string{}
+	for _, cmd := range registry.GetByCategory(CategoryAction) {
+		if cmd.Mutating {
+			mutating = append(mutating, cmd.Name)
+		}
+	}
+	assert.ElementsMatch(t, []string{"delete", "edit", "edit-key", "scale", "cordon", "drain", "restart"}, mutating)
+}
+
+func TestRegistry_GetByShortcut(t *testing.T) {
+	registry := NewRegistry(createTestPool(t), keyboard.GetKeys())
+
+	// "e" edits resources, and keys in the configmap key browser
+	names := []string{}
+	for _, cmd := range registry.GetByShortcut("e") {
+		names = append(names, cmd.Name)
+	}
+	assert.Equal(t, []string{"edit", "edit-key"}, names)
+	assert.Empty(t, registry.GetByShortcut("unbound"))
+}
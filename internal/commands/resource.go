@@ -67,8 +67,8 @@ func YamlCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 			gvr = config.GVR
 		}
 
-		// Get active repository at execution time
-		repo := pool.GetActiveRepository()
+		// Get repository of selected row at execution time
+		repo := selectedRepository(pool, ctx)
 		if repo == nil {
 			return messages.ErrorCmd("No active repository")
 		}
@@ -133,8 +133,8 @@ func DescribeCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 			gvr = config.GVR
 		}
 
-		// Get active repository at execution time
-		repo := pool.GetActiveRepository()
+		// Get repository of selected row at execution time
+		repo := selectedRepository(pool, ctx)
 		if repo == nil {
 			return messages.ErrorCmd("No active repository")
 		}
@@ -216,21 +216,35 @@ func DeleteCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 
 		// Return a command that executes kubectl asynchronously
 		return func() tea.Msg {
-			repo := pool.GetActiveRepository()
+			start := time.Now() // Track start time for history
+			repo := selectedRepository(pool, ctx)
 			if repo == nil {
 				return messages.ErrorCmd("No active repository")()
 			}
 			executor := NewKubectlExecutor(repo.GetKubeconfig(), repo.GetContext())
 			output, err := executor.Execute(args, ExecuteOptions{})
 
+			// Build history metadata
+			metadata := &types.CommandMetadata{
+				Command:        ctx.OriginalCommand,
+				KubectlCommand: "kubectl " + strings.Join(args, " "),
+				Context:        repo.GetContext(),
+				ResourceType:   ctx.ResourceType,
+				ResourceName:   resourceName,
+				Namespace:      namespace,
+				Duration:       time.Since(start),
+				Timestamp:      time.Now(),
+				Mutating:       true,
+			}
+
 			if err != nil {
-				return messages.ErrorCmd("Delete failed: %v", err)()
+				return messages.WithHistory(messages.ErrorCmd("Delete failed: %v", err), metadata)()
 			}
 			msg := fmt.Sprintf("Deleted %s/%s", ctx.ResourceType, resourceName)
 			if output != "" {
 				msg = strings.TrimSpace(output)
 			}
-			return messages.SuccessCmd("%s", msg)()
+			return messages.WithHistory(messages.SuccessCmd("%s", msg), metadata)()
 		}
 	}
 }
@@ -253,8 +267,8 @@ func EditCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 			}
 		}
 
-		// Validate we have active repository first
-		repo := pool.GetActiveRepository()
+		// Validate we have the repository of selected row first
+		repo := selectedRepository(pool, ctx)
 		if repo == nil {
 			return messages.ErrorCmd("No active repository")
 		}
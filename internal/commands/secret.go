@@ -0,0 +1,45 @@
+package commands
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// secretDataScreenID is the decoded Secret viewer, whose selected rows can
+// carry revealed values (kept out of /diff and /ai)
+const secretDataScreenID = "secret-data"
+
+// DecodeCommand returns execute function for opening the decoded Secret viewer
+func DecodeCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		name, _ := ctx.Selected["name"].(string)
+		if name == "" {
+			return messages.ErrorCmd("No secret selected")
+		}
+		namespace := "default"
+		if ns, ok := ctx.Selected["namespace"].(string); ok {
+			namespace = ns
+		}
+
+		if cmd := requireActiveContext(pool, ctx); cmd != nil {
+			return cmd
+		}
+
+		// Payload is fetched by the viewer itself (never cached by informers)
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: secretDataScreenID,
+				FilterContext: &types.FilterContext{
+					Field: "data",
+					Value: name,
+					Metadata: map[string]string{
+						"namespace": namespace,
+						"kind":      "Secret",
+					},
+				},
+			}
+		}
+	}
+}
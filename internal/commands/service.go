@@ -32,7 +32,7 @@ func EndpointsCommand(pool *k8s.RepositoryPool) ExecuteFunc {
 
 		// Return a command that executes kubectl asynchronously
 		return func() tea.Msg {
-			repo := pool.GetActiveRepository()
+			repo := selectedRepository(pool, ctx)
 			if repo == nil {
 				return messages.ErrorCmd("No active repository")()
 			}
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// selectedGVR resolves the GVR of the selected resource, either from the GVR
+// metadata of dynamic CRD instances or from the config of static resources
+func selectedGVR(ctx CommandContext) (schema.GroupVersionResource, bool) {
+	if group, hasGroup := ctx.Selected["__gvr_group"].(string); hasGroup {
+		version, _ := ctx.Selected["__gvr_version"].(string)
+		resource, _ := ctx.Selected["__gvr_resource"].(string)
+		return schema.GroupVersionResource{
+			Group:    group,
+			Version:  version,
+			Resource: resource,
+		}, true
+	}
+
+	config, ok := k8s.GetResourceConfig(ctx.ResourceType)
+	if !ok {
+		return schema.GroupVersionResource{}, false
+	}
+	return config.GVR, true
+}
+
+// TreeCommand returns execute function for opening the ownership tree of the
+// selected resource (the tree screen walks ownerReferences itself)
+func TreeCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		name, _ := ctx.Selected["name"].(string)
+		if name == "" {
+			return messages.ErrorCmd("No resource selected")
+		}
+		namespace, _ := ctx.Selected["namespace"].(string)
+		kind, _ := ctx.Selected["kind"].(string) // Only set for CRD instances
+
+		gvr, ok := selectedGVR(ctx)
+		if !ok {
+			return messages.ErrorCmd("Unknown resource type: %s", ctx.ResourceType)
+		}
+
+		if cmd := requireActiveContext(pool, ctx); cmd != nil {
+			return cmd
+		}
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: "ownership-tree",
+				FilterContext: &types.FilterContext{
+					Field: "tree",
+					Value: name,
+					Metadata: map[string]string{
+						"namespace": namespace,
+						"kind":      kind,
+						"group":     gvr.Group,
+						"version":   gvr.Version,
+						"resource":  gvr.Resource,
+					},
+				},
+			}
+		}
+	}
+}
+
+// JumpOwnerCommand returns execute function for jumping to the top-level
+// owner of the selected resource (following controller ownerReferences, any
+// kind including CRs), with the owner selected on its screen
+func JumpOwnerCommand(pool *k8s.RepositoryPool) ExecuteFunc {
+	return func(ctx CommandContext) tea.Cmd {
+		name, _ := ctx.Selected["name"].(string)
+		if name == "" {
+			return messages.ErrorCmd("No resource selected")
+		}
+		namespace, _ := ctx.Selected["namespace"].(string)
+
+		gvr, ok := selectedGVR(ctx)
+		if !ok {
+			return messages.ErrorCmd("Unknown resource type: %s", ctx.ResourceType)
+		}
+
+		// Owner lookups may hit API discovery - resolve off the UI loop
+		return func() tea.Msg {
+			repo := selectedRepository(pool, ctx)
+			if repo == nil {
+				return messages.ErrorCmd("No active repository")()
+			}
+			owner, err := repo.GetTopLevelOwner(gvr, namespace, name)
+			if err != nil {
+				return messages.ErrorCmd("Failed to find owner: %v", err)()
+			}
+			return NavigateToResourceCmd(pool, owner.GVR, owner.Kind, owner.Namespace, owner.Name)()
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+)
+
+func TestTreeCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      CommandContext
+		expected map[string]string
+	}{
+		{
+			name: "built-in resource",
+			ctx: CommandContext{
+				ResourceType: k8s.ResourceTypeDeployment,
+				Selected:     map[string]any{"name": "nginx", "namespace": "web"},
+			},
+			expected: map[string]string{
+				"namespace": "web",
+				"kind":      "",
+				"group":     "apps",
+				"version":   "v1",
+				"resource":  "deployments",
+			},
+		},
+		{
+			name: "custom resource",
+			ctx: CommandContext{
+				ResourceType: k8s.ResourceType("cert-manager.io/certificates"),
+				Selected: map[string]any{
+					"name":           "web-tls",
+					"namespace":      "web",
+					"kind":           "Certificate",
+					"__gvr_group":    "cert-manager.io",
+					"__gvr_version":  "v1",
+					"__gvr_resource": "certificates",
+				},
+			},
+			expected: map[string]string{
+				"namespace": "web",
+				"kind":      "Certificate",
+				"group":     "cert-manager.io",
+				"version":   "v1",
+				"resource":  "certificates",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := TreeCommand(nil)(tt.ctx)
+			require.NotNil(t, cmd)
+
+			switchMsg, ok := cmd().(types.ScreenSwitchMsg)
+			require.True(t, ok, "expected ScreenSwitchMsg")
+			assert.Equal(t, "ownership-tree", switchMsg.ScreenID)
+			require.NotNil(t, switchMsg.FilterContext)
+			assert.Equal(t, "tree", switchMsg.FilterContext.Field)
+			assert.Equal(t, tt.ctx.Selected["name"], switchMsg.FilterContext.Value)
+			assert.Equal(t, tt.expected, switchMsg.FilterContext.Metadata)
+		})
+	}
+}
+
+func TestTreeCommand_NoSelection(t *testing.T) {
+	cmd := TreeCommand(nil)(CommandContext{
+		ResourceType: k8s.ResourceTypeDeployment,
+		Selected:     map[string]any{},
+	})
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	statusMsg, ok := msg.(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg, got %T", msg)
+	assert.Equal(t, types.MessageTypeError, statusMsg.Type)
+}
+
+func TestJumpOwnerCommand_NoSelection(t *testing.T) {
+	cmd := JumpOwnerCommand(nil)(CommandContext{
+		ResourceType: k8s.ResourceTypePod,
+		Selected:     map[string]any{},
+	})
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	statusMsg, ok := msg.(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg, got %T", msg)
+	assert.Equal(t, types.MessageTypeError, statusMsg.Type)
+}
+
+func TestNavigateToResourceCmd(t *testing.T) {
+	t.Run("built-in resource switches to its screen", func(t *testing.T) {
+		gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}
+		msg := NavigateToResourceCmd(&mockRepository{}, gvr, "ReplicaSet", "web", "nginx-abc123")()
+
+		switchMsg, ok := msg.(types.ScreenSwitchMsg)
+		require.True(t, ok, "expected ScreenSwitchMsg, got %T", msg)
+		assert.Equal(t, "replicasets", switchMsg.ScreenID)
+		assert.Equal(t, "web/nginx-abc123", switchMsg.SelectKey)
+		assert.True(t, switchMsg.PushHistory)
+	})
+
+	t.Run("custom resource opens a dynamic screen", func(t *testing.T) {
+		gvr := schema.GroupVersionResource{Group: "acme.cert-manager.io", Version: "v1", Resource: "orders"}
+		msg := NavigateToResourceCmd(&mockRepository{}, gvr, "Order", "web", "web-tls-1")()
+
+		createMsg, ok := msg.(types.DynamicScreenCreateMsg)
+		require.True(t, ok, "expected DynamicScreenCreateMsg, got %T", msg)
+		assert.Equal(t, "web/web-tls-1", createMsg.SelectKey)
+		assert.Equal(t, k8s.CustomResourceDefinition{
+			Group:   "acme.cert-manager.io",
+			Version: "v1",
+			Kind:    "Order",
+			Plural:  "orders",
+			Scope:   "Namespaced",
+		}, createMsg.CRD)
+	})
+
+	t.Run("cluster-scoped custom resource", func(t *testing.T) {
+		gvr := schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "clusterissuers"}
+		msg := NavigateToResourceCmd(nil, gvr, "ClusterIssuer", "", "letsencrypt")()
+
+		createMsg, ok := msg.(types.DynamicScreenCreateMsg)
+		require.True(t, ok, "expected DynamicScreenCreateMsg, got %T", msg)
+		assert.Equal(t, "/letsencrypt", createMsg.SelectKey)
+		assert.Equal(t, "Cluster", createMsg.CRD.(k8s.CustomResourceDefinition).Scope)
+	})
+}
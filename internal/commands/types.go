@@ -55,6 +55,9 @@ type Command struct {
 	Description       string             // Human-readable description
 	Category          CommandCategory    // Command category
 	NeedsConfirmation bool               // Whether the command requires confirmation
+	Mutating          bool               // Whether the command changes the cluster (hidden in read-only mode)
+	Verb              string             // RBAC verb needed on the selected resource (empty = not checked)
+	Subresource       string             // RBAC subresource of Verb (e.g. "log", "exec")
 	Execute           ExecuteFunc        // Execution function
 	ResourceTypes     []k8s.ResourceType // Resource types this command applies to (empty = all)
 	Shortcut          string             // Keyboard shortcut (e.g., "ctrl+y")
@@ -9,9 +9,13 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/renato0307/k1/internal/commands"
+	"github.com/renato0307/k1/internal/config"
+	"github.com/renato0307/k1/internal/history"
 	"github.com/renato0307/k1/internal/k8s"
 	"github.com/renato0307/k1/internal/keyboard"
+	"github.com/renato0307/k1/internal/llm"
 	"github.com/renato0307/k1/internal/logging"
+	"github.com/renato0307/k1/internal/messages"
 	"github.com/renato0307/k1/internal/types"
 	"github.com/renato0307/k1/internal/ui"
 )
@@ -35,6 +39,7 @@ var usageTips = []string{
 	"[tip: use -kubeconfig for custom kubeconfig path]",
 	"[tip: use -dummy to explore k1 without a cluster]",
 	"[tip: resources refresh automatically every 10 seconds]",
+	"[tip: press ctrl+r in the palette to search command history]",
 }
 
 // CommandBar coordinates all command bar components and manages state machine.
@@ -49,6 +54,7 @@ type CommandBar struct {
 	// Context
 	screenID         string
 	selectedResource map[string]any
+	accessKey        string // Selection the RBAC denials were computed for
 
 	// Components
 	history  *History
@@ -57,6 +63,16 @@ type CommandBar struct {
 	executor *Executor
 	registry *commands.Registry
 
+	// Persisted history of the current context
+	historyStore *history.Store
+	context      string
+
+	// Reverse history search (ctrl+r)
+	searchQuery  string
+	searchMatch  string
+	searchPos    int  // Position of searchMatch in history
+	searchFailed bool // The query has no match before searchPos
+
 	// Tip rotation state
 	currentTipIndex int
 	lastTipRotation time.Time
@@ -95,6 +111,63 @@ func (cb *CommandBar) Init() tea.Cmd {
 	})
 }
 
+// SetLLMProvider sets the provider used to translate /ai prompts.
+func (cb *CommandBar) SetLLMProvider(provider llm.Provider) {
+	cb.registry.SetLLMProvider(provider)
+}
+
+// SetHistoryStore sets the store persisting command history and loads the
+// history of context.
+func (cb *CommandBar) SetHistoryStore(store *history.Store, context string) {
+	cb.historyStore = store
+	cb.context = context
+	cb.loadHistory()
+	cb.applyPolicy()
+}
+
+// SetContext switches command history and protection policy to the given
+// context.
+func (cb *CommandBar) SetContext(context string) {
+	if context != cb.context {
+		cb.context = context
+		cb.loadHistory()
+	}
+	cb.applyPolicy()
+}
+
+// SetPolicies sets the function resolving the protection policy of a
+// context.
+func (cb *CommandBar) SetPolicies(policyFor func(context string) config.Policy) {
+	cb.executor.SetPolicies(policyFor)
+	cb.applyPolicy()
+}
+
+// applyPolicy applies the policy of the current context (read-only contexts
+// hide mutating commands from the palette).
+func (cb *CommandBar) applyPolicy() {
+	cb.executor.SetContext(cb.context)
+	cb.palette.SetReadOnly(cb.executor.Policy(cb.context).ReadOnly)
+}
+
+// loadHistory replaces history with the persisted history of the context.
+func (cb *CommandBar) loadHistory() {
+	entries, err := cb.historyStore.LoadCommands(cb.context)
+	if err != nil {
+		logging.Warn("Failed to load command history", "context", cb.context, "error", err)
+	}
+	cb.history.Load(entries)
+}
+
+// recordHistory adds a command to history and persists it.
+func (cb *CommandBar) recordHistory(cmd string) {
+	if !cb.history.Add(cmd) {
+		return
+	}
+	if err := cb.historyStore.AppendCommand(cb.context, cmd); err != nil {
+		logging.Warn("Failed to save command history", "context", cb.context, "error", err)
+	}
+}
+
 // SetWidth updates component widths.
 func (cb *CommandBar) SetWidth(width int) {
 	cb.width = width
@@ -111,6 +184,49 @@ func (cb *CommandBar) SetScreen(screenID string) {
 // SetSelectedResource updates the selected resource for command execution.
 func (cb *CommandBar) SetSelectedResource(resource map[string]any) {
 	cb.selectedResource = resource
+
+	// Denials of the previous selection no longer apply
+	if key := cb.selectionKey(); key != cb.accessKey {
+		cb.setAccessDenials("", nil)
+	}
+}
+
+// selectionKey identifies the selected resource for RBAC denials ("" = no
+// resource selected).
+func (cb *CommandBar) selectionKey() string {
+	name, _ := cb.selectedResource["name"].(string)
+	if name == "" {
+		return ""
+	}
+	namespace, _ := cb.selectedResource["namespace"].(string)
+	contextName, _ := cb.selectedResource["context"].(string)
+	if contextName == "" {
+		contextName = cb.context
+	}
+	return strings.Join([]string{contextName, cb.screenID, namespace, name}, "/")
+}
+
+// checkAccess computes in the background which action commands the user
+// cannot run on the selected resource (access reviews are cached by the
+// repository, but a cache miss hits the API server).
+func (cb *CommandBar) checkAccess() tea.Cmd {
+	key := cb.selectionKey()
+	if key == "" {
+		return nil
+	}
+	ctx := cb.executor.BuildContext(k8s.ResourceType(cb.screenID), cb.selectedResource, "", "")
+	registry := cb.registry
+	return func() tea.Msg {
+		return accessDenialsMsg{key: key, denials: registry.AccessDenials(ctx)}
+	}
+}
+
+// setAccessDenials greys out denied commands in the palette and refuses
+// them on execution.
+func (cb *CommandBar) setAccessDenials(key string, denials map[string]string) {
+	cb.accessKey = key
+	cb.palette.SetDenied(denials)
+	cb.executor.SetAccessDenials(denials)
 }
 
 // GetHeight returns the current height (including separators, not hints).
@@ -175,6 +291,14 @@ func (cb *CommandBar) Update(msg tea.Msg) (*CommandBar, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		return cb.handleKeyMsg(msg)
+	case types.LLMTranslationMsg:
+		return cb.handleLLMTranslation(msg)
+	case accessDenialsMsg:
+		// Ignore denials of a selection that changed meanwhile
+		if msg.key == cb.selectionKey() {
+			cb.setAccessDenials(msg.key, msg.denials)
+		}
+		return cb, nil
 	case tipRotationMsg:
 		// Rotate to random tip (avoid showing same tip twice in a row)
 		oldIndex := cb.currentTipIndex
@@ -227,6 +351,8 @@ func (cb *CommandBar) handleKeyMsg(msg tea.KeyMsg) (*CommandBar, tea.Cmd) {
 		return cb.handleLLMPreviewState(msg)
 	case StateResult:
 		return cb.handleResultState(msg)
+	case StateHistorySearch:
+		return cb.handleHistorySearchState(msg)
 	}
 
 	return cb, nil
@@ -269,8 +395,7 @@ func (cb *CommandBar) handleHiddenState(msg tea.KeyMsg) (*CommandBar, tea.Cmd) {
 		}
 
 	case cb.keys.PaletteActivate, ">": // "ctrl+p" or ">"
-		cb.transitionToPalette(">", CommandTypeAction)
-		return cb, nil
+		return cb, cb.transitionToPalette(">", CommandTypeAction)
 
 	default:
 		// REMOVED: type-to-filter behavior
@@ -424,6 +549,9 @@ func (cb *CommandBar) handlePaletteState(msg tea.KeyMsg) (*CommandBar, tea.Cmd)
 
 	case "tab":
 		return cb.handlePaletteTab()
+
+	case "ctrl+r":
+		return cb.startHistorySearch()
 	}
 
 	return cb, nil
@@ -461,31 +589,27 @@ func (cb *CommandBar) handlePaletteEnter() (*CommandBar, tea.Cmd) {
 	}
 	commandStr := prefix + selected.Name
 
-	// Check if needs confirmation
-	if selected.NeedsConfirmation {
-		cb.executor.pendingCommand = selected
+	// Execute through the executor so access, read-only and protected
+	// context policies apply as for typed commands
+	ctx := cb.executor.BuildContext(k8s.ResourceType(cb.screenID), cb.selectedResource, "", commandStr)
+	cmd, needsConfirm := cb.executor.Execute(selected.Name, selected.Category, ctx)
+	cb.palette.Reset()
+
+	if needsConfirm {
 		cb.input.Set(commandStr) // Store for history after confirmation
 		cb.state = StateConfirmation
 		cb.height = 5
-		cb.palette.Reset()
 		return cb, nil
 	}
 
-	// Add to history
-	cb.history.Add(commandStr)
-
-	// Execute command
-	var cmd tea.Cmd
-	if selected.Execute != nil {
-		ctx := cb.executor.BuildContext(k8s.ResourceType(cb.screenID), cb.selectedResource, "", commandStr)
-		cmd = selected.Execute(ctx)
+	if cmd != nil {
+		cb.recordHistory(commandStr)
 	}
 
 	// Return to hidden
 	cb.state = StateHidden
 	cb.input.Clear()
 	cb.height = 1
-	cb.palette.Reset()
 
 	return cb, cmd
 }
@@ -554,12 +678,10 @@ func (cb *CommandBar) handleInputState(msg tea.KeyMsg) (*CommandBar, tea.Cmd) {
 			return cb, nil
 		}
 		if input == "/" {
-			cb.transitionToPalette("/", CommandTypeAction)
-			return cb, nil
+			return cb, cb.transitionToPalette("/", CommandTypeAction)
 		}
 		if input == "/ai" {
-			cb.transitionToPalette("/ai", CommandTypeAction)
-			return cb, nil
+			return cb, cb.transitionToPalette("/ai", CommandTypeAction)
 		}
 		return cb, nil
 	}
@@ -588,6 +710,9 @@ func (cb *CommandBar) handleInputState(msg tea.KeyMsg) (*CommandBar, tea.Cmd) {
 
 	case "enter":
 		return cb.handleInputEnter()
+
+	case "ctrl+r":
+		return cb.startHistorySearch()
 	}
 
 	return cb, nil
@@ -611,14 +736,16 @@ func (cb *CommandBar) handleInputEnter() (*CommandBar, tea.Cmd) {
 			return cb, nil
 		}
 
-		// Translate with mock LLM
-		translation := commands.TranslateWithMockLLM(prompt)
-		cb.executor.SetLLMTranslation(&translation)
+		// Translate in the background, preview shows progress until the
+		// LLMTranslationMsg arrives
+		ctx := cb.executor.BuildContext(k8s.ResourceType(cb.screenID), cb.selectedResource, prompt, inputStr)
+		cmd, _ := cb.executor.Execute("ai", commands.CategoryLLMAction, ctx)
+		cb.executor.SetLLMTranslation(&llm.Translation{Prompt: prompt})
 
 		// Transition to LLM preview
 		cb.state = StateLLMPreview
 		cb.height = 6
-		return cb, nil
+		return cb, cmd
 	}
 
 	// Parse and execute other commands
@@ -653,7 +780,7 @@ func (cb *CommandBar) handleInputEnter() (*CommandBar, tea.Cmd) {
 	}
 
 	if cmd != nil {
-		cb.history.Add(inputStr)
+		cb.recordHistory(inputStr)
 		cb.state = StateHidden
 		cb.input.Clear()
 		cb.height = 1
@@ -681,9 +808,13 @@ func (cb *CommandBar) handleConfirmationState(msg tea.KeyMsg) (*CommandBar, tea.
 		return cb, nil
 
 	case "enter":
+		if !cb.executor.PendingConfirmed() {
+			return cb, nil // Typed confirmation does not match yet
+		}
+
 		// Add to history
 		originalCmd := cb.input.Get()
-		cb.history.Add(originalCmd)
+		cb.recordHistory(originalCmd)
 
 		// Execute pending command
 		ctx := cb.executor.BuildContext(k8s.ResourceType(cb.screenID), cb.selectedResource, "", originalCmd)
@@ -695,10 +826,46 @@ func (cb *CommandBar) handleConfirmationState(msg tea.KeyMsg) (*CommandBar, tea.
 		cb.height = 1
 		return cb, cmd
 	}
+
+	if cb.executor.PendingNeedsTypedConfirmation() {
+		confirm := cb.executor.GetConfirmInput()
+		switch msg.Type {
+		case tea.KeyBackspace:
+			if len(confirm) > 0 {
+				cb.executor.SetConfirmInput(confirm[:len(confirm)-1])
+			}
+		case tea.KeyRunes:
+			cb.executor.SetConfirmInput(confirm + string(msg.Runes))
+		}
+	}
+	return cb, nil
+}
+
+// handleLLMTranslation shows the translated command in the preview, unless
+// the preview was cancelled or is waiting for another prompt.
+func (cb *CommandBar) handleLLMTranslation(msg types.LLMTranslationMsg) (*CommandBar, tea.Cmd) {
+	pending := cb.executor.GetLLMTranslation()
+	if cb.state != StateLLMPreview || pending == nil || pending.Prompt != msg.Prompt {
+		return cb, nil
+	}
+
+	if msg.Err != nil {
+		cb.state = StateHidden
+		cb.input.Clear()
+		cb.height = 1
+		cb.executor.ClearLLMTranslation()
+		return cb, messages.ErrorCmd("AI translation failed: %v", msg.Err)
+	}
+
+	cb.executor.SetLLMTranslation(msg.Translation)
+	cb.executor.SetLLMAnalysis(msg.Analysis, msg.Run, msg.Context)
+	cb.height = lipgloss.Height(cb.executor.ViewLLMPreview())
 	return cb, nil
 }
 
-// handleLLMPreviewState handles LLM command preview.
+// handleLLMPreviewState handles LLM command preview. Rejected commands can
+// only be cancelled; destructive ones run once their confirmation token is
+// typed.
 func (cb *CommandBar) handleLLMPreviewState(msg tea.KeyMsg) (*CommandBar, tea.Cmd) {
 	switch msg.String() {
 	case "esc":
@@ -709,34 +876,158 @@ func (cb *CommandBar) handleLLMPreviewState(msg tea.KeyMsg) (*CommandBar, tea.Cm
 		return cb, nil
 
 	case "enter":
-		// Add to history
-		cb.history.Add(cb.input.Get())
-
-		translation := cb.executor.GetLLMTranslation()
-		if translation != nil {
-			cb.state = StateResult
-			cb.input.Set("Command would execute: " + translation.Command)
-			cb.height = 2
-			cb.executor.ClearLLMTranslation()
-			return cb, nil
+		run, ok := cb.executor.ConfirmLLM()
+		if !ok {
+			return cb, nil // Translating, rejected or not confirmed yet
 		}
 
+		// Add to history
+		cb.recordHistory(cb.input.Get())
+
 		cb.state = StateHidden
 		cb.input.Clear()
 		cb.height = 1
+		cb.executor.ClearLLMTranslation()
+		return cb, run
+	}
+
+	if cb.executor.NeedsTypedConfirmation() {
+		confirm := cb.executor.GetLLMConfirmInput()
+		switch msg.Type {
+		case tea.KeyBackspace:
+			if len(confirm) > 0 {
+				cb.executor.SetLLMConfirmInput(confirm[:len(confirm)-1])
+			}
+		case tea.KeyRunes:
+			cb.executor.SetLLMConfirmInput(confirm + string(msg.Runes))
+		}
 		return cb, nil
+	}
 
-	case "e":
+	if msg.String() == "e" {
 		// Edit mode - for now just dismiss
 		cb.state = StateHidden
 		cb.input.Clear()
 		cb.height = 1
 		cb.executor.ClearLLMTranslation()
+	}
+	return cb, nil
+}
+
+// startHistorySearch starts a reverse incremental search over history.
+func (cb *CommandBar) startHistorySearch() (*CommandBar, tea.Cmd) {
+	cb.state = StateHistorySearch
+	cb.searchQuery = ""
+	cb.searchMatch = ""
+	cb.searchPos = cb.history.Size()
+	cb.searchFailed = false
+	cb.height = 1
+	cb.palette.Reset()
+	return cb, nil
+}
+
+// handleHistorySearchState handles reverse history search: typing narrows
+// the match, ctrl+r finds the next older match, enter runs it and tab
+// edits it.
+func (cb *CommandBar) handleHistorySearchState(msg tea.KeyMsg) (*CommandBar, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		cb.state = StateHidden
+		cb.input.Clear()
+		cb.height = 1
+		cb.history.Reset()
 		return cb, nil
+
+	case "ctrl+r":
+		if cb.searchMatch != "" {
+			cb.searchHistory(cb.searchPos)
+		}
+		return cb, nil
+
+	case "enter":
+		if cb.searchMatch == "" {
+			return cb, nil
+		}
+		cb.editSearchMatch()
+		return cb.handleInputEnter()
+
+	case "tab", "right":
+		if cb.searchMatch != "" {
+			cb.editSearchMatch()
+		}
+		return cb, nil
+
+	case "backspace":
+		if len(cb.searchQuery) > 0 {
+			cb.searchQuery = cb.searchQuery[:len(cb.searchQuery)-1]
+			cb.searchHistory(cb.history.Size())
+		}
+		return cb, nil
+	}
+
+	if msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace {
+		cb.searchQuery += string(msg.Runes)
+		if msg.Type == tea.KeySpace {
+			cb.searchQuery += " "
+		}
+		// Keep the current match if it still matches
+		from := cb.history.Size()
+		if cb.searchMatch != "" {
+			from = cb.searchPos + 1
+		}
+		cb.searchHistory(from)
 	}
 	return cb, nil
 }
 
+// searchHistory finds the newest match of the query before position.
+// Without a match, the previous match and its position stay (shown as
+// failing) so the search resumes from there.
+func (cb *CommandBar) searchHistory(before int) {
+	cb.searchFailed = false
+	if cb.searchQuery == "" {
+		cb.searchMatch = ""
+		cb.searchPos = cb.history.Size()
+		return
+	}
+	match, pos, found := cb.history.Search(cb.searchQuery, before)
+	if !found {
+		cb.searchFailed = true
+		return
+	}
+	cb.searchMatch = match
+	cb.searchPos = pos
+}
+
+// editSearchMatch puts the search match in the input for editing.
+func (cb *CommandBar) editSearchMatch() {
+	cb.state = StateInput
+	cb.input.Set(cb.searchMatch)
+	cb.height = 1
+	switch {
+	case strings.HasPrefix(cb.searchMatch, "/ai "):
+		cb.inputType = CommandTypeLLMAction
+	case strings.HasPrefix(cb.searchMatch, ":"):
+		cb.inputType = CommandTypeResource
+	default:
+		cb.inputType = CommandTypeAction
+	}
+}
+
+// viewHistorySearch renders the reverse history search line.
+func (cb *CommandBar) viewHistorySearch() string {
+	label := "(reverse-i-search)"
+	if cb.searchFailed {
+		label = "(failed reverse-i-search)"
+	}
+	labelStyle := lipgloss.NewStyle().Foreground(cb.theme.Dimmed)
+	barStyle := lipgloss.NewStyle().
+		Foreground(cb.theme.Foreground).
+		Width(cb.width).
+		Padding(0, 1)
+	return barStyle.Render(labelStyle.Render(label+"`"+cb.searchQuery+"': ") + cb.searchMatch + "█")
+}
+
 // handleResultState handles result display.
 func (cb *CommandBar) handleResultState(msg tea.KeyMsg) (*CommandBar, tea.Cmd) {
 	switch msg.String() {
@@ -749,8 +1040,9 @@ func (cb *CommandBar) handleResultState(msg tea.KeyMsg) (*CommandBar, tea.Cmd) {
 	return cb, nil
 }
 
-// transitionToPalette transitions to palette state.
-func (cb *CommandBar) transitionToPalette(input string, cmdType CommandType) {
+// transitionToPalette transitions to palette state. Returns the access check
+// of the selected resource for action palettes.
+func (cb *CommandBar) transitionToPalette(input string, cmdType CommandType) tea.Cmd {
 	cb.state = StateSuggestionPalette
 	cb.input.Set(input)
 	cb.inputType = cmdType
@@ -763,6 +1055,11 @@ func (cb *CommandBar) transitionToPalette(input string, cmdType CommandType) {
 
 	cb.palette.Filter(query, cmdType, cb.screenID)
 	cb.height = 1 + cb.palette.GetHeight()
+
+	if cmdType == CommandTypeAction {
+		return cb.checkAccess()
+	}
+	return nil
 }
 
 // View renders the command bar.
@@ -786,6 +1083,8 @@ func (cb *CommandBar) View() string {
 		content = cb.executor.ViewLLMPreview()
 	case StateResult:
 		content = cb.executor.ViewResult(cb.input.Get(), true)
+	case StateHistorySearch:
+		content = cb.viewHistorySearch()
 	default:
 		return ""
 	}
@@ -857,7 +1156,7 @@ func (cb *CommandBar) ExecuteCommand(name string, category commands.CommandCateg
 	return cb, cmd
 }
 
-// GetCommandByShortcut returns a command by keyboard shortcut.
-func (cb *CommandBar) GetCommandByShortcut(shortcut string) *commands.Command {
+// GetCommandsByShortcut returns the commands bound to a keyboard shortcut.
+func (cb *CommandBar) GetCommandsByShortcut(shortcut string) []*commands.Command {
 	return cb.registry.GetByShortcut(shortcut)
 }
@@ -1,12 +1,24 @@
 package commandbar
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/renato0307/k1/internal/commands"
+	"github.com/renato0307/k1/internal/config"
+	"github.com/renato0307/k1/internal/history"
+	"github.com/renato0307/k1/internal/k8s"
 	"github.com/renato0307/k1/internal/keyboard"
+	"github.com/renato0307/k1/internal/llm"
+	"github.com/renato0307/k1/internal/types"
 	"github.com/renato0307/k1/internal/ui"
 )
 
@@ -125,3 +137,401 @@ func TestCommandBar_TipsArrayValid(t *testing.T) {
 		assert.NotEqual(t, "", tip, "Tip at index %d should not be empty", i)
 	}
 }
+
+// fakeLLMProvider records the last request and answers with a fixed command
+type fakeLLMProvider struct {
+	request llm.Request
+	err     error
+}
+
+func (p *fakeLLMProvider) Name() string { return "fake-model" }
+
+func (p *fakeLLMProvider) Translate(_ context.Context, req llm.Request) (*llm.Translation, error) {
+	p.request = req
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &llm.Translation{Prompt: req.Prompt, Command: "kubectl get pods -n web", Explanation: "Lists pods"}, nil
+}
+
+func TestCommandBar_AITranslation(t *testing.T) {
+	pool := createTestPool(t)
+	cb := New(pool, ui.GetTheme("charm"), keyboard.GetKeys())
+	provider := &fakeLLMProvider{}
+	cb.SetLLMProvider(provider)
+	cb.SetScreen("pods")
+	cb.SetSelectedResource(map[string]any{"name": "nginx", "namespace": "web"})
+
+	cb.state = StateInput
+	cb.input.Set("/ai list pods here")
+	cb, cmd := cb.handleInputEnter()
+	require.NotNil(t, cmd)
+	assert.Equal(t, StateLLMPreview, cb.state)
+	assert.Contains(t, cb.executor.ViewLLMPreview(), "Translating with fake-model")
+
+	// Enter is ignored until the translation arrives
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, StateLLMPreview, cb.state)
+
+	msg := cmd()
+	assert.Equal(t, "list pods here", provider.request.Prompt)
+	assert.Equal(t, "pods", provider.request.Screen)
+	assert.Equal(t, "web", provider.request.Namespace)
+	assert.Equal(t, "nginx", provider.request.Selected["name"])
+
+	cb, _ = cb.Update(msg)
+	assert.Equal(t, StateLLMPreview, cb.state)
+	assert.Contains(t, cb.executor.ViewLLMPreview(), "kubectl get pods -n web")
+}
+
+func TestCommandBar_AITranslationError(t *testing.T) {
+	pool := createTestPool(t)
+	cb := New(pool, ui.GetTheme("charm"), keyboard.GetKeys())
+	cb.SetLLMProvider(&fakeLLMProvider{err: errors.New("connection refused")})
+
+	cb.state = StateInput
+	cb.input.Set("/ai list pods")
+	cb, cmd := cb.handleInputEnter()
+	require.NotNil(t, cmd)
+
+	cb, statusCmd := cb.Update(cmd())
+	assert.Equal(t, StateHidden, cb.state)
+	require.NotNil(t, statusCmd)
+	status, ok := statusCmd().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Contains(t, status.Message, "connection refused")
+
+	// Late results of a cancelled prompt are dropped
+	cb, _ = cb.Update(types.LLMTranslationMsg{Prompt: "list pods", Translation: &llm.Translation{Command: "kubectl get pods"}})
+	assert.Equal(t, StateHidden, cb.state)
+}
+
+// newAIPreview puts the command bar in the AI preview of command, vetted by
+// the safety gate (run returns a marker message)
+func newAIPreview(t *testing.T, command string, rejection error) *CommandBar {
+	t.Helper()
+	pool := createTestPool(t)
+	cb := New(pool, ui.GetTheme("charm"), keyboard.GetKeys())
+	cb.SetLLMProvider(&fakeLLMProvider{})
+
+	cb.state = StateInput
+	cb.input.Set("/ai do it")
+	cb, _ = cb.handleInputEnter()
+	require.Equal(t, StateLLMPreview, cb.state)
+
+	op, err := llm.ParseCommand(command)
+	require.NoError(t, err)
+	msg := types.LLMTranslationMsg{
+		Prompt:      "do it",
+		Translation: &llm.Translation{Prompt: "do it", Command: command},
+		Analysis:    &llm.Analysis{Operation: op, Rejection: rejection},
+	}
+	if rejection == nil {
+		msg.Run = func() tea.Msg { return "ran" }
+	}
+	cb, _ = cb.Update(msg)
+	return cb
+}
+
+func TestCommandBar_AIPreview_ReadOnly(t *testing.T) {
+	cb := newAIPreview(t, "kubectl get pods -n web", nil)
+	view := cb.executor.ViewLLMPreview()
+	assert.Contains(t, view, "READ-ONLY")
+	assert.Contains(t, view, "namespace: web")
+	assert.Equal(t, lipgloss.Height(view), cb.height)
+
+	cb, cmd := cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, StateHidden, cb.state)
+	require.NotNil(t, cmd)
+	assert.Equal(t, "ran", cmd())
+}
+
+func TestCommandBar_AIPreview_Rejected(t *testing.T) {
+	cb := newAIPreview(t, "kubectl delete pod missing", errors.New("server dry-run failed: not found"))
+	view := cb.executor.ViewLLMPreview()
+	assert.Contains(t, view, "BLOCKED")
+	assert.Contains(t, view, "server dry-run failed: not found")
+
+	cb, cmd := cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, StateLLMPreview, cb.state)
+	assert.Nil(t, cmd)
+}
+
+func TestCommandBar_AIPreview_TypedConfirmation(t *testing.T) {
+	cb := newAIPreview(t, "kubectl delete pod nginx -n web", nil)
+	assert.Contains(t, cb.executor.ViewLLMPreview(), "DESTRUCTIVE")
+	assert.Contains(t, cb.executor.ViewLLMPreview(), "Type nginx to confirm")
+
+	// Enter does nothing until the token is typed
+	cb, cmd := cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, StateLLMPreview, cb.state)
+	assert.Nil(t, cmd)
+
+	// "e" is typed, not an edit shortcut
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("nginxe")})
+	assert.Equal(t, StateLLMPreview, cb.state)
+	cb, cmd = cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Contains(t, cb.executor.ViewLLMPreview(), "to confirm: nginx")
+	cb, cmd = cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, StateHidden, cb.state)
+	require.NotNil(t, cmd)
+	assert.Equal(t, "ran", cmd())
+}
+
+func TestCommandBar_HistorySearch(t *testing.T) {
+	pool := createTestPool(t)
+	cb := New(pool, ui.GetTheme("charm"), keyboard.GetKeys())
+	store := history.NewStore(t.TempDir())
+	for _, command := range []string{":pods", "/scale 3", ":deployments", "/describe pod"} {
+		require.NoError(t, store.AppendCommand("prod", command))
+	}
+	cb.SetHistoryStore(store, "prod")
+
+	// ctrl+r starts the search from the palette
+	cb.state = StateSuggestionPalette
+	cb.input.Set(":")
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	require.Equal(t, StateHistorySearch, cb.state)
+	assert.True(t, cb.IsActive())
+
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("po")})
+	assert.Equal(t, "/describe pod", cb.searchMatch)
+	assert.Contains(t, cb.View(), "(reverse-i-search)`po': /describe pod")
+
+	// ctrl+r finds older matches, keeping the last one when none is left
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	assert.Equal(t, ":pods", cb.searchMatch)
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	assert.Equal(t, ":pods", cb.searchMatch)
+	assert.Contains(t, cb.View(), "(failed reverse-i-search)")
+
+	// Editing the query searches again from the newest entry
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ale")})
+	assert.Equal(t, "/scale 3", cb.searchMatch)
+
+	// Tab puts the match in the input for editing
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, StateInput, cb.state)
+	assert.Equal(t, "/scale 3", cb.input.Get())
+	assert.Equal(t, CommandTypeAction, cb.inputType)
+
+	// Enter runs the match and records it in the persisted history
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("pods")})
+	require.Equal(t, ":pods", cb.searchMatch)
+	cb, cmd := cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Equal(t, StateHidden, cb.state)
+
+	commands, err := store.LoadCommands("prod")
+	require.NoError(t, err)
+	assert.Equal(t, ":pods", commands[len(commands)-1])
+
+	// Esc cancels the search
+	cb.state = StateInput
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, StateHidden, cb.state)
+}
+
+func TestCommandBar_HistorySearchResumesAfterFailure(t *testing.T) {
+	pool := createTestPool(t)
+	cb := New(pool, ui.GetTheme("charm"), keyboard.GetKeys())
+	store := history.NewStore(t.TempDir())
+	for _, command := range []string{":pods", "/scale 3", ":deployments", "/describe pod"} {
+		require.NoError(t, store.AppendCommand("prod", command))
+	}
+	cb.SetHistoryStore(store, "prod")
+	cb.state = StateInput
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+
+	// Type, fail, then type more: the search goes on from the last match
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("po")})
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	require.Contains(t, cb.View(), "(failed reverse-i-search)")
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	assert.Equal(t, ":pods", cb.searchMatch)
+	assert.Contains(t, cb.View(), "(reverse-i-search)`pod': :pods")
+
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	assert.Contains(t, cb.View(), "(failed reverse-i-search)`podx': :pods")
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	assert.Equal(t, ":pods", cb.searchMatch)
+	assert.Contains(t, cb.View(), "(reverse-i-search)`pods': :pods")
+}
+
+func TestCommandBar_HistoryPerContext(t *testing.T) {
+	pool := createTestPool(t)
+	cb := New(pool, ui.GetTheme("charm"), keyboard.GetKeys())
+	store := history.NewStore(t.TempDir())
+	require.NoError(t, store.AppendCommand("prod", ":pods"))
+	cb.SetHistoryStore(store, "prod")
+	assert.Equal(t, 1, cb.history.Size())
+
+	cb.SetContext("staging")
+	assert.True(t, cb.history.IsEmpty())
+
+	cb.state = StateInput
+	cb.input.Set(":nodes")
+	cb.handleInputEnter()
+
+	cb.SetContext("prod")
+	cmd, ok := cb.history.NavigateUp()
+	require.True(t, ok)
+	assert.Equal(t, ":pods", cmd)
+
+	commands, err := store.LoadCommands("staging")
+	require.NoError(t, err)
+	assert.Equal(t, []string{":nodes"}, commands)
+}
+
+func TestCommandBar_ProtectedContextConfirmation(t *testing.T) {
+	pool := createTestPool(t)
+	cb := New(pool, ui.GetTheme("charm"), keyboard.GetKeys())
+	cfg := &config.Config{Policies: []config.ContextPolicy{{Context: "prod-*", Protected: true}}}
+	cb.SetPolicies(cfg.PolicyFor)
+	cb.SetContext("prod-eu")
+	cb.SetScreen("deployments")
+	cb.SetSelectedResource(map[string]any{"name": "api", "namespace": "web"})
+
+	cb, cmd := cb.ExecuteCommand("restart", commands.CategoryAction)
+	assert.Nil(t, cmd)
+	require.Equal(t, StateConfirmation, cb.state)
+	assert.Equal(t, lipgloss.Height(cb.executor.ViewConfirmation()), cb.height)
+
+	// Enter is ignored until a name is typed
+	cb, cmd = cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	assert.Equal(t, StateConfirmation, cb.state)
+
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("apx")})
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	assert.Contains(t, cb.View(), "to confirm: api█")
+
+	cb, cmd = cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, StateHidden, cb.state)
+}
+
+// selectFromPalette opens the palette, filters it and selects the first item
+func selectFromPalette(cb *CommandBar, query string) (*CommandBar, tea.Cmd) {
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	for _, r := range query {
+		cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+}
+
+func TestCommandBar_PaletteAppliesContextPolicies(t *testing.T) {
+	pool := createTestPool(t)
+	cb := New(pool, ui.GetTheme("charm"), keyboard.GetKeys())
+	cfg := &config.Config{Policies: []config.ContextPolicy{
+		{Context: "prod-*", Protected: true},
+		{Context: "support", ReadOnly: true},
+	}}
+	cb.SetPolicies(cfg.PolicyFor)
+	cb.SetContext("prod-eu")
+	cb.SetScreen("deployments")
+	cb.SetSelectedResource(map[string]any{"name": "api", "namespace": "web"})
+
+	// Protected contexts need the name typed, also from the palette
+	cb, cmd := selectFromPalette(cb, "restart")
+	assert.Nil(t, cmd)
+	require.Equal(t, StateConfirmation, cb.state)
+	assert.True(t, cb.executor.PendingNeedsTypedConfirmation())
+	cb, cmd = cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	cb, _ = cb.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("api")})
+	cb, cmd = cb.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, StateHidden, cb.state)
+
+	// Rows of read-only contexts (aggregated lists) are refused
+	cb.SetContext("dev")
+	cb.SetSelectedResource(map[string]any{"name": "api", "namespace": "web", "context": "support"})
+	cb, cmd = selectFromPalette(cb, "restart")
+	require.NotNil(t, cmd)
+	assert.Equal(t, StateHidden, cb.state)
+	msg, ok := cmd().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Equal(t, "/restart refused: context support is read-only", msg.Message)
+}
+
+func TestCommandBar_EditKeyFollowsConfigMapContext(t *testing.T) {
+	pool := createTestPool(t)
+	cb := New(pool, ui.GetTheme("charm"), keyboard.GetKeys())
+	cfg := &config.Config{Policies: []config.ContextPolicy{{Context: "prod-*", Protected: true}}}
+	cb.SetPolicies(cfg.PolicyFor)
+	cb.SetContext("dev")
+	cb.SetScreen("configmap-data")
+
+	// The ConfigMap was opened from a row of a protected context
+	cb.SetSelectedResource(map[string]any{"key": "app.yaml", "configmap": "app-config", "namespace": "web", "context": "prod-eu"})
+	cb, cmd := cb.ExecuteCommand("edit-key", commands.CategoryAction)
+	assert.Nil(t, cmd)
+	require.Equal(t, StateConfirmation, cb.state)
+	assert.True(t, cb.executor.PendingNeedsTypedConfirmation())
+	assert.Contains(t, cb.View(), "prod-eu")
+}
+
+func TestCommandBar_ReadOnlyContextHidesMutatingCommands(t *testing.T) {
+	pool := createTestPool(t)
+	cb := New(pool, ui.GetTheme("charm"), keyboard.GetKeys())
+	cfg := &config.Config{ReadOnly: true}
+	cb.SetPolicies(cfg.PolicyFor)
+	cb.SetScreen("deployments")
+
+	cb.palette.Filter("sca", CommandTypeAction, "deployments")
+	for _, item := range cb.palette.items {
+		assert.NotEqual(t, "scale", item.Name)
+	}
+}
+
+// deleteDeniedRepository denies deleting pods
+type deleteDeniedRepository struct {
+	*k8s.DummyRepository
+}
+
+func (r *deleteDeniedRepository) CheckAccess(verb string, gvr schema.GroupVersionResource, subresource, namespace string) k8s.AccessDecision {
+	if verb == "delete" && gvr.Resource == "pods" {
+		return k8s.AccessDecision{Reason: "cannot delete pods in namespace " + namespace}
+	}
+	return k8s.AccessDecision{Allowed: true}
+}
+
+func TestCommandBar_AccessDenials(t *testing.T) {
+	pool := createTestPool(t)
+	pool.SetTestRepository("test-context", &deleteDeniedRepository{DummyRepository: k8s.NewDummyRepository()})
+	cb := New(pool, ui.GetTheme("charm"), keyboard.GetKeys())
+	cb.SetContext("test-context")
+	cb.SetScreen("pods")
+	cb.SetSelectedResource(map[string]any{"name": "api-1", "namespace": "web"})
+
+	// Opening the palette checks access in the background
+	cb, cmd := cb.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	require.NotNil(t, cmd)
+	cb, _ = cb.Update(cmd())
+	assert.Contains(t, cb.ViewPaletteItems(), "delete - Delete selected resource (cannot delete pods in namespace web)")
+	assert.NotContains(t, cb.ViewPaletteItems(), "yaml - View resource YAML (")
+
+	// Denied commands are refused without confirmation
+	cb.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	cb, cmd = cb.ExecuteCommand("delete", commands.CategoryAction)
+	require.NotNil(t, cmd)
+	assert.NotEqual(t, StateConfirmation, cb.state)
+	msg, ok := cmd().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Equal(t, "/delete refused: cannot delete pods in namespace web", msg.Message)
+
+	// Denials of a previous selection no longer apply
+	cb.SetSelectedResource(map[string]any{"name": "api-2", "namespace": "web"})
+	assert.Empty(t, cb.executor.AccessDenial("delete"))
+}
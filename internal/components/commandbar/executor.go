@@ -1,11 +1,17 @@
 package commandbar
 
 import (
+	"fmt"
+	"strings"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/renato0307/k1/internal/commands"
+	"github.com/renato0307/k1/internal/config"
 	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/llm"
+	"github.com/renato0307/k1/internal/messages"
 	"github.com/renato0307/k1/internal/types"
 	"github.com/renato0307/k1/internal/ui"
 )
@@ -19,7 +25,21 @@ type Executor struct {
 	// Pending command state (for confirmation/preview)
 	pendingCommand *commands.Command
 	pendingArgs    string
-	llmTranslation *commands.MockLLMTranslation
+	llmTranslation *llm.Translation
+	llmAnalysis    *llm.Analysis // Safety gate result of the translation
+	llmRun         tea.Cmd       // Runs the vetted command
+	llmConfirm     string        // Typed confirmation of destructive commands
+	llmContext     string        // Context the translated command runs against
+	llmProtected   bool          // Translated command runs against a protected context
+
+	// Context protection policies
+	policyFor     func(context string) config.Policy // nil = no policies
+	context       string                             // Active context
+	confirmTokens []string                           // Accepted typed confirmations of the pending command (protected contexts)
+	confirmInput  string                             // Typed confirmation of the pending command
+
+	// RBAC denials of the selected resource (command name → reason)
+	denials map[string]string
 }
 
 // NewExecutor creates a new executor.
@@ -39,6 +59,47 @@ func (e *Executor) SetWidth(width int) {
 	e.width = width
 }
 
+// SetPolicies sets the function resolving the protection policy of a
+// context.
+func (e *Executor) SetPolicies(policyFor func(context string) config.Policy) {
+	e.policyFor = policyFor
+}
+
+// SetContext sets the active context (commands on rows of other contexts
+// use the policy of their own context).
+func (e *Executor) SetContext(context string) {
+	e.context = context
+}
+
+// Policy returns the protection policy of a context.
+func (e *Executor) Policy(context string) config.Policy {
+	if e.policyFor == nil {
+		return config.Policy{}
+	}
+	return e.policyFor(context)
+}
+
+// SetAccessDenials sets the action commands the user cannot run on the
+// selected resource (nil = unknown, commands check access when they run).
+func (e *Executor) SetAccessDenials(denials map[string]string) {
+	e.denials = denials
+}
+
+// AccessDenial returns why the user cannot run an action command on the
+// selected resource ("" when allowed or unknown).
+func (e *Executor) AccessDenial(cmdName string) string {
+	return e.denials[cmdName]
+}
+
+// targetContext returns the context a command runs against: the context of
+// the selected row (aggregated lists) or the active context.
+func (e *Executor) targetContext(ctx commands.CommandContext) string {
+	if contextName, _ := ctx.Selected["context"].(string); contextName != "" {
+		return contextName
+	}
+	return e.context
+}
+
 // BuildContext creates a CommandContext for command execution.
 func (e *Executor) BuildContext(resourceType k8s.ResourceType, selected map[string]any, args string, originalCommand string) commands.CommandContext {
 	return commands.CommandContext{
@@ -58,6 +119,27 @@ func (e *Executor) Execute(cmdName string, category commands.CommandCategory, ct
 		return nil, false
 	}
 
+	// Mutating commands are refused in read-only contexts and need typed
+	// confirmation in protected contexts
+	contextName := e.targetContext(ctx)
+	policy := e.Policy(contextName)
+	if cmd.Mutating && policy.ReadOnly {
+		return messages.ErrorCmd("/%s refused: context %s is read-only", cmd.Name, contextName), false
+	}
+	if reason := e.AccessDenial(cmd.Name); reason != "" && category == commands.CategoryAction {
+		return messages.ErrorCmd("/%s refused: %s", cmd.Name, reason), false
+	}
+	if cmd.Mutating && policy.Protected {
+		e.pendingCommand = cmd
+		e.pendingArgs = ctx.Args
+		e.confirmTokens = []string{contextName}
+		if name, _ := ctx.Selected["name"].(string); name != "" {
+			e.confirmTokens = []string{name, contextName}
+		}
+		e.confirmInput = ""
+		return nil, true // Needs typed confirmation
+	}
+
 	// Check if command needs confirmation
 	if cmd.NeedsConfirmation {
 		e.pendingCommand = cmd
@@ -76,7 +158,7 @@ func (e *Executor) Execute(cmdName string, category commands.CommandCategory, ct
 // ExecutePending executes the pending command with stored args.
 // Returns tea.Cmd to execute.
 func (e *Executor) ExecutePending(ctx commands.CommandContext) tea.Cmd {
-	if e.pendingCommand == nil || e.pendingCommand.Execute == nil {
+	if e.pendingCommand == nil || e.pendingCommand.Execute == nil || !e.PendingConfirmed() {
 		return nil
 	}
 
@@ -94,15 +176,47 @@ func (e *Executor) ExecutePending(ctx commands.CommandContext) tea.Cmd {
 
 // CancelPending cancels the pending command.
 func (e *Executor) CancelPending() {
-	e.pendingCommand = nil
-	e.pendingArgs = ""
-	e.llmTranslation = nil
+	e.ClearPending()
+	e.ClearLLMTranslation()
 }
 
 // ClearPending clears the pending command state.
 func (e *Executor) ClearPending() {
 	e.pendingCommand = nil
 	e.pendingArgs = ""
+	e.confirmTokens = nil
+	e.confirmInput = ""
+}
+
+// PendingNeedsTypedConfirmation returns true if the pending command runs
+// against a protected context and must be confirmed by typing the resource
+// or context name.
+func (e *Executor) PendingNeedsTypedConfirmation() bool {
+	return e.pendingCommand != nil && len(e.confirmTokens) > 0
+}
+
+// PendingConfirmed returns true if the pending command can run (typed
+// confirmation matches, when needed).
+func (e *Executor) PendingConfirmed() bool {
+	if !e.PendingNeedsTypedConfirmation() {
+		return true
+	}
+	for _, token := range e.confirmTokens {
+		if e.confirmInput == token {
+			return true
+		}
+	}
+	return false
+}
+
+// SetConfirmInput sets the typed confirmation of the pending command.
+func (e *Executor) SetConfirmInput(input string) {
+	e.confirmInput = input
+}
+
+// GetConfirmInput returns the typed confirmation of the pending command.
+func (e *Executor) GetConfirmInput() string {
+	return e.confirmInput
 }
 
 // HasPending returns true if there's a pending command.
@@ -116,18 +230,86 @@ func (e *Executor) GetPendingCommand() *commands.Command {
 }
 
 // SetLLMTranslation sets the LLM translation result for preview.
-func (e *Executor) SetLLMTranslation(translation *commands.MockLLMTranslation) {
+func (e *Executor) SetLLMTranslation(translation *llm.Translation) {
 	e.llmTranslation = translation
 }
 
 // GetLLMTranslation returns the LLM translation result.
-func (e *Executor) GetLLMTranslation() *commands.MockLLMTranslation {
+func (e *Executor) GetLLMTranslation() *llm.Translation {
 	return e.llmTranslation
 }
 
-// ClearLLMTranslation clears the LLM translation result.
+// ClearLLMTranslation clears the LLM translation result and its analysis.
 func (e *Executor) ClearLLMTranslation() {
 	e.llmTranslation = nil
+	e.llmAnalysis = nil
+	e.llmRun = nil
+	e.llmConfirm = ""
+	e.llmContext = ""
+	e.llmProtected = false
+}
+
+// SetLLMAnalysis sets the safety gate result of the translation, the
+// command that runs it (nil when rejected) and the context it runs against.
+// Commands changing a read-only context are rejected.
+func (e *Executor) SetLLMAnalysis(analysis *llm.Analysis, run tea.Cmd, contextName string) {
+	policy := e.Policy(contextName)
+	if policy.ReadOnly && analysis.Runnable() && analysis.Operation.Class != llm.ClassReadOnly {
+		analysis = &llm.Analysis{Rejection: fmt.Errorf("context %s is read-only", contextName)}
+		run = nil
+	}
+	e.llmAnalysis = analysis
+	e.llmRun = run
+	e.llmConfirm = ""
+	e.llmContext = contextName
+	e.llmProtected = policy.Protected
+}
+
+// GetLLMAnalysis returns the safety gate result of the translation.
+func (e *Executor) GetLLMAnalysis() *llm.Analysis {
+	return e.llmAnalysis
+}
+
+// NeedsTypedConfirmation returns true if the translated command is
+// destructive, or changes a protected context, and must be confirmed by
+// typing its confirmation token.
+func (e *Executor) NeedsTypedConfirmation() bool {
+	if !e.llmAnalysis.Runnable() {
+		return false
+	}
+	class := e.llmAnalysis.Operation.Class
+	return class == llm.ClassDestructive || (e.llmProtected && class != llm.ClassReadOnly)
+}
+
+// SetLLMConfirmInput sets the typed confirmation.
+func (e *Executor) SetLLMConfirmInput(input string) {
+	e.llmConfirm = input
+}
+
+// GetLLMConfirmInput returns the typed confirmation.
+func (e *Executor) GetLLMConfirmInput() string {
+	return e.llmConfirm
+}
+
+// ConfirmLLM returns the command running the translation, or false if the
+// translation was rejected or the typed confirmation does not match.
+func (e *Executor) ConfirmLLM() (tea.Cmd, bool) {
+	if !e.llmAnalysis.Runnable() || e.llmRun == nil {
+		return nil, false
+	}
+	if e.NeedsTypedConfirmation() && !e.llmConfirmed() {
+		return nil, false
+	}
+	return e.llmRun, true
+}
+
+// llmConfirmed returns true if the typed confirmation matches the
+// confirmation token (or the context name, in protected contexts).
+func (e *Executor) llmConfirmed() bool {
+	if e.llmConfirm == e.llmAnalysis.Operation.ConfirmationToken() {
+		return true
+	}
+	return e.llmProtected && e.llmConfirm == e.llmContext
 }
 
 // ViewConfirmation renders confirmation prompt.
@@ -155,6 +337,15 @@ func (e *Executor) ViewConfirmation() string {
 
 	// Build confirmation content
 	lines := []string{}
+	if e.PendingNeedsTypedConfirmation() {
+		contextName := e.confirmTokens[len(e.confirmTokens)-1]
+		lines = append(lines, titleStyle.Render("⚠ Protected context "+contextName))
+		lines = append(lines, textStyle.Render("Command: /"+e.pendingCommand.Name))
+		lines = append(lines, textStyle.Render("Type "+strings.Join(e.confirmTokens, " or ")+" to confirm: "+e.confirmInput+"█"))
+		lines = append(lines, textStyle.Render(""))
+		lines = append(lines, hintStyle.Render("[Enter] Confirm  [ESC] Cancel"))
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
 	lines = append(lines, titleStyle.Render("⚠ Confirm Action"))
 	lines = append(lines, textStyle.Render(""))
 	lines = append(lines, textStyle.Render("Command: /"+e.pendingCommand.Name))
@@ -202,13 +393,89 @@ func (e *Executor) ViewLLMPreview() string {
 	lines := []string{}
 	lines = append(lines, titleStyle.Render("🤖 AI Command Preview"))
 	lines = append(lines, promptStyle.Render("Prompt: "+e.llmTranslation.Prompt))
+	if e.llmTranslation.Command == "" {
+		// Translation still running
+		model := "LLM"
+		if provider := e.registry.LLMProvider(); provider != nil {
+			model = provider.Name()
+		}
+		lines = append(lines, explanationStyle.Render("Translating with "+model+"…"))
+		lines = append(lines, hintStyle.Render("[ESC] Cancel"))
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
 	lines = append(lines, commandStyle.Render("Command: "+e.llmTranslation.Command))
 	lines = append(lines, explanationStyle.Render(e.llmTranslation.Explanation))
-	lines = append(lines, hintStyle.Render("[Enter] Execute  [e] Edit  [ESC] Cancel"))
+
+	analysis := e.llmAnalysis
+	switch {
+	case analysis == nil:
+		lines = append(lines, hintStyle.Render("[ESC] Cancel"))
+	case !analysis.Runnable():
+		lines = append(lines, e.renderClass("BLOCKED", e.theme.Error))
+		lines = append(lines, explanationStyle.Render("Reason: "+analysis.Rejection.Error()))
+		lines = append(lines, hintStyle.Render("[ESC] Cancel"))
+	default:
+		op := analysis.Operation
+		classColor := e.theme.Success
+		switch op.Class {
+		case llm.ClassMutating:
+			classColor = e.theme.Warning
+		case llm.ClassDestructive:
+			classColor = e.theme.Error
+		}
+		namespace := op.Namespace
+		if namespace == "" {
+			namespace = "(default)"
+		}
+		lines = append(lines, e.renderClass(op.Class.String()+"  verb: "+op.Verb+"  namespace: "+namespace, classColor))
+		for _, line := range dryRunLines(analysis.DryRun) {
+			lines = append(lines, explanationStyle.Render(line))
+		}
+		if e.NeedsTypedConfirmation() {
+			token := op.ConfirmationToken()
+			if e.llmProtected {
+				token += " or " + e.llmContext
+			}
+			lines = append(lines, explanationStyle.Render("Type "+token+" to confirm: "+e.llmConfirm+"█"))
+			lines = append(lines, hintStyle.Render("[Enter] Execute  [ESC] Cancel"))
+		} else {
+			lines = append(lines, hintStyle.Render("[Enter] Execute  [e] Edit  [ESC] Cancel"))
+		}
+	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// renderClass renders the operation class line of the LLM preview.
+func (e *Executor) renderClass(text string, color lipgloss.AdaptiveColor) string {
+	return lipgloss.NewStyle().
+		Foreground(color).
+		Bold(true).
+		Width(e.width).
+		Padding(0, 1).
+		Render(text)
+}
+
+// maxDryRunLines caps the dry-run output shown in the LLM preview.
+const maxDryRunLines = 5
+
+// dryRunLines returns the dry-run output lines for the preview, capped at
+// maxDryRunLines.
+func dryRunLines(output string) []string {
+	if output == "" {
+		return nil
+	}
+	lines := strings.Split(output, "\n")
+	if len(lines) > maxDryRunLines {
+		more := len(lines) - maxDryRunLines + 1
+		lines = append(lines[:maxDryRunLines-1], fmt.Sprintf("… %d more", more))
+	}
+	for i, line := range lines {
+		lines[i] = "Dry-run: " + line
+	}
+	return lines
+}
+
 // ViewResult renders result message using the shared ui.RenderMessage function.
 // This ensures consistent message styling across all components (DRY).
 func (e *Executor) ViewResult(message string, success bool) string {
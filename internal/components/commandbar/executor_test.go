@@ -3,11 +3,16 @@ package commandbar
 import (
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/renato0307/k1/internal/commands"
+	"github.com/renato0307/k1/internal/config"
 	"github.com/renato0307/k1/internal/k8s"
 	"github.com/renato0307/k1/internal/keyboard"
+	"github.com/renato0307/k1/internal/llm"
+	"github.com/renato0307/k1/internal/types"
 	"github.com/renato0307/k1/internal/ui"
 )
 
@@ -127,7 +132,7 @@ func TestExecutor_LLMTranslation(t *testing.T) {
 
 	exec := NewExecutor(registry, theme, 80)
 
-	translation := &commands.MockLLMTranslation{
+	translation := &llm.Translation{
 		Prompt:      "show me pods",
 		Command:     "kubectl get pods",
 		Explanation: "Lists all pods in the current namespace",
@@ -174,8 +179,13 @@ func TestExecutor_ViewLLMPreview(t *testing.T) {
 	view := exec.ViewLLMPreview()
 	assert.Equal(t, "", view)
 
+	// Translation in progress
+	exec.SetLLMTranslation(&llm.Translation{Prompt: "show me pods"})
+	view = exec.ViewLLMPreview()
+	assert.Contains(t, view, "Translating with LLM")
+
 	// With translation
-	translation := &commands.MockLLMTranslation{
+	translation := &llm.Translation{
 		Prompt:      "show me pods",
 		Command:     "kubectl get pods",
 		Explanation: "Lists all pods",
@@ -246,3 +256,110 @@ func TestExecutor_ExecuteReturnsCmd(t *testing.T) {
 	assert.NotNil(t, msg)
 	// Commands return tea.Msg (various types), not tea.Cmd
 }
+
+// newPolicyExecutor returns an executor where prod-* contexts are
+// protected and support is read-only.
+func newPolicyExecutor(t *testing.T, activeContext string) *Executor {
+	t.Helper()
+	registry := commands.NewRegistry(createTestPool(t), keyboard.GetKeys())
+	exec := NewExecutor(registry, ui.GetTheme("charm"), 80)
+	cfg := &config.Config{Policies: []config.ContextPolicy{
+		{Context: "prod-*", Protected: true},
+		{Context: "support", ReadOnly: true},
+	}}
+	exec.SetPolicies(cfg.PolicyFor)
+	exec.SetContext(activeContext)
+	return exec
+}
+
+func TestExecutor_Execute_ReadOnly(t *testing.T) {
+	exec := newPolicyExecutor(t, "support")
+	selected := map[string]any{"name": "api", "namespace": "web"}
+
+	cmd, needsConfirm := exec.Execute("delete", commands.CategoryAction, exec.BuildContext(k8s.ResourceTypeDeployment, selected, "", "/delete"))
+	assert.False(t, needsConfirm)
+	assert.False(t, exec.HasPending())
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Equal(t, types.MessageTypeError, msg.Type)
+	assert.Contains(t, msg.Message, "/delete refused: context support is read-only")
+
+	// Read-only commands still run
+	cmd, _ = exec.Execute("yaml", commands.CategoryAction, exec.BuildContext(k8s.ResourceTypeDeployment, selected, "", "/yaml"))
+	assert.NotNil(t, cmd)
+
+	// Rows of aggregated lists use the policy of their own context
+	selected["context"] = "staging"
+	_, needsConfirm = exec.Execute("delete", commands.CategoryAction, exec.BuildContext(k8s.ResourceTypeDeployment, selected, "", "/delete"))
+	assert.True(t, needsConfirm)
+	assert.False(t, exec.PendingNeedsTypedConfirmation())
+}
+
+func TestExecutor_Execute_Protected(t *testing.T) {
+	exec := newPolicyExecutor(t, "prod-eu")
+	ctx := exec.BuildContext(k8s.ResourceTypeDeployment, map[string]any{"name": "api", "namespace": "web"}, "3", "/scale 3")
+
+	// Scale has no y/n confirmation, but protected contexts ask to type a name
+	cmd, needsConfirm := exec.Execute("scale", commands.CategoryAction, ctx)
+	assert.True(t, needsConfirm)
+	assert.Nil(t, cmd)
+	assert.True(t, exec.PendingNeedsTypedConfirmation())
+	assert.Contains(t, exec.ViewConfirmation(), "Type api or prod-eu to confirm")
+
+	assert.False(t, exec.PendingConfirmed())
+	assert.Nil(t, exec.ExecutePending(ctx))
+	assert.True(t, exec.HasPending())
+
+	for _, token := range []string{"api", "prod-eu"} {
+		exec.SetConfirmInput(token)
+		assert.True(t, exec.PendingConfirmed(), token)
+	}
+	assert.NotNil(t, exec.ExecutePending(ctx))
+	assert.False(t, exec.HasPending())
+	assert.Empty(t, exec.GetConfirmInput())
+}
+
+func TestExecutor_LLMAnalysis_Policies(t *testing.T) {
+	mutating := func() *llm.Analysis {
+		op, err := llm.ParseCommand("kubectl scale deployment api --replicas=3 -n web")
+		require.NoError(t, err)
+		return &llm.Analysis{Operation: op}
+	}
+	run := func() tea.Msg { return nil }
+
+	exec := newPolicyExecutor(t, "support")
+	exec.SetLLMAnalysis(mutating(), run, "support")
+	_, ok := exec.ConfirmLLM()
+	assert.False(t, ok)
+	assert.ErrorContains(t, exec.GetLLMAnalysis().Rejection, "context support is read-only")
+
+	exec = newPolicyExecutor(t, "prod-eu")
+	exec.SetLLMAnalysis(mutating(), run, "prod-eu")
+	assert.True(t, exec.NeedsTypedConfirmation())
+	_, ok = exec.ConfirmLLM()
+	assert.False(t, ok)
+	exec.SetLLMConfirmInput("prod-eu")
+	_, ok = exec.ConfirmLLM()
+	assert.True(t, ok)
+
+	exec.SetLLMAnalysis(mutating(), run, "staging")
+	assert.False(t, exec.NeedsTypedConfirmation())
+}
+
+func TestExecutor_Execute_AccessDenied(t *testing.T) {
+	registry := commands.NewRegistry(createTestPool(t), keyboard.GetKeys())
+	exec := NewExecutor(registry, ui.GetTheme("charm"), 80)
+	exec.SetAccessDenials(map[string]string{"delete": "cannot delete pods in namespace web"})
+	ctx := exec.BuildContext(k8s.ResourceTypePod, map[string]any{"name": "api-1", "namespace": "web"}, "", "/delete")
+
+	cmd, needsConfirm := exec.Execute("delete", commands.CategoryAction, ctx)
+	assert.False(t, needsConfirm, "denied commands are refused before confirmation")
+	require.NotNil(t, cmd)
+	msg := cmd().(types.StatusMsg)
+	assert.Equal(t, "/delete refused: cannot delete pods in namespace web", msg.Message)
+
+	exec.SetAccessDenials(nil)
+	_, needsConfirm = exec.Execute("delete", commands.CategoryAction, ctx)
+	assert.True(t, needsConfirm)
+}
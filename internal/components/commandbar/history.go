@@ -1,5 +1,7 @@
 package commandbar
 
+import "strings"
+
 // History manages command history with deduplication and size limits.
 // It provides stateless helper functions for history management.
 type History struct {
@@ -15,29 +17,60 @@ func NewHistory() *History {
 	}
 }
 
+// maxHistory is the number of commands kept in history
+const maxHistory = 100
+
 // Add adds a command to history, avoiding duplicates of most recent entry.
-func (h *History) Add(cmd string) {
+// Returns true if the command was added.
+func (h *History) Add(cmd string) bool {
 	// Don't add empty commands
 	if len(cmd) == 0 {
-		return
+		return false
 	}
 
 	// Don't add if it's the same as the most recent command
 	if len(h.entries) > 0 && h.entries[len(h.entries)-1] == cmd {
-		return
+		h.index = -1
+		return false
 	}
 
 	// Add to history
 	h.entries = append(h.entries, cmd)
 
 	// Keep max 100 entries
-	const maxHistory = 100
 	if len(h.entries) > maxHistory {
 		h.entries = h.entries[len(h.entries)-maxHistory:]
 	}
 
 	// Reset index
 	h.index = -1
+	return true
+}
+
+// Load replaces the history with entries (oldest first), e.g. the persisted
+// history of a context.
+func (h *History) Load(entries []string) {
+	if len(entries) > maxHistory {
+		entries = entries[len(entries)-maxHistory:]
+	}
+	h.entries = append([]string{}, entries...)
+	h.index = -1
+}
+
+// Search finds the most recent entry older than position before that
+// contains query (reverse incremental search). Pass before = Size() to
+// search from the newest entry. Returns the entry and its position.
+func (h *History) Search(query string, before int) (string, int, bool) {
+	if before > len(h.entries) {
+		before = len(h.entries)
+	}
+	query = strings.ToLower(query)
+	for i := before - 1; i >= 0; i-- {
+		if strings.Contains(strings.ToLower(h.entries[i]), query) {
+			return h.entries[i], i, true
+		}
+	}
+	return "", -1, false
 }
 
 // NavigateUp navigates backwards in history (older commands).
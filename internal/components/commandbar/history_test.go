@@ -1,6 +1,7 @@
 package commandbar
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -157,3 +158,57 @@ func TestHistory_Reset(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, "/describe", cmd)
 }
+
+func TestHistory_Load(t *testing.T) {
+	h := NewHistory()
+	h.Add("/yaml")
+	h.NavigateUp()
+
+	h.Load([]string{":pods", "/scale 3"})
+	assert.Equal(t, 2, h.Size())
+	assert.Equal(t, -1, h.index)
+
+	cmd, ok := h.NavigateUp()
+	assert.True(t, ok)
+	assert.Equal(t, "/scale 3", cmd)
+
+	// Loading more than the limit keeps the newest entries
+	entries := make([]string, maxHistory+5)
+	for i := range entries {
+		entries[i] = fmt.Sprintf(":pods %d", i)
+	}
+	h.Load(entries)
+	assert.Equal(t, maxHistory, h.Size())
+	cmd, _ = h.NavigateUp()
+	assert.Equal(t, fmt.Sprintf(":pods %d", maxHistory+4), cmd)
+}
+
+func TestHistory_Search(t *testing.T) {
+	h := NewHistory()
+	h.Load([]string{":pods", "/scale 3", ":deployments", "/describe POD"})
+
+	tests := []struct {
+		name     string
+		query    string
+		before   int
+		expected string
+		pos      int
+		found    bool
+	}{
+		{"newest match", "pod", 4, "/describe POD", 3, true},
+		{"older match", "pod", 3, ":pods", 0, true},
+		{"no older match", "pod", 0, "", -1, false},
+		{"case insensitive", "SCALE", 4, "/scale 3", 1, true},
+		{"before past end", "deploy", 10, ":deployments", 2, true},
+		{"no match", "nodes", 4, "", -1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, pos, found := h.Search(tt.query, tt.before)
+			assert.Equal(t, tt.expected, match)
+			assert.Equal(t, tt.pos, pos)
+			assert.Equal(t, tt.found, found)
+		})
+	}
+}
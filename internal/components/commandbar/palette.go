@@ -18,6 +18,8 @@ type Palette struct {
 	registry     *commands.Registry
 	theme        *ui.Theme
 	width        int
+	readOnly     bool              // Hide mutating commands
+	denied       map[string]string // Commands greyed out (name → reason)
 }
 
 // NewPalette creates a new palette manager.
@@ -37,6 +39,16 @@ func (p *Palette) SetWidth(width int) {
 	p.width = width
 }
 
+// SetReadOnly hides (or shows again) mutating commands.
+func (p *Palette) SetReadOnly(readOnly bool) {
+	p.readOnly = readOnly
+}
+
+// SetDenied greys out commands the user cannot run (name → reason).
+func (p *Palette) SetDenied(denied map[string]string) {
+	p.denied = denied
+}
+
 // Filter filters commands by query and command type.
 // Handles special case of /ai for LLM commands.
 // Filters resource commands by current screen (resource type).
@@ -63,6 +75,10 @@ func (p *Palette) Filter(query string, cmdType CommandType, screenID string) {
 		// Filter by current screen (resource type)
 		items = p.registry.FilterByResourceType(items, k8s.ResourceType(screenID))
 
+		if p.readOnly {
+			items = withoutMutating(items)
+		}
+
 		// Add /ai option if it matches the query
 		if strings.HasPrefix("ai", strings.ToLower(query)) || query == "" {
 			items = append(items, commands.Command{
@@ -79,6 +95,17 @@ func (p *Palette) Filter(query string, cmdType CommandType, screenID string) {
 	p.scrollOffset = 0
 }
 
+// withoutMutating returns the commands that do not change the cluster.
+func withoutMutating(items []commands.Command) []commands.Command {
+	result := make([]commands.Command, 0, len(items))
+	for _, cmd := range items {
+		if !cmd.Mutating {
+			result = append(result, cmd)
+		}
+	}
+	return result
+}
+
 // NavigateUp moves selection up in palette.
 // Scrolls viewport if cursor moves above visible range.
 func (p *Palette) NavigateUp() {
@@ -155,12 +182,7 @@ func (p *Palette) View(prefix string) string {
 	// First pass: find longest description to align shortcuts
 	longestMainText := 0
 	for i := p.scrollOffset; i < visibleEnd; i++ {
-		cmd := p.items[i]
-		mainText := prefix + cmd.Name
-		if cmd.ArgPattern != "" {
-			mainText += cmd.ArgPattern
-		}
-		mainText += " - " + cmd.Description
+		mainText := p.itemText(prefix, p.items[i])
 		if len(mainText) > longestMainText {
 			longestMainText = len(mainText)
 		}
@@ -172,11 +194,11 @@ func (p *Palette) View(prefix string) string {
 	// Second pass: render items with aligned shortcuts
 	for i := p.scrollOffset; i < visibleEnd; i++ {
 		cmd := p.items[i]
-		mainText := prefix + cmd.Name
-		if cmd.ArgPattern != "" {
-			mainText += cmd.ArgPattern
+		mainText := p.itemText(prefix, cmd)
+		foreground := p.theme.PaletteForeground
+		if p.deniedReason(cmd) != "" {
+			foreground = p.theme.Dimmed
 		}
-		mainText += " - " + cmd.Description
 
 		var line string
 		if cmd.Shortcut != "" {
@@ -201,7 +223,7 @@ func (p *Palette) View(prefix string) string {
 				line = selectedStyle.Render("▶ " + itemContent)
 			} else {
 				paletteStyle := lipgloss.NewStyle().
-					Foreground(p.theme.PaletteForeground).
+					Foreground(foreground).
 					Background(p.theme.PaletteBackground).
 					Width(p.width).
 					Padding(0, 1)
@@ -219,7 +241,7 @@ func (p *Palette) View(prefix string) string {
 				line = selectedStyle.Render("▶ " + mainText)
 			} else {
 				paletteStyle := lipgloss.NewStyle().
-					Foreground(p.theme.PaletteForeground).
+					Foreground(foreground).
 					Background(p.theme.PaletteBackground).
 					Width(p.width).
 					Padding(0, 1)
@@ -232,3 +254,25 @@ func (p *Palette) View(prefix string) string {
 
 	return lipgloss.JoinVertical(lipgloss.Left, sections...)
 }
+
+// itemText returns the text of a palette item (denied commands show why).
+func (p *Palette) itemText(prefix string, cmd commands.Command) string {
+	text := prefix + cmd.Name
+	if cmd.ArgPattern != "" {
+		text += cmd.ArgPattern
+	}
+	text += " - " + cmd.Description
+	if reason := p.deniedReason(cmd); reason != "" {
+		text += " (" + reason + ")"
+	}
+	return text
+}
+
+// deniedReason returns why the user cannot run an action command ("" when
+// allowed).
+func (p *Palette) deniedReason(cmd commands.Command) string {
+	if cmd.Category != commands.CategoryAction {
+		return ""
+	}
+	return p.denied[cmd.Name]
+}
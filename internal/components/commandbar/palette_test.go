@@ -375,3 +375,40 @@ func TestPalette_ViewRenderingWithScroll(t *testing.T) {
 	assert.NotContains(t, view, "cmd13")
 	assert.Contains(t, view, "▶", "Selected item should have indicator")
 }
+
+func TestPalette_Filter_ReadOnly(t *testing.T) {
+	registry := commands.NewRegistry(createTestPool(t), keyboard.GetKeys())
+	p := NewPalette(registry, ui.GetTheme("charm"), 80)
+
+	names := func() []string {
+		result := []string{}
+		for _, item := range p.items {
+			result = append(result, item.Name)
+		}
+		return result
+	}
+
+	p.Filter("", CommandTypeAction, "deployments")
+	assert.Contains(t, names(), "scale")
+	assert.Contains(t, names(), "delete")
+
+	p.SetReadOnly(true)
+	p.Filter("", CommandTypeAction, "deployments")
+	assert.NotContains(t, names(), "scale")
+	assert.NotContains(t, names(), "delete")
+	assert.NotContains(t, names(), "restart")
+	assert.Contains(t, names(), "yaml")
+	assert.Contains(t, names(), "ai")
+}
+
+func TestPalette_View_Denied(t *testing.T) {
+	registry := commands.NewRegistry(createTestPool(t), keyboard.GetKeys())
+	p := NewPalette(registry, ui.GetTheme("charm"), 120)
+	p.SetDenied(map[string]string{"delete": "cannot delete pods in namespace web", "pods": "ignored"})
+
+	p.Filter("delete", CommandTypeAction, "pods")
+	assert.Contains(t, p.View("/"), "/delete - Delete selected resource (cannot delete pods in namespace web)")
+
+	p.Filter("pods", CommandTypeResource, "pods")
+	assert.NotContains(t, p.View(":"), "ignored", "only action commands are denied")
+}
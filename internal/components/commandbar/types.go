@@ -23,7 +23,15 @@ const (
 	StateConfirmation                      // Destructive operation confirmation
 	StateLLMPreview                        // /ai command preview
 	StateResult                            // Success/error message
+	StateHistorySearch                     // ctrl+r reverse history search
 )
 
 // tipRotationMsg triggers rotation to next tip
 type tipRotationMsg time.Time
+
+// accessDenialsMsg carries the action commands the user cannot run on a
+// selection (command name → reason), see CommandBar.checkAccess
+type accessDenialsMsg struct {
+	key     string // Selection the denials were computed for
+	denials map[string]string
+}
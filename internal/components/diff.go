@@ -0,0 +1,268 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// diffOp is the kind of change of a diff line
+type diffOp int
+
+const (
+	diffEqual diffOp = iota
+	diffDelete
+	diffInsert
+)
+
+const (
+	// diffContextLines is the number of unchanged lines kept around changes
+	diffContextLines = 3
+
+	// diffMaxCells caps the LCS table; larger changed regions are shown as a
+	// whole-block replacement instead of a minimal diff
+	diffMaxCells = 4_000_000
+)
+
+// diffLine is a line of a diff with its line numbers on each side (0 when
+// the line does not exist on that side)
+type diffLine struct {
+	op    diffOp
+	text  string
+	left  int
+	right int
+}
+
+// diffLines computes a line diff of a and b (longest common subsequence
+// after trimming the common prefix and suffix)
+func diffLines(a, b []string) []diffLine {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var ops []diffOp
+	for range prefix {
+		ops = append(ops, diffEqual)
+	}
+	ops = append(ops, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for range suffix {
+		ops = append(ops, diffEqual)
+	}
+
+	// Attach text and line numbers
+	lines := make([]diffLine, 0, len(ops))
+	i, j := 0, 0
+	for _, op := range ops {
+		switch op {
+		case diffEqual:
+			lines = append(lines, diffLine{op: op, text: a[i], left: i + 1, right: j + 1})
+			i++
+			j++
+		case diffDelete:
+			lines = append(lines, diffLine{op: op, text: a[i], left: i + 1})
+			i++
+		case diffInsert:
+			lines = append(lines, diffLine{op: op, text: b[j], right: j + 1})
+			j++
+		}
+	}
+	return lines
+}
+
+// diffMiddle returns the edit script of the changed region
+func diffMiddle(a, b []string) []diffOp {
+	var ops []diffOp
+	if (len(a)+1)*(len(b)+1) > diffMaxCells {
+		for range a {
+			ops = append(ops, diffDelete)
+		}
+		for range b {
+			ops = append(ops, diffInsert)
+		}
+		return ops
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffEqual)
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffDelete)
+			i++
+		default:
+			ops = append(ops, diffInsert)
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffDelete)
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffInsert)
+	}
+	return ops
+}
+
+// diffHunks groups changed lines with their context into hunks; nil when
+// both sides are equal
+func diffHunks(lines []diffLine, context int) [][]diffLine {
+	var hunks [][]diffLine
+	start, end := -1, -1
+	for i, line := range lines {
+		if line.op == diffEqual {
+			continue
+		}
+		from := max(0, i-context)
+		to := min(len(lines), i+context+1)
+		if start >= 0 && from <= end {
+			end = max(end, to)
+			continue
+		}
+		if start >= 0 {
+			hunks = append(hunks, lines[start:end])
+		}
+		start, end = from, to
+	}
+	if start >= 0 {
+		hunks = append(hunks, lines[start:end])
+	}
+	return hunks
+}
+
+// hunkHeader returns the unified diff header of a hunk (@@ -l,n +r,m @@)
+func hunkHeader(hunk []diffLine) string {
+	leftStart, rightStart, leftCount, rightCount := 0, 0, 0, 0
+	for _, line := range hunk {
+		if line.left > 0 {
+			if leftStart == 0 {
+				leftStart = line.left
+			}
+			leftCount++
+		}
+		if line.right > 0 {
+			if rightStart == 0 {
+				rightStart = line.right
+			}
+			rightCount++
+		}
+	}
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@", leftStart, leftCount, rightStart, rightCount)
+}
+
+// UnifiedDiff renders a unified diff of two texts
+func UnifiedDiff(leftTitle, rightTitle, left, right string) string {
+	hunks := diffHunks(diffLines(splitDiffLines(left), splitDiffLines(right)), diffContextLines)
+	if len(hunks) == 0 {
+		return "No differences"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", leftTitle, rightTitle)
+	for _, hunk := range hunks {
+		b.WriteString(hunkHeader(hunk) + "\n")
+		for _, line := range hunk {
+			switch line.op {
+			case diffEqual:
+				b.WriteString(" " + line.text + "\n")
+			case diffDelete:
+				b.WriteString("-" + line.text + "\n")
+			case diffInsert:
+				b.WriteString("+" + line.text + "\n")
+			}
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// SideBySideDiff renders two texts in columns with a change marker between
+// them ("|" changed, "<" only left, ">" only right)
+func SideBySideDiff(leftTitle, rightTitle, left, right string, width int) string {
+	hunks := diffHunks(diffLines(splitDiffLines(left), splitDiffLines(right)), diffContextLines)
+	if len(hunks) == 0 {
+		return "No differences"
+	}
+
+	column := max(10, (width-3)/2)
+	row := func(l, marker, r string) string {
+		return padDiffColumn(l, column) + " " + marker + " " + padDiffColumn(r, column)
+	}
+
+	rows := []string{row(leftTitle, " ", rightTitle)}
+	for _, hunk := range hunks {
+		rows = append(rows, hunkHeader(hunk))
+
+		// Pair each run of deletions with the insertions that follow it
+		for i := 0; i < len(hunk); {
+			if hunk[i].op == diffEqual {
+				rows = append(rows, row(hunk[i].text, " ", hunk[i].text))
+				i++
+				continue
+			}
+			var deleted, inserted []string
+			for ; i < len(hunk) && hunk[i].op == diffDelete; i++ {
+				deleted = append(deleted, hunk[i].text)
+			}
+			for ; i < len(hunk) && hunk[i].op == diffInsert; i++ {
+				inserted = append(inserted, hunk[i].text)
+			}
+			for k := range max(len(deleted), len(inserted)) {
+				switch {
+				case k >= len(deleted):
+					rows = append(rows, row("", ">", inserted[k]))
+				case k >= len(inserted):
+					rows = append(rows, row(deleted[k], "<", ""))
+				default:
+					rows = append(rows, row(deleted[k], "|", inserted[k]))
+				}
+			}
+		}
+	}
+	return strings.Join(rows, "\n")
+}
+
+// splitDiffLines splits text into lines, ignoring the final newline
+func splitDiffLines(text string) []string {
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// padDiffColumn truncates or pads text to exactly width cells
+func padDiffColumn(text string, width int) string {
+	text = strings.ReplaceAll(text, "\t", "  ")
+	if lipgloss.Width(text) > width {
+		runes := []rune(text)
+		for len(runes) > 0 && lipgloss.Width(string(runes))+1 > width {
+			runes = runes[:len(runes)-1]
+		}
+		return string(runes) + "…"
+	}
+	return text + strings.Repeat(" ", width-lipgloss.Width(text))
+}
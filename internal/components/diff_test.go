@@ -0,0 +1,62 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLines(t *testing.T) {
+	lines := diffLines(
+		[]string{"a", "b", "c", "d"},
+		[]string{"a", "x", "c", "d", "e"},
+	)
+
+	var ops []diffOp
+	for _, line := range lines {
+		ops = append(ops, line.op)
+	}
+	assert.Equal(t, []diffOp{diffEqual, diffDelete, diffInsert, diffEqual, diffEqual, diffInsert}, ops)
+	assert.Equal(t, diffLine{op: diffDelete, text: "b", left: 2}, lines[1])
+	assert.Equal(t, diffLine{op: diffInsert, text: "e", right: 5}, lines[5])
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	left := "spec:\n  replicas: 2\n  image: nginx:1.25\n  a: 1\n  b: 2\n  c: 3\n  d: 4\n  e: 5\n  f: 6\n  g: 7\n  port: 80\n"
+	right := "spec:\n  replicas: 3\n  image: nginx:1.25\n  a: 1\n  b: 2\n  c: 3\n  d: 4\n  e: 5\n  f: 6\n  g: 7\n  port: 8080\n"
+
+	diff := UnifiedDiff("staging", "prod", left, right)
+
+	assert.Equal(t, strings.Join([]string{
+		"--- staging",
+		"+++ prod",
+		"@@ -1,5 +1,5 @@",
+		" spec:",
+		"-  replicas: 2",
+		"+  replicas: 3",
+		"   image: nginx:1.25",
+		"   a: 1",
+		"   b: 2",
+		"@@ -8,4 +8,4 @@",
+		"   e: 5",
+		"   f: 6",
+		"   g: 7",
+		"-  port: 80",
+		"+  port: 8080",
+	}, "\n"), diff)
+
+	assert.Equal(t, "No differences", UnifiedDiff("a", "b", left, left))
+}
+
+func TestSideBySideDiff(t *testing.T) {
+	diff := SideBySideDiff("left", "right", "a\nb\nc\n", "a\nB\nc\nd\n", 23)
+
+	rows := strings.Split(diff, "\n")
+	require.Len(t, rows, 6)
+	assert.Equal(t, "left         right     ", rows[0])
+	assert.Equal(t, "@@ -1,3 +1,4 @@", rows[1])
+	assert.Equal(t, "b          | B         ", rows[3])
+	assert.Equal(t, "           > d         ", rows[5])
+}
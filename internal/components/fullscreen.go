@@ -6,6 +6,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/renato0307/k1/internal/types"
 	"github.com/renato0307/k1/internal/ui"
 )
 
@@ -16,6 +17,10 @@ const (
 	FullScreenYAML FullScreenViewType = iota
 	FullScreenDescribe
 	FullScreenLogs
+	FullScreenData      // Decoded Secret/ConfigMap values
+	FullScreenDiagnosis // Pod diagnosis findings
+	FullScreenDiff      // Diff of two resources
+	FullScreenOutput    // Output of a kubectl command (/ai)
 
 	// FullScreenReservedLines is the number of lines reserved for UI chrome
 	// (header, command bar, borders) when showing full-screen views.
@@ -31,6 +36,11 @@ type FullScreen struct {
 	height       int
 	theme        *ui.Theme
 	scrollOffset int
+
+	// Diff view state (content is rendered from diff on every toggle)
+	diff       *types.DiffContent
+	specOnly   bool
+	sideBySide bool
 }
 
 // NewFullScreen creates a new full-screen component
@@ -46,10 +56,39 @@ func NewFullScreen(viewType FullScreenViewType, resourceName string, content str
 	}
 }
 
+// NewDiffFullScreen creates a full-screen diff of two resources, starting
+// with the unified diff of the full (cleaned) manifests
+func NewDiffFullScreen(diff *types.DiffContent, theme *ui.Theme) *FullScreen {
+	fs := NewFullScreen(FullScreenDiff, diff.LeftTitle+" ↔ "+diff.RightTitle, "", theme)
+	fs.diff = diff
+	fs.renderDiff()
+	return fs
+}
+
 // SetSize updates the size of the full-screen view
 func (fs *FullScreen) SetSize(width, height int) {
 	fs.width = width
 	fs.height = height
+	if fs.sideBySide {
+		fs.renderDiff()
+	}
+}
+
+// renderDiff renders the diff for the current toggles
+func (fs *FullScreen) renderDiff() {
+	if fs.diff == nil {
+		return
+	}
+	left, right := fs.diff.Left, fs.diff.Right
+	if fs.specOnly {
+		left, right = fs.diff.LeftSpec, fs.diff.RightSpec
+	}
+	if fs.sideBySide {
+		fs.content = SideBySideDiff(fs.diff.LeftTitle, fs.diff.RightTitle, left, right, fs.width)
+	} else {
+		fs.content = UnifiedDiff(fs.diff.LeftTitle, fs.diff.RightTitle, left, right)
+	}
+	fs.scrollOffset = 0
 }
 
 // Update handles input for the full-screen view
@@ -101,6 +140,18 @@ func (fs *FullScreen) Update(msg tea.Msg) (*FullScreen, tea.Cmd) {
 			}
 			fs.scrollOffset = maxOffset
 			return fs, nil
+		case "s":
+			if fs.diff != nil {
+				fs.specOnly = !fs.specOnly
+				fs.renderDiff()
+			}
+			return fs, nil
+		case "v":
+			if fs.diff != nil {
+				fs.sideBySide = !fs.sideBySide
+				fs.renderDiff()
+			}
+			return fs, nil
 		}
 	}
 	return fs, nil
@@ -124,10 +175,25 @@ func (fs *FullScreen) View() string {
 		viewTypeStr = "Describe"
 	case FullScreenLogs:
 		viewTypeStr = "Logs"
+	case FullScreenData:
+		viewTypeStr = "Data"
+	case FullScreenDiagnosis:
+		viewTypeStr = "Diagnosis"
+	case FullScreenDiff:
+		viewTypeStr = "Diff"
+		if fs.specOnly {
+			viewTypeStr = "Diff (spec)"
+		}
+	case FullScreenOutput:
+		viewTypeStr = "Output"
 	}
 
 	title := titleStyle.Render(viewTypeStr + ": " + fs.resourceName)
-	hint := hintStyle.Render("[ESC] Back  [↑↓/jk] Scroll  [PgUp/PgDn] Page  [g/G] Top/Bottom")
+	hintText := "[ESC] Back  [↑↓/jk] Scroll  [PgUp/PgDn] Page  [g/G] Top/Bottom"
+	if fs.diff != nil {
+		hintText = "[ESC] Back  [↑↓/jk] Scroll  [s] Spec only  [v] Side-by-side"
+	}
+	hint := hintStyle.Render(hintText)
 
 	headerLine := lipgloss.JoinHorizontal(
 		lipgloss.Top,
@@ -144,6 +210,9 @@ func (fs *FullScreen) View() string {
 	if fs.viewType == FullScreenYAML {
 		displayContent = fs.highlightYAML(fs.content)
 	}
+	if fs.viewType == FullScreenDiff {
+		displayContent = fs.highlightDiff(fs.content)
+	}
 
 	// Split content into lines and apply scroll offset
 	lines := strings.Split(displayContent, "\n")
@@ -215,6 +284,47 @@ func (fs *FullScreen) highlightYAML(yaml string) string {
 	return strings.Join(highlighted, "\n")
 }
 
+// highlightDiff colors added, removed and changed lines of a diff
+func (fs *FullScreen) highlightDiff(diff string) string {
+	lines := strings.Split(diff, "\n")
+
+	addedStyle := lipgloss.NewStyle().Foreground(fs.theme.Success)
+	removedStyle := lipgloss.NewStyle().Foreground(fs.theme.Error)
+	changedStyle := lipgloss.NewStyle().Foreground(fs.theme.Warning)
+	hunkStyle := lipgloss.NewStyle().Foreground(fs.theme.Primary)
+
+	// Side-by-side rows carry their marker in the middle column
+	markerAt := -1
+	if fs.sideBySide {
+		markerAt = max(10, (fs.width-3)/2) + 1
+	}
+
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = hunkStyle.Render(line)
+		case markerAt >= 0:
+			marker := ""
+			if runes := []rune(line); len(runes) > markerAt {
+				marker = string(runes[markerAt])
+			}
+			switch marker {
+			case "|":
+				lines[i] = changedStyle.Render(line)
+			case "<":
+				lines[i] = removedStyle.Render(line)
+			case ">":
+				lines[i] = addedStyle.Render(line)
+			}
+		case strings.HasPrefix(line, "+"):
+			lines[i] = addedStyle.Render(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = removedStyle.Render(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 // Helper functions
 func max(a, b int) int {
 	if a > b {
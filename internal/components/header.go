@@ -5,6 +5,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/lipgloss"
+
 	"github.com/renato0307/k1/internal/ui"
 )
 
@@ -23,6 +25,7 @@ type Header struct {
 	contextLoading  bool   // Whether context is loading
 	loadingMessage  string // Loading progress message
 	loadingSpinner  int    // Spinner frame index (0-7)
+	badge           string // Protection badge of the context (READ-ONLY, PROTECTED)
 }
 
 func NewHeader(appName string, theme *ui.Theme) *Header {
@@ -70,6 +73,11 @@ func (h *Header) SetContext(context string) {
 	h.contextLoading = false
 }
 
+// SetBadge sets the protection badge of the current context (empty = none)
+func (h *Header) SetBadge(badge string) {
+	h.badge = badge
+}
+
 // SetContextLoading sets context loading state with message
 func (h *Header) SetContextLoading(context, message string) {
 	h.context = context
@@ -120,6 +128,15 @@ func (h *Header) View() string {
 	// Build left side: "Pods • refreshing in 10s"
 	leftParts := []string{}
 
+	if h.badge != "" {
+		badgeStyle := lipgloss.NewStyle().
+			Foreground(h.theme.Warning).
+			Bold(true).
+			Reverse(true).
+			Padding(0, 1)
+		leftParts = append(leftParts, badgeStyle.Render(h.badge))
+	}
+
 	if h.screenTitle != "" {
 		leftParts = append(leftParts, h.screenTitle)
 	}
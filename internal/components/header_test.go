@@ -0,0 +1,22 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renato0307/k1/internal/ui"
+)
+
+func TestHeader_Badge(t *testing.T) {
+	header := NewHeader("k1", ui.GetTheme("charm"))
+	header.SetScreenTitle("Pods")
+	assert.NotContains(t, header.View(), "READ-ONLY")
+
+	header.SetBadge("READ-ONLY")
+	assert.Contains(t, header.View(), "READ-ONLY")
+	assert.Contains(t, header.View(), "Pods")
+
+	header.SetBadge("")
+	assert.NotContains(t, header.View(), "READ-ONLY")
+}
@@ -1,6 +1,7 @@
 package components
 
 import (
+	"sort"
 	"sync"
 	"time"
 )
@@ -47,6 +48,21 @@ func (b *OutputBuffer) Add(entry CommandOutput) {
 	}
 }
 
+// Merge adds entries loaded from persisted history, keeping the buffer
+// ordered by timestamp and bounded
+func (b *OutputBuffer) Merge(entries []CommandOutput) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.entries = append(b.entries, entries...)
+	sort.SliceStable(b.entries, func(i, j int) bool {
+		return b.entries[i].Timestamp.Before(b.entries[j].Timestamp)
+	})
+	if len(b.entries) > MaxOutputHistory {
+		b.entries = b.entries[len(b.entries)-MaxOutputHistory:]
+	}
+}
+
 // GetAll returns all entries (newest first for display)
 func (b *OutputBuffer) GetAll() []CommandOutput {
 	b.mu.RLock()
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestOutputBuffer_Add(t *testing.T) {
@@ -218,3 +219,29 @@ func TestOutputBuffer_Count(t *testing.T) {
 	buffer.Clear()
 	assert.Equal(t, 0, buffer.Count())
 }
+
+func TestOutputBuffer_Merge(t *testing.T) {
+	buffer := NewOutputBuffer()
+	now := time.Now()
+
+	buffer.Add(CommandOutput{Command: "current", Timestamp: now})
+	buffer.Merge([]CommandOutput{
+		{Command: "older", Timestamp: now.Add(-2 * time.Minute)},
+		{Command: "old", Timestamp: now.Add(-time.Minute)},
+	})
+
+	entries := buffer.GetAll()
+	require.Len(t, entries, 3)
+	assert.Equal(t, "current", entries[0].Command)
+	assert.Equal(t, "old", entries[1].Command)
+	assert.Equal(t, "older", entries[2].Command)
+
+	// Merging past the limit keeps the newest entries
+	loaded := make([]CommandOutput, MaxOutputHistory)
+	for i := range loaded {
+		loaded[i] = CommandOutput{Command: "loaded", Timestamp: now.Add(-time.Hour)}
+	}
+	buffer.Merge(loaded)
+	assert.Equal(t, MaxOutputHistory, buffer.Count())
+	assert.Equal(t, "current", buffer.GetAll()[0].Command)
+}
@@ -0,0 +1,121 @@
+// Package config loads the k1 configuration file
+// ($XDG_CONFIG_HOME/k1/config.yaml, usually ~/.config/k1/config.yaml).
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Config is the content of the configuration file. Every setting is
+// optional; command-line flags take precedence.
+type Config struct {
+	LLM   LLMConfig   `json:"llm"`
+	Audit AuditConfig `json:"audit"`
+	// ReadOnly makes every context read-only (also set by -readonly)
+	ReadOnly bool `json:"readOnly"`
+	// Policies protect contexts by name pattern
+	Policies []ContextPolicy `json:"policies"`
+	// ObjectHistory records the versions of objects that change
+	ObjectHistory ObjectHistoryConfig `json:"objectHistory"`
+}
+
+// LLMConfig configures the OpenAI-compatible endpoint behind /ai commands
+type LLMConfig struct {
+	// Endpoint is the base URL of the API (e.g. http://localhost:11434/v1)
+	Endpoint string `json:"endpoint"`
+	// Model is the model name (e.g. qwen2.5-coder:7b)
+	Model string `json:"model"`
+	// APIKeyEnv names the environment variable holding the API key (keys
+	// are never stored in the file)
+	APIKeyEnv string `json:"apiKeyEnv"`
+	// TimeoutSeconds bounds each translation (0 = default)
+	TimeoutSeconds int `json:"timeoutSeconds"`
+}
+
+// AuditConfig configures the audit log of mutating operations
+type AuditConfig struct {
+	// Path is the audit file (empty = $XDG_STATE_HOME/k1/audit.jsonl)
+	Path string `json:"path"`
+	// Disabled turns the audit log off
+	Disabled bool `json:"disabled"`
+	// MaxSizeMB is the file size that triggers rotation (0 = default)
+	MaxSizeMB int `json:"maxSizeMB"`
+	// MaxBackups is the number of rotated files kept (0 = default)
+	MaxBackups int `json:"maxBackups"`
+}
+
+// ObjectHistoryConfig configures the per-object change history (/history)
+type ObjectHistoryConfig struct {
+	// Enabled turns the history on (also set by -object-history)
+	Enabled bool `json:"enabled"`
+	// MaxVersions is the number of versions kept per object (0 = default)
+	MaxVersions int `json:"maxVersions"`
+	// MaxObjects is the number of objects tracked (0 = default)
+	MaxObjects int `json:"maxObjects"`
+}
+
+// DefaultPath returns the default location of the configuration file
+func DefaultPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "k1", "config.yaml")
+}
+
+// StateDir returns the directory for k1 state files (audit log, history):
+// $XDG_STATE_HOME/k1, defaulting to ~/.local/state/k1
+func StateDir() string {
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return filepath.Join(dir, "k1")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".local", "state", "k1")
+}
+
+// AuditPath returns the audit file to use (empty when disabled)
+func (c AuditConfig) AuditPath() string {
+	if c.Disabled {
+		return ""
+	}
+	if c.Path != "" {
+		return c.Path
+	}
+	if dir := StateDir(); dir != "" {
+		return filepath.Join(dir, "audit.jsonl")
+	}
+	return ""
+}
+
+// Load reads the configuration file at path. A missing file is not an error
+// and yields the zero configuration.
+func Load(path string) (*Config, error) {
+	cfg := &Config{}
+	if path == "" {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	if err := cfg.validatePolicies(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return cfg, nil
+}
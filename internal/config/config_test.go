@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	// Missing file yields the zero config
+	cfg, err := Load(filepath.Join(dir, "missing.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, &Config{}, cfg)
+
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`llm:
+  endpoint: http://localhost:11434/v1
+  model: qwen2.5-coder:7b
+  timeoutSeconds: 30
+`), 0o600))
+	cfg, err = Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:11434/v1", cfg.LLM.Endpoint)
+	assert.Equal(t, "qwen2.5-coder:7b", cfg.LLM.Model)
+	assert.Equal(t, 30, cfg.LLM.TimeoutSeconds)
+
+	// Typos are reported instead of silently ignored
+	require.NoError(t, os.WriteFile(path, []byte("llm:\n  endpiont: x\n"), 0o600))
+	_, err = Load(path)
+	assert.ErrorContains(t, err, "endpiont")
+}
+
+func TestAuditConfig_AuditPath(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", "/tmp/state")
+
+	assert.Equal(t, "/tmp/state/k1/audit.jsonl", AuditConfig{}.AuditPath())
+	assert.Equal(t, "/var/log/k1.jsonl", AuditConfig{Path: "/var/log/k1.jsonl"}.AuditPath())
+	assert.Empty(t, AuditConfig{Path: "/var/log/k1.jsonl", Disabled: true}.AuditPath())
+}
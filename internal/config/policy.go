@@ -0,0 +1,70 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ContextPolicy protects the contexts whose name matches Context, a glob
+// pattern where * matches any characters (e.g. prod-*)
+type ContextPolicy struct {
+	Context string `json:"context"`
+	// ReadOnly hides and refuses mutating commands
+	ReadOnly bool `json:"readOnly"`
+	// Protected asks to type the resource or context name before mutating
+	Protected bool `json:"protected"`
+}
+
+// Policy is the protection applied to a context
+type Policy struct {
+	ReadOnly  bool
+	Protected bool
+}
+
+// Badge returns the header badge of the policy (empty = unprotected)
+func (p Policy) Badge() string {
+	switch {
+	case p.ReadOnly:
+		return "READ-ONLY"
+	case p.Protected:
+		return "PROTECTED"
+	}
+	return ""
+}
+
+// PolicyFor returns the policy of a context: read-only everywhere when
+// ReadOnly is set, otherwise the union of the policies matching it
+func (c *Config) PolicyFor(context string) Policy {
+	policy := Policy{ReadOnly: c.ReadOnly}
+	for _, p := range c.Policies {
+		if matchContext(p.Context, context) {
+			policy.ReadOnly = policy.ReadOnly || p.ReadOnly
+			policy.Protected = policy.Protected || p.Protected
+		}
+	}
+	return policy
+}
+
+// validatePolicies rejects policies that would never match
+func (c *Config) validatePolicies() error {
+	for i, p := range c.Policies {
+		if strings.TrimSpace(p.Context) == "" {
+			return fmt.Errorf("policies[%d]: context pattern is required", i)
+		}
+		if !p.ReadOnly && !p.Protected {
+			return fmt.Errorf("policies[%d] (%s): set readOnly or protected", i, p.Context)
+		}
+	}
+	return nil
+}
+
+// matchContext reports whether a context name matches a glob pattern (* and
+// ? also match the slashes and colons of names such as EKS ARNs)
+func matchContext(pattern, context string) bool {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	matched, _ := regexp.MatchString("^"+expr+"$", context)
+	return matched
+}
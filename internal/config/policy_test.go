@@ -0,0 +1,70 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_PolicyFor(t *testing.T) {
+	cfg := &Config{Policies: []ContextPolicy{
+		{Context: "prod-*", Protected: true},
+		{Context: "prod-payments", ReadOnly: true},
+		{Context: "arn:aws:eks:*:cluster/live-?", ReadOnly: true},
+	}}
+
+	tests := []struct {
+		context  string
+		expected Policy
+	}{
+		{"prod-eu", Policy{Protected: true}},
+		{"prod-payments", Policy{ReadOnly: true, Protected: true}},
+		{"arn:aws:eks:eu-west-1:123456789012:cluster/live-1", Policy{ReadOnly: true}},
+		{"arn:aws:eks:eu-west-1:123456789012:cluster/live-10", Policy{}},
+		{"staging", Policy{}},
+		{"my-prod-eu", Policy{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.context, func(t *testing.T) {
+			assert.Equal(t, tt.expected, cfg.PolicyFor(tt.context))
+		})
+	}
+
+	// Global read-only mode applies everywhere
+	cfg.ReadOnly = true
+	assert.Equal(t, Policy{ReadOnly: true}, cfg.PolicyFor("staging"))
+}
+
+func TestPolicy_Badge(t *testing.T) {
+	assert.Equal(t, "", Policy{}.Badge())
+	assert.Equal(t, "PROTECTED", Policy{Protected: true}.Badge())
+	assert.Equal(t, "READ-ONLY", Policy{ReadOnly: true, Protected: true}.Badge())
+}
+
+func TestLoad_Policies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`readOnly: false
+policies:
+  - context: prod-*
+    protected: true
+  - context: support
+    readOnly: true
+`), 0o600))
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, []ContextPolicy{
+		{Context: "prod-*", Protected: true},
+		{Context: "support", ReadOnly: true},
+	}, cfg.Policies)
+
+	require.NoError(t, os.WriteFile(path, []byte("policies:\n  - readOnly: true\n"), 0o600))
+	_, err = Load(path)
+	assert.ErrorContains(t, err, "context pattern is required")
+
+	require.NoError(t, os.WriteFile(path, []byte("policies:\n  - context: prod-*\n"), 0o600))
+	_, err = Load(path)
+	assert.ErrorContains(t, err, "set readOnly or protected")
+}
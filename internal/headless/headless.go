@@ -0,0 +1,88 @@
+// Package headless runs list screens without the TUI (k1 get), so scripts
+// see the same columns, status semantics and filters as the screens.
+package headless
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/screens"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+)
+
+// pollInterval is how often Run retries while informers are syncing
+const pollInterval = 200 * time.Millisecond
+
+// Query selects the rows of a list screen
+type Query struct {
+	Resource  string // Screen ID or alias (pods, deployments, pvcs…)
+	Namespace string // "" = all namespaces (ignored for cluster-scoped resources)
+	Filter    string // Screen filter: fuzzy search or column comparisons (restarts>3)
+}
+
+// Result holds the columns and formatted rows of a query, as the screen
+// shows them
+type Result struct {
+	Columns []string
+	Rows    [][]string
+}
+
+// Run lists the rows of a query through the screen of its resource, waiting
+// for the informers to sync until ctx is done
+func Run(ctx context.Context, repo k8s.Repository, query Query) (*Result, error) {
+	cfg, ok := screens.GetListScreenConfig(query.Resource)
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %q", query.Resource)
+	}
+	screen := screens.NewConfigScreen(cfg, repo, ui.GetTheme("charm"))
+
+	if err := refresh(ctx, screen); err != nil {
+		return nil, err
+	}
+	if query.Filter != "" {
+		screen.SetFilter(query.Filter)
+	}
+
+	resourceConfig, _ := k8s.GetResourceConfig(cfg.ResourceType)
+	columns := screen.GetColumns()
+	result := &Result{Columns: make([]string, len(columns)), Rows: [][]string{}}
+	for i, col := range columns {
+		result.Columns[i] = col.Title
+	}
+	for _, item := range screen.GetFilteredItems() {
+		if query.Namespace != "" && resourceConfig.Namespaced {
+			if resource, ok := item.(k8s.Resource); ok && resource.GetNamespace() != query.Namespace {
+				continue
+			}
+		}
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			row[i] = col.FormatValue(item)
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	return result, nil
+}
+
+// refresh loads the items of a screen, retrying while it is loading
+func refresh(ctx context.Context, screen *screens.ConfigScreen) error {
+	for {
+		switch msg := screen.Refresh()().(type) {
+		case types.RefreshCompleteMsg:
+			return nil
+		case types.StatusMsg:
+			if msg.Type == types.MessageTypeError {
+				return fmt.Errorf("%s", msg.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s to sync", screen.Title())
+		case <-time.After(pollInterval):
+		}
+	}
+}
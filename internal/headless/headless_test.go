@@ -0,0 +1,37 @@
+package headless
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+)
+
+func TestRun(t *testing.T) {
+	repo := k8s.NewDummyRepository()
+
+	result, err := Run(context.Background(), repo, Query{Resource: "pods"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Namespace", "Name", "Ready", "Status", "Restarts", "Age", "Node", "IP"}, result.Columns)
+	assert.Len(t, result.Rows, 4)
+
+	result, err = Run(context.Background(), repo, Query{Resource: "pods", Filter: "restarts>3"})
+	require.NoError(t, err)
+	require.Len(t, result.Rows, 1)
+	assert.Equal(t, []string{"production", "api-server-6b9f8c7d5e-qwert", "0/1", "CrashLoopBackOff", "15", "2h", "node-3", "10.244.3.7"}, result.Rows[0])
+
+	result, err = Run(context.Background(), repo, Query{Resource: "pods", Namespace: "default"})
+	require.NoError(t, err)
+	assert.Len(t, result.Rows, 2)
+	for _, row := range result.Rows {
+		assert.Equal(t, "default", row[0])
+	}
+}
+
+func TestRun_UnknownResource(t *testing.T) {
+	_, err := Run(context.Background(), k8s.NewDummyRepository(), Query{Resource: "widgets"})
+	assert.EqualError(t, err, `unknown resource type "widgets"`)
+}
@@ -0,0 +1,82 @@
+package headless
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Output formats
+const (
+	FormatTable = "table"
+	FormatJSON  = "json"
+	FormatYAML  = "yaml"
+	FormatCSV   = "csv"
+)
+
+// Formats lists the supported output formats
+var Formats = []string{FormatTable, FormatJSON, FormatYAML, FormatCSV}
+
+// Write writes a result in a format: an aligned table (like kubectl), CSV
+// with a header row, or JSON/YAML lists of objects keyed by column title
+func Write(w io.Writer, result *Result, format string) error {
+	switch format {
+	case FormatTable, "":
+		return writeTable(w, result)
+	case FormatCSV:
+		return writeCSV(w, result)
+	case FormatJSON:
+		data, err := json.MarshalIndent(records(result), "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case FormatYAML:
+		data, err := yaml.Marshal(records(result))
+		if err != nil {
+			return fmt.Errorf("failed to encode YAML: %w", err)
+		}
+		_, err = w.Write(data)
+		return err
+	}
+	return fmt.Errorf("unknown output format %q (use %s)", format, strings.Join(Formats, ", "))
+}
+
+// records returns the rows as objects keyed by column title
+func records(result *Result) []map[string]string {
+	items := make([]map[string]string, len(result.Rows))
+	for i, row := range result.Rows {
+		item := make(map[string]string, len(result.Columns))
+		for j, column := range result.Columns {
+			item[column] = row[j]
+		}
+		items[i] = item
+	}
+	return items
+}
+
+func writeTable(w io.Writer, result *Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(result.Columns, "\t")))
+	for _, row := range result.Rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func writeCSV(w io.Writer, result *Result) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(result.Columns); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(result.Rows); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package headless
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	result := &Result{
+		Columns: []string{"Name", "Status"},
+		Rows: [][]string{
+			{"api-1", "Running"},
+			{"worker, blue", "CrashLoopBackOff"},
+		},
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{FormatTable, "NAME           STATUS\napi-1          Running\nworker, blue   CrashLoopBackOff\n"},
+		{FormatCSV, "Name,Status\napi-1,Running\n\"worker, blue\",CrashLoopBackOff\n"},
+		{FormatJSON, "[\n  {\n    \"Name\": \"api-1\",\n    \"Status\": \"Running\"\n  },\n  {\n    \"Name\": \"worker, blue\",\n    \"Status\": \"CrashLoopBackOff\"\n  }\n]\n"},
+		{FormatYAML, "- Name: api-1\n  Status: Running\n- Name: worker, blue\n  Status: CrashLoopBackOff\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Write(&buf, result, tt.format))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestWrite_UnknownFormat(t *testing.T) {
+	err := Write(&bytes.Buffer{}, &Result{}, "xml")
+	assert.EqualError(t, err, `unknown output format "xml" (use table, json, yaml, csv)`)
+}
@@ -0,0 +1,30 @@
+package history
+
+import "regexp"
+
+// redacted replaces secret values in persisted history
+const redacted = "***"
+
+// value matches a quoted or unquoted argument value
+const value = `("[^"]*"|'[^']*'|\S+)`
+
+var redactPatterns = []*regexp.Regexp{
+	// Sensitive flags, with the value attached or as the next argument
+	regexp.MustCompile(`(?i)(--(?:token|password|passwd|client-key|client-secret|api-key|apikey|bearer-token)(?:=|\s+))` + value),
+	// Literal secret data (--from-literal=key=value)
+	regexp.MustCompile(`(?i)(--from-literal(?:=|\s+)[^=\s]+=)` + value),
+	// key=value pairs with a sensitive key (password=..., API_TOKEN=...)
+	regexp.MustCompile(`(?i)(\b[\w.-]*(?:password|passwd|secret|token|apikey|api-key|api_key|credential|private-key|private_key)[\w.-]*=)` + value),
+	// Authorization headers
+	regexp.MustCompile(`(?i)(bearer\s+)` + value),
+}
+
+// Redact masks secret values passed inline in a command (sensitive flags,
+// --from-literal data, key=value pairs with sensitive keys and bearer
+// tokens) before it is written to disk
+func Redact(command string) string {
+	for _, pattern := range redactPatterns {
+		command = pattern.ReplaceAllString(command, "${1}"+redacted)
+	}
+	return command
+}
@@ -0,0 +1,31 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		expected string
+	}{
+		{"no secrets", ":pods", ":pods"},
+		{"plain args", "/scale 3", "/scale 3"},
+		{"token flag", "kubectl get pods --token abc123", "kubectl get pods --token ***"},
+		{"token flag with equals", "kubectl get pods --token=abc123 -n web", "kubectl get pods --token=*** -n web"},
+		{"password flag", "/ai login --password 'p4ss word'", "/ai login --password ***"},
+		{"from literal", "kubectl create secret generic db --from-literal=password=hunter2", "kubectl create secret generic db --from-literal=password=***"},
+		{"from literal other key", "kubectl create secret generic db --from-literal user=admin", "kubectl create secret generic db --from-literal user=***"},
+		{"sensitive env", "kubectl set env deploy/api API_TOKEN=xyz DEBUG=true", "kubectl set env deploy/api API_TOKEN=*** DEBUG=true"},
+		{"bearer", `curl -H "Authorization: Bearer eyJhbGci"`, `curl -H "Authorization: Bearer ***`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Redact(tt.command))
+		})
+	}
+}
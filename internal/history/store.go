@@ -0,0 +1,203 @@
+// Package history persists palette command history and command output
+// history per kubeconfig context, so they survive restarts.
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/renato0307/k1/internal/components"
+)
+
+const (
+	// MaxCommands is the number of commands kept per context
+	MaxCommands = 100
+
+	commandsExt = ".commands"
+	outputExt   = ".output.jsonl"
+)
+
+// Store reads and appends history files under dir, one pair of files per
+// context. A nil *Store is a disabled store.
+type Store struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewStore creates a store writing under dir (empty = disabled, nil store)
+func NewStore(dir string) *Store {
+	if dir == "" {
+		return nil
+	}
+	return &Store{dir: dir}
+}
+
+// LoadCommands returns the command history of a context, oldest first
+func (s *Store) LoadCommands(context string) ([]string, error) {
+	if s == nil {
+		return nil, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.path(context, commandsExt)
+	lines, err := readLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	commands := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line == "" || (len(commands) > 0 && commands[len(commands)-1] == line) {
+			continue
+		}
+		commands = append(commands, line)
+	}
+	if len(commands) > MaxCommands {
+		commands = commands[len(commands)-MaxCommands:]
+	}
+
+	// Compact files that grew well past the limit
+	if len(lines) > 2*MaxCommands {
+		if err := writeLines(path, commands); err != nil {
+			return nil, err
+		}
+	}
+	return commands, nil
+}
+
+// AppendCommand appends a command (redacted) to the history of a context
+func (s *Store) AppendCommand(context, command string) error {
+	if s == nil {
+		return nil
+	}
+	command = strings.ReplaceAll(Redact(command), "\n", " ")
+	if strings.TrimSpace(command) == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return appendLine(s.path(context, commandsExt), command)
+}
+
+// LoadOutputs returns the output history of a context, oldest first
+func (s *Store) LoadOutputs(context string) ([]components.CommandOutput, error) {
+	if s == nil {
+		return nil, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.path(context, outputExt)
+	lines, err := readLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]components.CommandOutput, 0, len(lines))
+	for _, line := range lines {
+		var output components.CommandOutput
+		if err := json.Unmarshal([]byte(line), &output); err != nil {
+			continue
+		}
+		outputs = append(outputs, output)
+	}
+	if len(outputs) > components.MaxOutputHistory {
+		outputs = outputs[len(outputs)-components.MaxOutputHistory:]
+	}
+
+	if len(lines) > 2*components.MaxOutputHistory {
+		if err := writeLines(path, lines[len(lines)-components.MaxOutputHistory:]); err != nil {
+			return nil, err
+		}
+	}
+	return outputs, nil
+}
+
+// AppendOutput appends a command output (redacted) to the history of its
+// context
+func (s *Store) AppendOutput(output components.CommandOutput) error {
+	if s == nil {
+		return nil
+	}
+	output.Command = Redact(output.Command)
+	output.KubectlCommand = Redact(output.KubectlCommand)
+	output.Output = Redact(output.Output)
+
+	line, err := json.Marshal(output)
+	if err != nil {
+		return fmt.Errorf("failed to encode output history: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return appendLine(s.path(output.Context, outputExt), string(line))
+}
+
+// path returns the history file of a context (context names may contain
+// slashes and colons, e.g. EKS ARNs)
+func (s *Store) path(context, ext string) string {
+	if context == "" {
+		context = "default"
+	}
+	return filepath.Join(s.dir, url.PathEscape(context)+ext)
+}
+
+// readLines reads the lines of a file (missing file = no lines)
+func readLines(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history: %w", err)
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	return lines, nil
+}
+
+// appendLine appends a line to a file, creating it (and its directory)
+// readable by the user only
+func appendLine(path, line string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open history: %w", err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(line + "\n"); err != nil {
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+	return nil
+}
+
+// writeLines replaces a file with lines
+func writeLines(path string, lines []string) error {
+	content := strings.Join(lines, "\n")
+	if len(lines) > 0 {
+		content += "\n"
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		return fmt.Errorf("failed to compact history: %w", err)
+	}
+	return nil
+}
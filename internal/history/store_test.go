@@ -0,0 +1,129 @@
+package history
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/components"
+)
+
+func TestStore_Commands(t *testing.T) {
+	store := NewStore(t.TempDir())
+
+	require.NoError(t, store.AppendCommand("prod", ":pods"))
+	require.NoError(t, store.AppendCommand("prod", ":pods"))
+	require.NoError(t, store.AppendCommand("prod", "/scale 3"))
+	require.NoError(t, store.AppendCommand("staging", ":nodes"))
+	require.NoError(t, store.AppendCommand("prod", "   "))
+
+	commands, err := store.LoadCommands("prod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{":pods", "/scale 3"}, commands, "consecutive duplicates collapsed")
+
+	commands, err = store.LoadCommands("staging")
+	require.NoError(t, err)
+	assert.Equal(t, []string{":nodes"}, commands, "history is per context")
+
+	commands, err = store.LoadCommands("unknown")
+	require.NoError(t, err)
+	assert.Empty(t, commands)
+}
+
+func TestStore_CommandsRedactedAndSingleLine(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir)
+
+	require.NoError(t, store.AppendCommand("prod", "/ai create secret --from-literal=password=hunter2\nnow"))
+
+	commands, err := store.LoadCommands("prod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/ai create secret --from-literal=password=*** now"}, commands)
+
+	content, err := os.ReadFile(filepath.Join(dir, "prod.commands"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "hunter2")
+}
+
+func TestStore_CommandsCappedAndCompacted(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir)
+
+	for i := 0; i < 2*MaxCommands+10; i++ {
+		require.NoError(t, store.AppendCommand("prod", fmt.Sprintf(":pods %d", i)))
+	}
+
+	commands, err := store.LoadCommands("prod")
+	require.NoError(t, err)
+	require.Len(t, commands, MaxCommands)
+	assert.Equal(t, fmt.Sprintf(":pods %d", 2*MaxCommands+9), commands[MaxCommands-1])
+
+	lines, err := readLines(filepath.Join(dir, "prod.commands"))
+	require.NoError(t, err)
+	assert.Len(t, lines, MaxCommands, "file compacted")
+}
+
+func TestStore_ContextFileNames(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir)
+
+	context := "arn:aws:eks:eu-west-1:123456789012:cluster/prod"
+	require.NoError(t, store.AppendCommand(context, ":pods"))
+	require.NoError(t, store.AppendCommand("", ":nodes"))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2, "context names must not create sub directories")
+
+	commands, err := store.LoadCommands(context)
+	require.NoError(t, err)
+	assert.Equal(t, []string{":pods"}, commands)
+
+	commands, err = store.LoadCommands("")
+	require.NoError(t, err)
+	assert.Equal(t, []string{":nodes"}, commands)
+}
+
+func TestStore_Outputs(t *testing.T) {
+	store := NewStore(t.TempDir())
+	now := time.Now().Truncate(time.Second)
+
+	require.NoError(t, store.AppendOutput(components.CommandOutput{
+		Command:        "/scale 3",
+		KubectlCommand: "kubectl scale deployment api --replicas=3 --token=abc123",
+		Output:         "deployment.apps/api scaled",
+		Status:         "success",
+		Context:        "prod",
+		Timestamp:      now,
+		Duration:       2 * time.Second,
+	}))
+	require.NoError(t, store.AppendOutput(components.CommandOutput{Command: ":pods", Context: "staging"}))
+
+	outputs, err := store.LoadOutputs("prod")
+	require.NoError(t, err)
+	require.Len(t, outputs, 1)
+	assert.Equal(t, "/scale 3", outputs[0].Command)
+	assert.Equal(t, "kubectl scale deployment api --replicas=3 --token=***", outputs[0].KubectlCommand)
+	assert.Equal(t, "success", outputs[0].Status)
+	assert.True(t, now.Equal(outputs[0].Timestamp))
+	assert.Equal(t, 2*time.Second, outputs[0].Duration)
+}
+
+func TestStore_Nil(t *testing.T) {
+	store := NewStore("")
+	assert.Nil(t, store)
+
+	require.NoError(t, store.AppendCommand("prod", ":pods"))
+	require.NoError(t, store.AppendOutput(components.CommandOutput{Command: ":pods"}))
+	commands, err := store.LoadCommands("prod")
+	require.NoError(t, err)
+	assert.Empty(t, commands)
+	outputs, err := store.LoadOutputs("prod")
+	require.NoError(t, err)
+	assert.Empty(t, outputs)
+}
@@ -0,0 +1,263 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+
+	"github.com/renato0307/k1/internal/logging"
+)
+
+// AccessDecision is the result of an access review
+type AccessDecision struct {
+	Allowed bool
+	Reason  string // Why access is denied (empty when allowed)
+}
+
+// accessKey identifies an access review (namespace "" = cluster scope)
+type accessKey struct {
+	verb        string
+	group       string
+	resource    string
+	subresource string
+	namespace   string
+}
+
+// String describes the access, e.g. "delete pods in namespace web"
+func (k accessKey) String() string {
+	resource := k.resource
+	if k.group != "" {
+		resource += "." + k.group
+	}
+	if k.subresource != "" {
+		resource += "/" + k.subresource
+	}
+	if k.namespace == "" {
+		return fmt.Sprintf("%s %s at cluster scope", k.verb, resource)
+	}
+	return fmt.Sprintf("%s %s in namespace %s", k.verb, resource, k.namespace)
+}
+
+type cachedRules struct {
+	status  authorizationv1.SubjectRulesReviewStatus
+	fetched time.Time
+}
+
+type cachedDecision struct {
+	decision AccessDecision
+	fetched  time.Time
+}
+
+// accessReviewer answers "can I" questions for the current user with one
+// SelfSubjectRulesReview per namespace, falling back to a
+// SelfSubjectAccessReview when the rules cannot tell (incomplete rules,
+// rules restricted to resource names, cluster-scoped resources). Results
+// are cached for AccessCacheTTL.
+type accessReviewer struct {
+	client authorizationv1client.AuthorizationV1Interface
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	rules     map[string]cachedRules // namespace → rules review
+	decisions map[accessKey]cachedDecision
+}
+
+func newAccessReviewer(client authorizationv1client.AuthorizationV1Interface) *accessReviewer {
+	return &accessReviewer{
+		client:    client,
+		ttl:       AccessCacheTTL,
+		now:       time.Now,
+		rules:     make(map[string]cachedRules),
+		decisions: make(map[accessKey]cachedDecision),
+	}
+}
+
+// check returns whether the user can perform the access. Reviews that fail
+// (e.g. timeouts) allow the access without caching it: the API server still
+// enforces RBAC when the command runs.
+func (a *accessReviewer) check(ctx context.Context, key accessKey) AccessDecision {
+	a.mu.Lock()
+	cached, ok := a.decisions[key]
+	a.mu.Unlock()
+	if ok && a.now().Sub(cached.fetched) < a.ttl {
+		return cached.decision
+	}
+
+	decision, err := a.review(ctx, key)
+	if err != nil {
+		logging.Warn("Access review failed", "access", key.String(), "error", err)
+		return AccessDecision{Allowed: true}
+	}
+
+	a.mu.Lock()
+	a.decisions[key] = cachedDecision{decision: decision, fetched: a.now()}
+	a.mu.Unlock()
+	return decision
+}
+
+// review decides from the rules of the namespace when possible, with a
+// SelfSubjectAccessReview otherwise
+func (a *accessReviewer) review(ctx context.Context, key accessKey) (AccessDecision, error) {
+	if key.namespace != "" {
+		status, err := a.rulesReview(ctx, key.namespace)
+		if err != nil {
+			logging.Debug("Rules review failed, using access review", "namespace", key.namespace, "error", err)
+		} else {
+			switch matchRules(status.ResourceRules, key) {
+			case ruleAllows:
+				return AccessDecision{Allowed: true}, nil
+			case ruleDenies:
+				if !status.Incomplete {
+					return AccessDecision{Reason: "cannot " + key.String()}, nil
+				}
+			}
+		}
+	}
+	return a.accessReview(ctx, key)
+}
+
+// rulesReview returns the (cached) rules of the user in a namespace
+func (a *accessReviewer) rulesReview(ctx context.Context, namespace string) (authorizationv1.SubjectRulesReviewStatus, error) {
+	a.mu.Lock()
+	cached, ok := a.rules[namespace]
+	a.mu.Unlock()
+	if ok && a.now().Sub(cached.fetched) < a.ttl {
+		return cached.status, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, AccessReviewTimeout)
+	defer cancel()
+	review := &authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: namespace},
+	}
+	result, err := a.client.SelfSubjectRulesReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return authorizationv1.SubjectRulesReviewStatus{}, err
+	}
+
+	a.mu.Lock()
+	a.rules[namespace] = cachedRules{status: result.Status, fetched: a.now()}
+	a.mu.Unlock()
+	return result.Status, nil
+}
+
+// accessReview asks the API server about a single access
+func (a *accessReviewer) accessReview(ctx context.Context, key accessKey) (AccessDecision, error) {
+	ctx, cancel := context.WithTimeout(ctx, AccessReviewTimeout)
+	defer cancel()
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   key.namespace,
+				Verb:        key.verb,
+				Group:       key.group,
+				Resource:    key.resource,
+				Subresource: key.subresource,
+			},
+		},
+	}
+	result, err := a.client.SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return AccessDecision{}, err
+	}
+	if result.Status.Allowed {
+		return AccessDecision{Allowed: true}, nil
+	}
+	reason := "cannot " + key.String()
+	if result.Status.Reason != "" {
+		reason += " (" + result.Status.Reason + ")"
+	}
+	return AccessDecision{Reason: reason}, nil
+}
+
+// ruleMatch is the outcome of matching an access against rules
+type ruleMatch int
+
+const (
+	ruleDenies  ruleMatch = iota // No rule grants the access
+	ruleAllows                   // A rule grants the access
+	ruleUnknown                  // A rule grants it for some resource names only
+)
+
+// matchRules checks an access against the resource rules of a namespace
+func matchRules(rules []authorizationv1.ResourceRule, key accessKey) ruleMatch {
+	resource := key.resource
+	if key.subresource != "" {
+		resource += "/" + key.subresource
+	}
+
+	result := ruleDenies
+	for _, rule := range rules {
+		if !matchesAny(rule.Verbs, key.verb) || !matchesAny(rule.APIGroups, key.group) || !matchesResource(rule.Resources, resource, key.subresource) {
+			continue
+		}
+		if len(rule.ResourceNames) > 0 {
+			result = ruleUnknown
+			continue
+		}
+		return ruleAllows
+	}
+	return result
+}
+
+// matchesAny reports whether values contain value or the * wildcard
+func matchesAny(values []string, value string) bool {
+	for _, v := range values {
+		if v == value || v == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesResource reports whether rule resources contain the resource (with
+// the *, */subresource and resource/* wildcards)
+func matchesResource(resources []string, resource, subresource string) bool {
+	for _, r := range resources {
+		switch {
+		case r == resource, r == "*":
+			return true
+		case subresource != "" && r == "*/"+subresource:
+			return true
+		case subresource != "" && len(r) > 2 && r[len(r)-2:] == "/*" && r[:len(r)-1] == resource[:len(resource)-len(subresource)]:
+			return true
+		}
+	}
+	return false
+}
+
+// CheckAccess returns whether the current user can perform verb on a
+// resource (and subresource) in a namespace ("" = cluster scope)
+func (r *InformerRepository) CheckAccess(verb string, gvr schema.GroupVersionResource, subresource, namespace string) AccessDecision {
+	if r.access == nil {
+		return AccessDecision{Allowed: true}
+	}
+	return r.access.check(r.ctx, accessKey{
+		verb:        verb,
+		group:       gvr.Group,
+		resource:    gvr.Resource,
+		subresource: subresource,
+		namespace:   namespace,
+	})
+}
+
+// checkInformerAccess marks the resource types the user cannot list
+// cluster-wide as forbidden (their informers never sync)
+func (r *InformerRepository) checkInformerAccess() {
+	for gvr, stats := range r.resourceStats {
+		decision := r.CheckAccess("list", gvr, "", "")
+		if decision.Allowed {
+			continue
+		}
+		logging.Info("Resource type forbidden", "resource", gvr.Resource, "reason", decision.Reason)
+		stats.Forbidden = true
+		stats.ForbiddenReason = decision.Reason
+	}
+}
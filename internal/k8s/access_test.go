@@ -0,0 +1,130 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newTestAccessReviewer returns a reviewer answering rules reviews with rules
+// (incomplete when set) and access reviews with allowed, counting the calls
+func newTestAccessReviewer(rules []authorizationv1.ResourceRule, incomplete, allowed bool) (*accessReviewer, *int, *int) {
+	clientset := fake.NewSimpleClientset()
+	rulesCalls, accessCalls := 0, 0
+	clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		rulesCalls++
+		return true, &authorizationv1.SelfSubjectRulesReview{
+			Status: authorizationv1.SubjectRulesReviewStatus{ResourceRules: rules, Incomplete: incomplete},
+		}, nil
+	})
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		accessCalls++
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed, Reason: "no RBAC policy matched"},
+		}, nil
+	})
+	return newAccessReviewer(clientset.AuthorizationV1()), &rulesCalls, &accessCalls
+}
+
+func TestMatchRules(t *testing.T) {
+	rules := []authorizationv1.ResourceRule{
+		{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}},
+		{Verbs: []string{"*"}, APIGroups: []string{"apps"}, Resources: []string{"deployments/*"}},
+		{Verbs: []string{"patch"}, APIGroups: []string{"apps"}, Resources: []string{"deployments"}, ResourceNames: []string{"api"}},
+	}
+
+	tests := []struct {
+		name string
+		key  accessKey
+		want ruleMatch
+	}{
+		{"verb and resource", accessKey{verb: "get", resource: "pods"}, ruleAllows},
+		{"subresource", accessKey{verb: "get", resource: "pods", subresource: "log"}, ruleAllows},
+		{"other verb", accessKey{verb: "delete", resource: "pods"}, ruleDenies},
+		{"other subresource", accessKey{verb: "get", resource: "pods", subresource: "exec"}, ruleDenies},
+		{"subresource wildcard", accessKey{verb: "patch", group: "apps", resource: "deployments", subresource: "scale"}, ruleAllows},
+		{"other group", accessKey{verb: "get", group: "apps", resource: "pods"}, ruleDenies},
+		{"resource names", accessKey{verb: "patch", group: "apps", resource: "deployments"}, ruleUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchRules(rules, tt.key))
+		})
+	}
+}
+
+func TestAccessReviewer_RulesReview(t *testing.T) {
+	rules := []authorizationv1.ResourceRule{
+		{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}},
+	}
+	reviewer, rulesCalls, accessCalls := newTestAccessReviewer(rules, false, true)
+
+	assert.True(t, reviewer.check(context.Background(), accessKey{verb: "get", resource: "pods", namespace: "web"}).Allowed)
+	decision := reviewer.check(context.Background(), accessKey{verb: "delete", resource: "pods", namespace: "web"})
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, "cannot delete pods in namespace web", decision.Reason)
+
+	assert.Equal(t, 1, *rulesCalls, "one rules review per namespace")
+	assert.Equal(t, 0, *accessCalls, "complete rules need no access review")
+}
+
+func TestAccessReviewer_FallsBackToAccessReview(t *testing.T) {
+	tests := []struct {
+		name       string
+		rules      []authorizationv1.ResourceRule
+		incomplete bool
+		key        accessKey
+	}{
+		{"incomplete rules", nil, true, accessKey{verb: "delete", resource: "pods", namespace: "web"}},
+		{"resource names", []authorizationv1.ResourceRule{
+			{Verbs: []string{"delete"}, APIGroups: []string{""}, Resources: []string{"pods"}, ResourceNames: []string{"api-1"}},
+		}, false, accessKey{verb: "delete", resource: "pods", namespace: "web"}},
+		{"cluster scope", nil, false, accessKey{verb: "list", resource: "nodes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviewer, _, accessCalls := newTestAccessReviewer(tt.rules, tt.incomplete, false)
+
+			decision := reviewer.check(context.Background(), tt.key)
+			assert.False(t, decision.Allowed)
+			assert.Contains(t, decision.Reason, "no RBAC policy matched")
+			assert.Equal(t, 1, *accessCalls)
+		})
+	}
+}
+
+func TestAccessReviewer_Cache(t *testing.T) {
+	reviewer, _, accessCalls := newTestAccessReviewer(nil, false, true)
+	now := time.Now()
+	reviewer.now = func() time.Time { return now }
+	key := accessKey{verb: "list", resource: "nodes"}
+
+	reviewer.check(context.Background(), key)
+	reviewer.check(context.Background(), key)
+	assert.Equal(t, 1, *accessCalls, "cached decision reused")
+
+	now = now.Add(AccessCacheTTL + time.Second)
+	reviewer.check(context.Background(), key)
+	assert.Equal(t, 2, *accessCalls, "expired decision reviewed again")
+}
+
+func TestAccessReviewer_ErrorAllows(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	reviewer := newAccessReviewer(clientset.AuthorizationV1())
+
+	decision := reviewer.check(context.Background(), accessKey{verb: "delete", resource: "pods", namespace: "web"})
+	assert.True(t, decision.Allowed, "the API server still enforces RBAC")
+	assert.Empty(t, reviewer.decisions, "errors are not cached")
+}
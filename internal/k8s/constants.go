@@ -19,4 +19,39 @@ const (
 	// resource lists. Since informers sync in parallel, total startup time is
 	// max(all individual syncs), not sum(all individual syncs).
 	InformerIndividualSyncTimeout = 30 * time.Second
+
+	// OnDemandFetchTimeout is the timeout for fetching a single object from the
+	// API server when it is not fully cached (metadata-only resources such as
+	// Secrets). Short because it blocks an explicit user action.
+	OnDemandFetchTimeout = 10 * time.Second
+
+	// LogsFetchTimeout is the timeout for fetching container logs from the
+	// kubelet (through the API server). Longer than OnDemandFetchTimeout
+	// because log payloads can be large.
+	LogsFetchTimeout = 30 * time.Second
+
+	// AccessReviewTimeout is the timeout for a SelfSubjectRulesReview or
+	// SelfSubjectAccessReview. Short because the palette waits for it.
+	AccessReviewTimeout = 5 * time.Second
+
+	// AccessCacheTTL is how long access review results are reused. Role
+	// changes are rare, and a stale answer only greys out a command (the API
+	// server still has the final word).
+	AccessCacheTTL = 5 * time.Minute
+
+	// SnapshotPageSize is the number of objects listed per request when
+	// capturing a snapshot (paging keeps large lists from timing out).
+	SnapshotPageSize = 500
+
+	// SnapshotListTimeout is the timeout for listing one page of objects when
+	// capturing a snapshot.
+	SnapshotListTimeout = 60 * time.Second
+
+	// DefaultHistoryMaxVersions is the number of versions the object history
+	// keeps per object when not configured.
+	DefaultHistoryMaxVersions = 20
+
+	// DefaultHistoryMaxObjects is the number of objects the object history
+	// tracks when not configured (the least recently changed are dropped).
+	DefaultHistoryMaxObjects = 500
 )
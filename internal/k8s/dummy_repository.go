@@ -1,8 +1,10 @@
 package k8s
 
 import (
+	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -367,6 +369,182 @@ func (r *DummyRepository) GetPodsUsingSecret(namespace, name string) ([]Pod, err
 	return filteredPods, nil
 }
 
+func (r *DummyRepository) GetNodeDetail(name string) (*NodeDetail, error) {
+	// Return a fixed, partially allocated node for testing
+	return &NodeDetail{
+		Name:   name,
+		Status: "Ready",
+		Conditions: []NodeCondition{
+			{Type: "MemoryPressure", Status: "False", Reason: "KubeletHasSufficientMemory"},
+			{Type: "DiskPressure", Status: "False", Reason: "KubeletHasNoDiskPressure"},
+			{Type: "Ready", Status: "True", Reason: "KubeletReady", Message: "kubelet is posting ready status"},
+		},
+		Taints:    []string{"dedicated=gpu:NoSchedule"},
+		Addresses: []string{"InternalIP: 10.0.1.10", "Hostname: " + name},
+		Resources: []NodeResourceAllocation{
+			{Name: "cpu", Capacity: resource.MustParse("4"), Allocatable: resource.MustParse("3920m"), Requests: resource.MustParse("1960m"), Limits: resource.MustParse("4")},
+			{Name: "memory", Capacity: resource.MustParse("16Gi"), Allocatable: resource.MustParse("15Gi"), Requests: resource.MustParse("3Gi"), Limits: resource.MustParse("6Gi")},
+			{Name: "ephemeral-storage", Capacity: resource.MustParse("100Gi"), Allocatable: resource.MustParse("90Gi")},
+			{Name: "pods", Capacity: resource.MustParse("110"), Allocatable: resource.MustParse("110"), Requests: resource.MustParse("2")},
+		},
+		Pods: []NodePodAllocation{
+			{Namespace: "default", Name: "nginx-abc123-xyz789", Status: "Running", CPURequests: resource.MustParse("960m"), CPULimits: resource.MustParse("2"), MemoryRequests: resource.MustParse("1Gi"), MemoryLimits: resource.MustParse("2Gi"), Age: 5 * time.Hour},
+			{Namespace: "kube-system", Name: "coredns-5d78c9869d-abcde", Status: "Running", CPURequests: resource.MustParse("1"), CPULimits: resource.MustParse("2"), MemoryRequests: resource.MustParse("2Gi"), MemoryLimits: resource.MustParse("4Gi"), Age: 48 * time.Hour},
+		},
+	}, nil
+}
+
+func (r *DummyRepository) GetOwnershipTree(gvr schema.GroupVersionResource, namespace, name string) (*OwnershipNode, error) {
+	// Return a fixed Deployment → ReplicaSet → Pods tree for testing
+	rsGVR := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}
+	podGVR := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	now := time.Now()
+
+	return &OwnershipNode{
+		GVR:       gvr,
+		Kind:      "Deployment",
+		Namespace: namespace,
+		Name:      name,
+		Status:    "Ready 2/2",
+		CreatedAt: now.Add(-24 * time.Hour),
+		Children: []*OwnershipNode{
+			{
+				GVR:       rsGVR,
+				Kind:      "ReplicaSet",
+				Namespace: namespace,
+				Name:      name + "-abc123",
+				Status:    "Ready 2/2",
+				CreatedAt: now.Add(-5 * time.Hour),
+				Children: []*OwnershipNode{
+					{GVR: podGVR, Kind: "Pod", Namespace: namespace, Name: name + "-abc123-xyz789", Status: "Running 1/1", CreatedAt: now.Add(-5 * time.Hour)},
+					{GVR: podGVR, Kind: "Pod", Namespace: namespace, Name: name + "-abc123-uvw456", Status: "Running 1/1", CreatedAt: now.Add(-5 * time.Hour)},
+				},
+			},
+		},
+	}, nil
+}
+
+func (r *DummyRepository) GetBindingsForSubject(kind, namespace, name string) ([]RoleBinding, error) {
+	// Return a fixed namespace edit binding for testing
+	return []RoleBinding{
+		{
+			ResourceMetadata: ResourceMetadata{Namespace: namespace, Name: name + "-edit", Age: 24 * time.Hour, CreatedAt: time.Now().Add(-24 * time.Hour)},
+			Kind:             "RoleBinding",
+			Role:             "ClusterRole/edit",
+			Subjects:         RBACSubject{Kind: kind, Namespace: namespace, Name: name}.String(),
+		},
+	}, nil
+}
+
+func (r *DummyRepository) GetRulesForRole(kind, namespace, name string) ([]PolicyRule, error) {
+	// Return fixed read-only rules for testing
+	return []PolicyRule{
+		{Name: "#1", Verbs: "get, list, watch", Resources: "pods, services"},
+		{Name: "#2", Verbs: "get", Resources: "pods/log"},
+		{Name: "#3", Verbs: "get", Resources: "configmaps", ResourceNames: "app-config"},
+	}, nil
+}
+
+func (r *DummyRepository) GetSubjectsWhoCan(verb, resource, namespace string) ([]RBACGrant, error) {
+	// Return fixed grants (cluster admins and a namespace editor) for testing
+	return []RBACGrant{
+		{Name: "admin", Subject: "Group system:masters", Scope: "*", Binding: "ClusterRoleBinding cluster-admin", Role: "ClusterRole/cluster-admin", Verbs: "*", Resources: "*.*"},
+		{Name: "editor", Subject: "ServiceAccount default/deployer", Scope: "default", Binding: "RoleBinding default/deployer-edit", Role: "ClusterRole/edit", Verbs: verb, Resources: resource},
+	}, nil
+}
+
+func (r *DummyRepository) GetSubjectPermissions(kind, namespace, name string) ([]RBACGrant, error) {
+	// Return fixed grants (discovery everywhere, edit in one namespace) for testing
+	subject := RBACSubject{Kind: kind, Namespace: namespace, Name: name}.String()
+	return []RBACGrant{
+		{Name: "discovery", Subject: "Group system:authenticated", Scope: "*", Binding: "ClusterRoleBinding system:discovery", Role: "ClusterRole/system:discovery", Verbs: "get", NonResourceURLs: "/api, /api/*"},
+		{Name: "edit", Subject: subject, Scope: "default", Binding: "RoleBinding default/deployer-edit", Role: "ClusterRole/edit", Verbs: "create, delete, patch, update", Resources: "deployments.apps"},
+	}, nil
+}
+
+func (r *DummyRepository) GetObjectHistory(gvr schema.GroupVersionResource, namespace, name string) (*ObjectHistory, error) {
+	// Return a fixed scale up followed by an image change for testing
+	manifest := func(replicas int, image string) string {
+		return fmt.Sprintf(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: %s
+  namespace: %s
+spec:
+  replicas: %d
+  template:
+    spec:
+      containers:
+      - image: %s
+        name: app
+status:
+  replicas: %d
+`, name, namespace, replicas, image, replicas)
+	}
+	now := time.Now()
+	return &ObjectHistory{
+		GVR:       gvr,
+		Namespace: namespace,
+		Name:      name,
+		Versions: []ObjectVersion{
+			{ResourceVersion: "100", Manifest: manifest(1, "nginx:1.25")},
+			{ObservedAt: now.Add(-2 * time.Minute), ResourceVersion: "101", Changes: []string{"spec.replicas", "status.replicas"}, Manifest: manifest(3, "nginx:1.25")},
+			{ObservedAt: now.Add(-time.Minute), ResourceVersion: "102", Changes: []string{"spec.template.spec.containers[0].image"}, Manifest: manifest(3, "nginx:1.26")},
+		},
+	}, nil
+}
+
+func (r *DummyRepository) GetTopLevelOwner(gvr schema.GroupVersionResource, namespace, name string) (ResourceRef, error) {
+	// Return a fixed Deployment owner for testing
+	return ResourceRef{
+		GVR:       schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+		Kind:      "Deployment",
+		Namespace: namespace,
+		Name:      "nginx",
+	}, nil
+}
+
+func (r *DummyRepository) GetSecretData(namespace, name string) (map[string][]byte, error) {
+	// Return dummy payload for testing
+	return map[string][]byte{
+		"username": []byte("admin"),
+		"password": []byte("s3cr3t"),
+		"config":   []byte(`{"debug":true,"replicas":3}`),
+	}, nil
+}
+
+func (r *DummyRepository) GetConfigMapData(namespace, name string) (*ConfigMapData, error) {
+	// Return dummy payload for testing
+	return &ConfigMapData{
+		Values: map[string][]byte{
+			"app.yaml":       []byte("server:\n  port: 8080\n  debug: false\n"),
+			"app.properties": []byte("server.port=8080\nserver.debug=false\n"),
+			"entrypoint.sh":  []byte("#!/bin/sh\nexec /app/server\n"),
+			"settings.json":  []byte(`{"replicas":3}`),
+			"LOG_LEVEL":      []byte("info"),
+		},
+	}, nil
+}
+
+func (r *DummyRepository) PatchConfigMap(namespace, name string, patch []byte) error {
+	return nil
+}
+
+func (r *DummyRepository) GetPreviousContainerLogs(namespace, podName, container string, tailLines int64) (*PreviousContainerLogs, error) {
+	// Return a fixed crashed instance for testing
+	if container == "" {
+		container = "app"
+	}
+	return &PreviousContainerLogs{
+		Container:    container,
+		RestartCount: 5,
+		Reason:       "Error",
+		ExitCode:     1,
+		FinishedAt:   time.Now().Add(-2 * time.Minute),
+		Logs:         "starting server\nfailed to connect to database: connection refused\n",
+	}, nil
+}
+
 func (r *DummyRepository) GetPodsForReplicaSet(namespace, name string) ([]Pod, error) {
 	// Return dummy pods
 	return []Pod{
@@ -418,6 +596,51 @@ func (r *DummyRepository) GetPodsForPVC(namespace, name string) ([]Pod, error) {
 	}, nil
 }
 
+func (r *DummyRepository) DiagnosePod(namespace, name string) (*PodDiagnosis, error) {
+	// Return a fixed crash looping pod for testing
+	return &PodDiagnosis{
+		Namespace: namespace,
+		Name:      name,
+		Phase:     "Running",
+		Findings: []DiagnosisFinding{
+			{
+				Severity: DiagnosisCritical,
+				Title:    "Container app was OOMKilled (5 restarts)",
+				Details:  []string{"Last termination: OOMKilled, exit code 137, 2m ago", "Container exceeded its memory limit 256Mi"},
+				Actions:  []string{"/logs-previous app", "Raise the memory limit or reduce the memory usage of the process"},
+			},
+			{
+				Severity: DiagnosisInfo,
+				Title:    "Warning event BackOff (x12), 1m ago",
+				Details:  []string{"Back-off restarting failed container app"},
+			},
+		},
+	}, nil
+}
+
+func (r *DummyRepository) GetClusterPulse() (*ClusterPulse, error) {
+	// Return a cluster with a crash looping pod whose deployment misses a replica
+	return &ClusterPulse{
+		Tiles: []PulseTile{
+			{ID: "pods-phase-Running", Group: "Pods", Title: "Running", Count: 3, Loaded: true, ResourceType: ResourceTypePod, Keys: []string{"default/nginx-deployment-7d64f8d9c8-abc12", "default/nginx-deployment-7d64f8d9c8-def34", "kube-system/coredns-5d78c9869d-xyz89"}},
+			{ID: "pods-phase-Pending", Group: "Pods", Title: "Pending", Loaded: true, ResourceType: ResourceTypePod},
+			{ID: "pods-reason-CrashLoopBackOff", Group: "Pods", Title: "CrashLoopBackOff", Count: 1, Severity: PulseCritical, Loaded: true, ResourceType: ResourceTypePod, Keys: []string{"production/api-server-6b9f8c7d5e-qwert"}},
+			{ID: "nodes-not-ready", Group: "Nodes", Title: "Not ready", Loaded: true, ResourceType: ResourceTypeNode},
+			{ID: "deployments-unavailable", Group: "Workloads", Title: "Deployments missing replicas", Count: 1, Severity: PulseWarning, Loaded: true, ResourceType: ResourceTypeDeployment, Keys: []string{"production/api-server"}},
+			{ID: PulseTileWarningEvents, Group: "Events", Title: "Warning events (last 10m)", Loaded: true},
+		},
+	}, nil
+}
+
+func (r *DummyRepository) GetContainersForPod(namespace, name string) ([]Container, error) {
+	// Return an init container, a crashing app container and a sidecar
+	return []Container{
+		{Namespace: namespace, Pod: name, Name: "migrate", Type: ContainerTypeInit, Image: "app:1.2.0", Ready: "false", State: "Terminated: Completed"},
+		{Namespace: namespace, Pod: name, Name: "app", Type: ContainerTypeApp, Image: "app:1.2.0", Ready: "false", State: "Waiting: CrashLoopBackOff", Restarts: 5, LastTermination: "Error (1)", Ports: "8080/TCP", Requests: "100m/128Mi", Limits: "500m/256Mi"},
+		{Namespace: namespace, Pod: name, Name: "proxy", Type: ContainerTypeApp, Image: "envoy:v1.30", Ready: "true", State: "Running", Ports: "9901/TCP"},
+	}, nil
+}
+
 func (r *DummyRepository) GetResourceYAML(gvr schema.GroupVersionResource, namespace, name string) (string, error) {
 	// Return dummy YAML for development
 	return `apiVersion: v1
@@ -486,6 +709,11 @@ func (r *DummyRepository) GetResources(resourceType ResourceType) ([]any, error)
 	}
 }
 
+// CheckAccess allows everything (no RBAC in dummy data)
+func (r *DummyRepository) CheckAccess(verb string, gvr schema.GroupVersionResource, subresource, namespace string) AccessDecision {
+	return AccessDecision{Allowed: true}
+}
+
 func (r *DummyRepository) GetResourceStats() []ResourceStats {
 	now := time.Now()
 	return []ResourceStats{
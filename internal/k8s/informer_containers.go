@@ -0,0 +1,132 @@
+package k8s
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Container types, in the order containers are listed
+const (
+	ContainerTypeInit      = "init"
+	ContainerTypeSidecar   = "sidecar" // Init container with restartPolicy Always
+	ContainerTypeApp       = "app"
+	ContainerTypeEphemeral = "ephemeral"
+)
+
+// GetContainersForPod returns the init, regular and ephemeral containers of
+// a pod with their status (from the pod informer cache)
+func (r *InformerRepository) GetContainersForPod(namespace, name string) ([]Container, error) {
+	pod, err := r.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod: %w", err)
+	}
+	return buildContainers(pod), nil
+}
+
+// buildContainers flattens the containers of a pod, matching each spec with
+// its status by name (containers without a status have not started yet)
+func buildContainers(pod *corev1.Pod) []Container {
+	statuses := make(map[string]corev1.ContainerStatus)
+	for _, list := range [][]corev1.ContainerStatus{
+		pod.Status.InitContainerStatuses,
+		pod.Status.ContainerStatuses,
+		pod.Status.EphemeralContainerStatuses,
+	} {
+		for _, status := range list {
+			statuses[status.Name] = status
+		}
+	}
+
+	newContainer := func(spec corev1.Container, containerType string) Container {
+		status, hasStatus := statuses[spec.Name]
+		container := Container{
+			Namespace: pod.Namespace,
+			Pod:       pod.Name,
+			Name:      spec.Name,
+			Type:      containerType,
+			Image:     spec.Image,
+			Ready:     strconv.FormatBool(status.Ready),
+			State:     "Waiting",
+			Ports:     formatContainerPorts(spec.Ports),
+			Requests:  formatCPUMemory(spec.Resources.Requests),
+			Limits:    formatCPUMemory(spec.Resources.Limits),
+		}
+		if !hasStatus {
+			return container
+		}
+
+		container.State = formatContainerState(status.State)
+		container.Restarts = status.RestartCount
+		if terminated := status.LastTerminationState.Terminated; terminated != nil {
+			container.LastTermination = fmt.Sprintf("%s (%d)", terminated.Reason, terminated.ExitCode)
+		}
+		return container
+	}
+
+	containers := make([]Container, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers)+len(pod.Spec.EphemeralContainers))
+	for _, spec := range pod.Spec.InitContainers {
+		containerType := ContainerTypeInit
+		if spec.RestartPolicy != nil && *spec.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			containerType = ContainerTypeSidecar
+		}
+		containers = append(containers, newContainer(spec, containerType))
+	}
+	for _, spec := range pod.Spec.Containers {
+		containers = append(containers, newContainer(spec, ContainerTypeApp))
+	}
+	for _, spec := range pod.Spec.EphemeralContainers {
+		containers = append(containers, newContainer(corev1.Container(spec.EphemeralContainerCommon), ContainerTypeEphemeral))
+	}
+	return containers
+}
+
+// formatContainerState formats a container state (with its reason)
+func formatContainerState(state corev1.ContainerState) string {
+	switch {
+	case state.Running != nil:
+		return "Running"
+	case state.Waiting != nil && state.Waiting.Reason != "":
+		return "Waiting: " + state.Waiting.Reason
+	case state.Terminated != nil && state.Terminated.Reason != "":
+		return "Terminated: " + state.Terminated.Reason
+	case state.Terminated != nil:
+		return "Terminated"
+	default:
+		return "Waiting"
+	}
+}
+
+// formatContainerPorts formats container ports as port/protocol
+func formatContainerPorts(ports []corev1.ContainerPort) string {
+	parts := make([]string, len(ports))
+	for i, port := range ports {
+		protocol := port.Protocol
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+		parts[i] = fmt.Sprintf("%d/%s", port.ContainerPort, protocol)
+	}
+	return strings.Join(parts, ",")
+}
+
+// formatCPUMemory formats the cpu and memory of a resource list as cpu/memory
+// ("-" for an unset one, empty when both are unset)
+func formatCPUMemory(list corev1.ResourceList) string {
+	cpu, hasCPU := list[corev1.ResourceCPU]
+	memory, hasMemory := list[corev1.ResourceMemory]
+	if !hasCPU && !hasMemory {
+		return ""
+	}
+
+	cpuStr, memoryStr := "-", "-"
+	if hasCPU {
+		cpuStr = cpu.String()
+	}
+	if hasMemory {
+		memoryStr = memory.String()
+	}
+	return cpuStr + "/" + memoryStr
+}
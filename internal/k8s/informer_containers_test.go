@@ -0,0 +1,100 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBuildContainers(t *testing.T) {
+	always := corev1.ContainerRestartPolicyAlways
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web-0"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Name: "migrate", Image: "app:1.2.0"},
+				{Name: "proxy", Image: "envoy:v1.30", RestartPolicy: &always},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:  "app",
+					Image: "app:1.2.0",
+					Ports: []corev1.ContainerPort{
+						{ContainerPort: 8080},
+						{ContainerPort: 9090, Protocol: corev1.ProtocolUDP},
+					},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("100m"),
+							corev1.ResourceMemory: resource.MustParse("128Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("256Mi"),
+						},
+					},
+				},
+			},
+			EphemeralContainers: []corev1.EphemeralContainer{
+				{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger", Image: "busybox"}},
+			},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "migrate", State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"},
+				}},
+				{Name: "proxy", Ready: true, State: corev1.ContainerState{
+					Running: &corev1.ContainerStateRunning{},
+				}},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         "app",
+					RestartCount: 5,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+					},
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+					},
+				},
+			},
+		},
+	}
+
+	containers := buildContainers(pod)
+	require.Len(t, containers, 4)
+
+	assert.Equal(t, "migrate", containers[0].Name)
+	assert.Equal(t, ContainerTypeInit, containers[0].Type)
+	assert.Equal(t, "Terminated: Completed", containers[0].State)
+
+	assert.Equal(t, ContainerTypeSidecar, containers[1].Type)
+	assert.Equal(t, "true", containers[1].Ready)
+	assert.Equal(t, "Running", containers[1].State)
+
+	app := containers[2]
+	assert.Equal(t, Container{
+		Namespace:       "shop",
+		Pod:             "web-0",
+		Name:            "app",
+		Type:            ContainerTypeApp,
+		Image:           "app:1.2.0",
+		Ready:           "false",
+		State:           "Waiting: CrashLoopBackOff",
+		Restarts:        5,
+		LastTermination: "OOMKilled (137)",
+		Ports:           "8080/TCP,9090/UDP",
+		Requests:        "100m/128Mi",
+		Limits:          "-/256Mi",
+	}, app)
+
+	// Ephemeral container without a status yet
+	assert.Equal(t, ContainerTypeEphemeral, containers[3].Type)
+	assert.Equal(t, "Waiting", containers[3].State)
+	assert.Empty(t, containers[3].Requests)
+}
@@ -0,0 +1,506 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Pod diagnosis
+//
+// The pod list reduces a pod to a phase and a ready count, but answering "why
+// is this pod unhealthy" needs the container states, last terminations,
+// scheduling conditions, the objects the pod depends on and recent Warning
+// events. DiagnosePod gathers all of them (events are fetched on demand,
+// everything else comes from the informer cache) and turns them into ranked
+// findings, each with suggested next actions.
+
+// DiagnosisSeverity ranks diagnosis findings (most severe first)
+type DiagnosisSeverity int
+
+const (
+	DiagnosisInfo DiagnosisSeverity = iota
+	DiagnosisWarning
+	DiagnosisCritical
+)
+
+// String returns the severity label
+func (s DiagnosisSeverity) String() string {
+	switch s {
+	case DiagnosisCritical:
+		return "CRITICAL"
+	case DiagnosisWarning:
+		return "WARNING"
+	default:
+		return "INFO"
+	}
+}
+
+// DiagnosisFinding is one explanation of why a pod is unhealthy
+type DiagnosisFinding struct {
+	Severity DiagnosisSeverity
+	Title    string
+	Details  []string
+	Actions  []string // Suggested next actions (k1 commands or things to check)
+}
+
+// PodDiagnosis is the ranked list of findings for a pod
+type PodDiagnosis struct {
+	Namespace string
+	Name      string
+	Phase     string
+	Ready     bool
+	Findings  []DiagnosisFinding // Sorted by severity (most severe first)
+}
+
+// diagnosisLookup returns a cached object the pod depends on. known is false
+// when the informer for the resource type is not loaded (check skipped).
+type diagnosisLookup func(resourceType ResourceType, namespace, name string) (obj *unstructured.Unstructured, known bool)
+
+// maxDiagnosisEvents is the number of recent Warning events reported
+const maxDiagnosisEvents = 5
+
+// DiagnosePod explains why a pod is not healthy
+func (r *InformerRepository) DiagnosePod(namespace, name string) (*PodDiagnosis, error) {
+	pod, err := r.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod: %w", err)
+	}
+
+	lookup := func(resourceType ResourceType, namespace, name string) (*unstructured.Unstructured, bool) {
+		config, ok := r.resources[resourceType]
+		if !ok {
+			return nil, false
+		}
+		r.mu.RLock()
+		_, loaded := r.dynamicListers[config.GVR]
+		r.mu.RUnlock()
+		if !loaded {
+			return nil, false
+		}
+		obj, err := r.getCachedObject(config.GVR, namespace, name)
+		if err != nil {
+			return nil, true
+		}
+		return obj, true
+	}
+
+	// Events are not cached - a failed fetch only loses that part
+	events, err := r.fetchEventsForResource(namespace, name, string(pod.UID))
+	if err != nil {
+		events = nil
+	}
+
+	return diagnosePod(pod, lookup, events, time.Now()), nil
+}
+
+// diagnosePod builds the ranked findings for a pod
+func diagnosePod(pod *corev1.Pod, lookup diagnosisLookup, events []corev1.Event, now time.Time) *PodDiagnosis {
+	d := &PodDiagnosis{
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		Phase:     string(pod.Status.Phase),
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+			d.Ready = true
+		}
+	}
+
+	d.Findings = append(d.Findings, diagnoseScheduling(pod)...)
+	d.Findings = append(d.Findings, diagnoseContainers(pod, now)...)
+	d.Findings = append(d.Findings, diagnoseDependencies(pod, lookup)...)
+	d.Findings = append(d.Findings, diagnoseEvents(events, now)...)
+
+	if len(d.Findings) == 0 {
+		title := "No problems found"
+		if !d.Ready {
+			title = "No problems found, but the pod is not Ready yet"
+		}
+		d.Findings = append(d.Findings, DiagnosisFinding{
+			Severity: DiagnosisInfo,
+			Title:    title,
+			Actions:  []string{"/describe for the full pod state"},
+		})
+	}
+
+	sort.SliceStable(d.Findings, func(i, j int) bool {
+		return d.Findings[i].Severity > d.Findings[j].Severity
+	})
+	return d
+}
+
+// diagnoseScheduling reports pods the scheduler could not place
+func diagnoseScheduling(pod *corev1.Pod) []DiagnosisFinding {
+	for _, c := range pod.Status.Conditions {
+		if c.Type != corev1.PodScheduled || c.Status != corev1.ConditionFalse {
+			continue
+		}
+		finding := DiagnosisFinding{
+			Severity: DiagnosisCritical,
+			Title:    "Pod cannot be scheduled",
+			Actions: []string{
+				"Compare the pod requests with free node capacity (/show-node on a node)",
+				"Check node selectors, affinity and tolerations against node labels and taints",
+			},
+		}
+		if c.Message != "" {
+			finding.Details = append(finding.Details, c.Message)
+		}
+		return []DiagnosisFinding{finding}
+	}
+	return nil
+}
+
+// diagnoseContainers reports waiting, failing, crash looping and OOMKilled
+// containers (init containers included)
+func diagnoseContainers(pod *corev1.Pod, now time.Time) []DiagnosisFinding {
+	specs := make(map[string]corev1.Container)
+	for _, c := range pod.Spec.InitContainers {
+		specs[c.Name] = c
+	}
+	for _, c := range pod.Spec.Containers {
+		specs[c.Name] = c
+	}
+
+	var findings []DiagnosisFinding
+	check := func(status corev1.ContainerStatus, isInit bool) {
+		spec := specs[status.Name]
+		label := "Container " + status.Name
+		if isInit {
+			label = "Init container " + status.Name
+		}
+		lastTerminated := status.LastTerminationState.Terminated
+
+		switch {
+		case status.State.Waiting != nil:
+			if finding, ok := diagnoseWaiting(label, status, spec, now); ok {
+				findings = append(findings, finding)
+				return
+			}
+		case status.State.Terminated != nil && status.State.Terminated.ExitCode != 0:
+			terminated := status.State.Terminated
+			findings = append(findings, DiagnosisFinding{
+				Severity: DiagnosisCritical,
+				Title:    fmt.Sprintf("%s exited with code %d (%s)", label, terminated.ExitCode, terminated.Reason),
+				Details:  nonEmpty(terminated.Message),
+				Actions:  []string{"/logs " + status.Name, exitCodeHint(terminated.ExitCode)},
+			})
+			return
+		case status.State.Running != nil && !status.Ready && !isInit:
+			findings = append(findings, DiagnosisFinding{
+				Severity: DiagnosisWarning,
+				Title:    label + " is running but not ready",
+				Details:  probeDetails(spec.ReadinessProbe, "Readiness"),
+				Actions:  []string{"/logs " + status.Name, "Check the readiness probe endpoint and timings (Unhealthy events below)"},
+			})
+		}
+
+		// Past crashes of a container that is up again
+		if lastTerminated != nil && status.RestartCount > 0 {
+			findings = append(findings, restartFinding(label, status, spec, now))
+		}
+	}
+
+	for _, status := range pod.Status.InitContainerStatuses {
+		check(status, true)
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		check(status, false)
+	}
+	return findings
+}
+
+// diagnoseWaiting explains a waiting container from its reason
+func diagnoseWaiting(label string, status corev1.ContainerStatus, spec corev1.Container, now time.Time) (DiagnosisFinding, bool) {
+	waiting := status.State.Waiting
+	details := nonEmpty(waiting.Message)
+
+	switch waiting.Reason {
+	case "ImagePullBackOff", "ErrImagePull", "InvalidImageName", "ErrImageNeverPull":
+		return DiagnosisFinding{
+			Severity: DiagnosisCritical,
+			Title:    fmt.Sprintf("%s cannot pull image %s (%s)", label, spec.Image, waiting.Reason),
+			Details:  details,
+			Actions: []string{
+				"Check the image name and tag exist in the registry",
+				"Check imagePullSecrets for private registries",
+			},
+		}, true
+	case "CrashLoopBackOff":
+		finding := restartFinding(label, status, spec, now)
+		finding.Severity = DiagnosisCritical
+		finding.Title = fmt.Sprintf("%s is crash looping (%d restarts)", label, status.RestartCount)
+		if terminated := status.LastTerminationState.Terminated; terminated != nil && terminated.Reason == "OOMKilled" {
+			finding.Title = fmt.Sprintf("%s is crash looping, OOMKilled (%d restarts)", label, status.RestartCount)
+		}
+		return finding, true
+	case "CreateContainerConfigError", "CreateContainerError":
+		return DiagnosisFinding{
+			Severity: DiagnosisCritical,
+			Title:    fmt.Sprintf("%s cannot be created (%s)", label, waiting.Reason),
+			Details:  details,
+			Actions:  []string{"Check the ConfigMaps, Secrets and keys referenced by env, envFrom and volumes"},
+		}, true
+	case "ContainerCreating", "PodInitializing", "":
+		return DiagnosisFinding{}, false
+	default:
+		return DiagnosisFinding{
+			Severity: DiagnosisWarning,
+			Title:    fmt.Sprintf("%s is waiting (%s)", label, waiting.Reason),
+			Details:  details,
+			Actions:  []string{"/describe for the full pod state"},
+		}, true
+	}
+}
+
+// restartFinding explains the last termination of a restarted container
+func restartFinding(label string, status corev1.ContainerStatus, spec corev1.Container, now time.Time) DiagnosisFinding {
+	terminated := status.LastTerminationState.Terminated
+	finding := DiagnosisFinding{
+		Severity: DiagnosisWarning,
+		Title:    fmt.Sprintf("%s restarted %d times", label, status.RestartCount),
+		Actions:  []string{"/logs-previous " + status.Name},
+	}
+	if terminated == nil {
+		return finding
+	}
+
+	detail := fmt.Sprintf("Last termination: %s, exit code %d", terminated.Reason, terminated.ExitCode)
+	if !terminated.FinishedAt.IsZero() {
+		detail += ", " + formatEventAge(now.Sub(terminated.FinishedAt.Time)) + " ago"
+	}
+	finding.Details = append(finding.Details, detail)
+	finding.Details = append(finding.Details, nonEmpty(terminated.Message)...)
+
+	if terminated.Reason == "OOMKilled" {
+		finding.Severity = DiagnosisCritical
+		finding.Title = fmt.Sprintf("%s was OOMKilled (%d restarts)", label, status.RestartCount)
+		limit := "no memory limit"
+		if memory, ok := spec.Resources.Limits[corev1.ResourceMemory]; ok {
+			limit = "memory limit " + memory.String()
+		}
+		finding.Details = append(finding.Details, "Container exceeded its "+limit)
+		finding.Actions = append(finding.Actions, "Raise the memory limit or reduce the memory usage of the process")
+		return finding
+	}
+
+	finding.Actions = append(finding.Actions, exitCodeHint(terminated.ExitCode))
+	if spec.LivenessProbe != nil {
+		finding.Details = append(finding.Details, probeDetails(spec.LivenessProbe, "Liveness")...)
+	}
+	return finding
+}
+
+// exitCodeHint explains common container exit codes
+func exitCodeHint(code int32) string {
+	switch code {
+	case 0:
+		return "Process exited successfully - check if it is meant to keep running"
+	case 1:
+		return "Exit code 1 is an application error - the previous logs should show it"
+	case 126, 127:
+		return "Exit code 126/127: the command is not executable or not found in the image"
+	case 137:
+		return "Exit code 137 (SIGKILL): killed by the kubelet (OOM or failed liveness probe)"
+	case 143:
+		return "Exit code 143 (SIGTERM): stopped by the kubelet (failed liveness probe or shutdown)"
+	default:
+		return fmt.Sprintf("Exit code %d is application specific - check the previous logs", code)
+	}
+}
+
+// probeDetails summarizes a probe configuration
+func probeDetails(probe *corev1.Probe, name string) []string {
+	if probe == nil {
+		return nil
+	}
+	target := "exec"
+	switch {
+	case probe.HTTPGet != nil:
+		target = fmt.Sprintf("GET %s on port %s", probe.HTTPGet.Path, probe.HTTPGet.Port.String())
+	case probe.TCPSocket != nil:
+		target = "TCP port " + probe.TCPSocket.Port.String()
+	case probe.GRPC != nil:
+		target = fmt.Sprintf("gRPC port %d", probe.GRPC.Port)
+	}
+	return []string{fmt.Sprintf("%s probe: %s (delay %ds, timeout %ds, period %ds, failure threshold %d)",
+		name, target, probe.InitialDelaySeconds, probe.TimeoutSeconds, probe.PeriodSeconds, probe.FailureThreshold)}
+}
+
+// diagnoseDependencies reports missing ConfigMaps/Secrets and unbound PVCs
+// (checked against the informer cache, optional references are skipped)
+func diagnoseDependencies(pod *corev1.Pod, lookup diagnosisLookup) []DiagnosisFinding {
+	var findings []DiagnosisFinding
+	reported := make(map[string]bool)
+
+	missing := func(resourceType ResourceType, kind, name, usage string) {
+		key := kind + "/" + name
+		if name == "" || reported[key] {
+			return
+		}
+		obj, known := lookup(resourceType, pod.Namespace, name)
+		if !known || obj != nil {
+			return
+		}
+		reported[key] = true
+		findings = append(findings, DiagnosisFinding{
+			Severity: DiagnosisCritical,
+			Title:    fmt.Sprintf("%s %s does not exist", kind, name),
+			Details:  []string{"Referenced by " + usage},
+			Actions:  []string{fmt.Sprintf("Create %s %s in namespace %s or mark the reference optional", kind, name, pod.Namespace)},
+		})
+	}
+
+	isOptional := func(optional *bool) bool { return optional != nil && *optional }
+
+	for _, volume := range pod.Spec.Volumes {
+		usage := "volume " + volume.Name
+		switch {
+		case volume.ConfigMap != nil && !isOptional(volume.ConfigMap.Optional):
+			missing(ResourceTypeConfigMap, "ConfigMap", volume.ConfigMap.Name, usage)
+		case volume.Secret != nil && !isOptional(volume.Secret.Optional):
+			missing(ResourceTypeSecret, "Secret", volume.Secret.SecretName, usage)
+		case volume.Projected != nil:
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil && !isOptional(source.ConfigMap.Optional) {
+					missing(ResourceTypeConfigMap, "ConfigMap", source.ConfigMap.Name, usage)
+				}
+				if source.Secret != nil && !isOptional(source.Secret.Optional) {
+					missing(ResourceTypeSecret, "Secret", source.Secret.Name, usage)
+				}
+			}
+		case volume.PersistentVolumeClaim != nil:
+			if finding, ok := diagnosePVC(pod.Namespace, volume.PersistentVolumeClaim.ClaimName, usage, lookup); ok {
+				findings = append(findings, finding)
+			}
+		}
+	}
+
+	containers := append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, container := range containers {
+		usage := "container " + container.Name
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil && !isOptional(envFrom.ConfigMapRef.Optional) {
+				missing(ResourceTypeConfigMap, "ConfigMap", envFrom.ConfigMapRef.Name, usage+" (envFrom)")
+			}
+			if envFrom.SecretRef != nil && !isOptional(envFrom.SecretRef.Optional) {
+				missing(ResourceTypeSecret, "Secret", envFrom.SecretRef.Name, usage+" (envFrom)")
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil && !isOptional(ref.Optional) {
+				missing(ResourceTypeConfigMap, "ConfigMap", ref.Name, usage+" (env "+env.Name+")")
+			}
+			if ref := env.ValueFrom.SecretKeyRef; ref != nil && !isOptional(ref.Optional) {
+				missing(ResourceTypeSecret, "Secret", ref.Name, usage+" (env "+env.Name+")")
+			}
+		}
+	}
+	return findings
+}
+
+// diagnosePVC reports a claim that does not exist or is not bound
+func diagnosePVC(namespace, claimName, usage string, lookup diagnosisLookup) (DiagnosisFinding, bool) {
+	obj, known := lookup(ResourceTypePersistentVolumeClaim, namespace, claimName)
+	if !known {
+		return DiagnosisFinding{}, false
+	}
+	if obj == nil {
+		return DiagnosisFinding{
+			Severity: DiagnosisCritical,
+			Title:    fmt.Sprintf("PersistentVolumeClaim %s does not exist", claimName),
+			Details:  []string{"Referenced by " + usage},
+			Actions:  []string{"Create the claim or fix the claimName"},
+		}, true
+	}
+
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if phase == string(corev1.ClaimBound) {
+		return DiagnosisFinding{}, false
+	}
+	storageClass, _, _ := unstructured.NestedString(obj.Object, "spec", "storageClassName")
+	details := []string{"Referenced by " + usage}
+	if storageClass != "" {
+		details = append(details, "Storage class: "+storageClass)
+	}
+	if request, found, _ := unstructured.NestedString(obj.Object, "spec", "resources", "requests", "storage"); found {
+		if quantity, err := resource.ParseQuantity(request); err == nil {
+			details = append(details, "Requested: "+quantity.String())
+		}
+	}
+	return DiagnosisFinding{
+		Severity: DiagnosisCritical,
+		Title:    fmt.Sprintf("PersistentVolumeClaim %s is %s", claimName, strings.ToLower(phaseOrUnknown(phase))),
+		Details:  details,
+		Actions: []string{
+			"Open the claim on the PVCs screen and /describe it for provisioning events",
+			"Check the storage class exists and its provisioner is running",
+		},
+	}, true
+}
+
+// diagnoseEvents reports the most recent Warning events
+func diagnoseEvents(events []corev1.Event, now time.Time) []DiagnosisFinding {
+	var warnings []corev1.Event
+	for _, event := range events {
+		if event.Type == corev1.EventTypeWarning {
+			warnings = append(warnings, event)
+		}
+	}
+	sort.Slice(warnings, func(i, j int) bool {
+		return eventTime(warnings[i]).After(eventTime(warnings[j]))
+	})
+
+	var findings []DiagnosisFinding
+	for i, event := range warnings {
+		if i == maxDiagnosisEvents {
+			break
+		}
+		title := fmt.Sprintf("Warning event %s", event.Reason)
+		if event.Count > 1 {
+			title += fmt.Sprintf(" (x%d)", event.Count)
+		}
+		if t := eventTime(event); !t.IsZero() {
+			title += ", " + formatEventAge(now.Sub(t)) + " ago"
+		}
+		findings = append(findings, DiagnosisFinding{
+			Severity: DiagnosisInfo,
+			Title:    title,
+			Details:  nonEmpty(strings.TrimSpace(event.Message)),
+		})
+	}
+	return findings
+}
+
+// eventTime returns the last time an event was seen
+func eventTime(event corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	return event.EventTime.Time
+}
+
+// phaseOrUnknown returns the phase, or "Unknown" when unset
+func phaseOrUnknown(phase string) string {
+	if phase == "" {
+		return "Unknown"
+	}
+	return phase
+}
+
+// nonEmpty returns s as a single-element slice, or nil when empty
+func nonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return []string{s}
+}
@@ -0,0 +1,184 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// newDiagnosisLookup returns a lookup over a fixed set of cached objects
+// (keyed by resource type and name); PVCs carry their phase
+func newDiagnosisLookup(objects map[ResourceType]map[string]string) diagnosisLookup {
+	return func(resourceType ResourceType, namespace, name string) (*unstructured.Unstructured, bool) {
+		byName, known := objects[resourceType]
+		if !known {
+			return nil, false
+		}
+		phase, ok := byName[name]
+		if !ok {
+			return nil, true
+		}
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"name": name, "namespace": namespace},
+			"status":   map[string]interface{}{"phase": phase},
+		}}, true
+	}
+}
+
+func findingTitles(d *PodDiagnosis) []string {
+	titles := make([]string, len(d.Findings))
+	for i, finding := range d.Findings {
+		titles[i] = finding.Severity.String() + " " + finding.Title
+	}
+	return titles
+}
+
+func TestDiagnosePod(t *testing.T) {
+	now := time.Now()
+	lookup := newDiagnosisLookup(map[ResourceType]map[string]string{
+		ResourceTypeConfigMap:             {"app-config": ""},
+		ResourceTypePersistentVolumeClaim: {"data": "Pending"},
+	})
+
+	t.Run("crash looping OOMKilled container", func(t *testing.T) {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web-0"},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name: "app",
+				Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+				}},
+			}}},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:         "app",
+					RestartCount: 5,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+					},
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+					},
+				}},
+			},
+		}
+		events := []corev1.Event{
+			{Type: corev1.EventTypeNormal, Reason: "Pulled"},
+			{Type: corev1.EventTypeWarning, Reason: "BackOff", Count: 12, Message: "Back-off restarting failed container",
+				LastTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+		}
+
+		d := diagnosePod(pod, lookup, events, now)
+		assert.False(t, d.Ready)
+		assert.Equal(t, []string{
+			"CRITICAL Container app is crash looping, OOMKilled (5 restarts)",
+			"INFO Warning event BackOff (x12), 1m ago",
+		}, findingTitles(d))
+		assert.Contains(t, d.Findings[0].Details, "Container exceeded its memory limit 256Mi")
+		assert.Contains(t, d.Findings[0].Actions, "/logs-previous app")
+	})
+
+	t.Run("image pull failure and missing dependencies", func(t *testing.T) {
+		optional := true
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web-1"},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:  "app",
+					Image: "registry.example.com/app:typo",
+					EnvFrom: []corev1.EnvFromSource{
+						{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "app-config"}}},
+						{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "feature-flags"}}},
+						{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "overrides"}, Optional: &optional}},
+						// Secrets informer not loaded: not checked
+						{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "db"}}},
+					},
+				}},
+				Volumes: []corev1.Volume{
+					{Name: "data", VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+					}},
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "app",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+				}},
+			},
+		}
+
+		d := diagnosePod(pod, lookup, nil, now)
+		assert.Equal(t, []string{
+			"CRITICAL Container app cannot pull image registry.example.com/app:typo (ImagePullBackOff)",
+			"CRITICAL PersistentVolumeClaim data is pending",
+			"CRITICAL ConfigMap feature-flags does not exist",
+		}, findingTitles(d))
+	})
+
+	t.Run("unschedulable pod", func(t *testing.T) {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web-2"},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Reason:  "Unschedulable",
+					Message: "0/3 nodes are available: 3 Insufficient cpu.",
+				}},
+			},
+		}
+
+		d := diagnosePod(pod, lookup, nil, now)
+		require.Len(t, d.Findings, 1)
+		assert.Equal(t, "Pod cannot be scheduled", d.Findings[0].Title)
+		assert.Equal(t, []string{"0/3 nodes are available: 3 Insufficient cpu."}, d.Findings[0].Details)
+	})
+
+	t.Run("running but not ready", func(t *testing.T) {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web-3"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "app",
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				}},
+			},
+		}
+
+		d := diagnosePod(pod, lookup, nil, now)
+		assert.Equal(t, []string{"WARNING Container app is running but not ready"}, findingTitles(d))
+	})
+
+	t.Run("healthy pod", func(t *testing.T) {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "web-4"},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		}
+
+		d := diagnosePod(pod, lookup, nil, now)
+		assert.True(t, d.Ready)
+		assert.Equal(t, []string{"INFO No problems found"}, findingTitles(d))
+	})
+}
+
+func TestExitCodeHint(t *testing.T) {
+	assert.Contains(t, exitCodeHint(137), "SIGKILL")
+	assert.Contains(t, exitCodeHint(143), "SIGTERM")
+	assert.Contains(t, exitCodeHint(127), "not found")
+	assert.Contains(t, exitCodeHint(42), "42")
+}
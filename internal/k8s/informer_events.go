@@ -10,6 +10,9 @@ import (
 // setupDynamicInformersEventTracking registers event handlers for statistics tracking on all dynamic informers
 func (r *InformerRepository) setupDynamicInformersEventTracking(dynamicInformers map[schema.GroupVersionResource]cache.SharedIndexInformer) {
 	for gvr, informer := range dynamicInformers {
+		r.trackOwnership(gvr, informer)
+		r.trackHistory(gvr, informer)
+
 		// Skip job informer (already has tracking in setupJobIndexes)
 		if gvr.Group == "batch" && gvr.Resource == "jobs" {
 			continue
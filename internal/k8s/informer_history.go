@@ -0,0 +1,333 @@
+package k8s
+
+import (
+	"container/list"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/renato0307/k1/internal/logging"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/yaml"
+)
+
+// Object history
+//
+// Informers receive every version of every object but only keep the latest.
+// When enabled (opt-in, see EnableObjectHistory), the versions observed
+// during the session are recorded per object so the history view can show
+// what changed and when. Only objects that change are tracked: their first
+// update records the previous version as the baseline. Memory is bounded
+// twice, by the versions kept per object and by the number of objects (the
+// least recently changed are dropped). Metadata-only informers deliver
+// projections, so Secret and ConfigMap histories hold metadata changes only.
+
+// ObjectHistoryOptions bounds the object history
+type ObjectHistoryOptions struct {
+	MaxVersions int // Versions kept per object (0 = default)
+	MaxObjects  int // Objects tracked (0 = default)
+}
+
+// ObjectVersion is a version of an object observed by an informer
+type ObjectVersion struct {
+	ObservedAt      time.Time
+	ResourceVersion string
+	Changes         []string // Field paths changed since the previous version (none for the baseline)
+	Deleted         bool
+	Manifest        string // YAML, without managed fields and resource version
+}
+
+// ObjectHistory is the versions of an object observed during the session
+// (oldest first)
+type ObjectHistory struct {
+	GVR       schema.GroupVersionResource
+	Namespace string
+	Name      string
+	Versions  []ObjectVersion
+}
+
+// historyIgnoredPaths change on every update and are not reported
+var historyIgnoredPaths = []string{"metadata.resourceVersion", "metadata.managedFields"}
+
+// historyKey identifies a tracked object
+type historyKey struct {
+	gvr       schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+// historyRecorder keeps the bounded object history of a repository
+type historyRecorder struct {
+	mu          sync.Mutex
+	maxVersions int
+	maxObjects  int
+	objects     map[historyKey]*list.Element // values are *ObjectHistory
+	lru         *list.List                   // front = most recently changed
+}
+
+func newHistoryRecorder(opts ObjectHistoryOptions) *historyRecorder {
+	if opts.MaxVersions <= 0 {
+		opts.MaxVersions = DefaultHistoryMaxVersions
+	}
+	if opts.MaxVersions < 2 {
+		opts.MaxVersions = 2 // A baseline and a change
+	}
+	if opts.MaxObjects <= 0 {
+		opts.MaxObjects = DefaultHistoryMaxObjects
+	}
+	return &historyRecorder{
+		maxVersions: opts.MaxVersions,
+		maxObjects:  opts.MaxObjects,
+		objects:     make(map[historyKey]*list.Element),
+		lru:         list.New(),
+	}
+}
+
+// EnableObjectHistory starts recording the versions of objects that change
+// (informers already running are covered from now on)
+func (r *InformerRepository) EnableObjectHistory(opts ObjectHistoryOptions) {
+	r.history.Store(newHistoryRecorder(opts))
+}
+
+// GetObjectHistory returns the versions of an object observed since history
+// was enabled (none if the object has not changed)
+func (r *InformerRepository) GetObjectHistory(gvr schema.GroupVersionResource, namespace, name string) (*ObjectHistory, error) {
+	recorder := r.history.Load()
+	if recorder == nil {
+		return nil, fmt.Errorf("object history is disabled (start k1 with -object-history)")
+	}
+	return recorder.get(historyKey{gvr: gvr, namespace: namespace, name: name}), nil
+}
+
+// trackHistory registers object history recording on a dynamic informer
+// (idempotent - on-demand CR informers may be ensured many times). Handlers
+// are cheap no-ops while history is disabled.
+func (r *InformerRepository) trackHistory(gvr schema.GroupVersionResource, informer cache.SharedIndexInformer) {
+	r.mu.Lock()
+	if r.historyTracked[gvr] {
+		r.mu.Unlock()
+		return
+	}
+	r.historyTracked[gvr] = true
+	r.mu.Unlock()
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			recorder := r.history.Load()
+			if recorder == nil {
+				return
+			}
+			oldUnstr, ok := oldObj.(*unstructured.Unstructured)
+			if !ok {
+				return
+			}
+			newUnstr, ok := newObj.(*unstructured.Unstructured)
+			if !ok {
+				return
+			}
+			// Resyncs deliver the cached object again
+			if oldUnstr.GetResourceVersion() == newUnstr.GetResourceVersion() {
+				return
+			}
+			recorder.recordUpdate(gvr, oldUnstr, newUnstr, time.Now())
+		},
+		DeleteFunc: func(obj interface{}) {
+			recorder := r.history.Load()
+			if recorder == nil {
+				return
+			}
+			// Handle DeletedFinalStateUnknown wrapper
+			unstr, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					return
+				}
+				unstr, ok = tombstone.Obj.(*unstructured.Unstructured)
+				if !ok {
+					return
+				}
+			}
+			recorder.recordDelete(gvr, unstr, time.Now())
+		},
+	})
+	if err != nil {
+		logging.Warn("Failed to track object history", "resource", gvr.Resource, "error", err)
+	}
+}
+
+// recordUpdate appends the new version of an object (and the previous one
+// as the baseline when the object was not tracked yet)
+func (h *historyRecorder) recordUpdate(gvr schema.GroupVersionResource, oldObj, newObj *unstructured.Unstructured, now time.Time) {
+	changes := changedFieldPaths(oldObj.Object, newObj.Object)
+	if len(changes) == 0 {
+		return
+	}
+	// Manifests are printed outside the lock
+	baseline := ObjectVersion{ResourceVersion: oldObj.GetResourceVersion(), Manifest: historyManifest(oldObj)}
+	version := ObjectVersion{
+		ObservedAt:      now,
+		ResourceVersion: newObj.GetResourceVersion(),
+		Changes:         changes,
+		Manifest:        historyManifest(newObj),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := historyKey{gvr: gvr, namespace: newObj.GetNamespace(), name: newObj.GetName()}
+	history := h.touch(key, true)
+	if len(history.Versions) == 0 {
+		// The previous version was seen when the informer listed or last
+		// updated the object, not now
+		history.Versions = append(history.Versions, baseline)
+	}
+	h.append(history, version)
+}
+
+// recordDelete appends a deleted version to tracked objects (objects that
+// never changed are not worth tracking for their deletion alone)
+func (h *historyRecorder) recordDelete(gvr schema.GroupVersionResource, obj *unstructured.Unstructured, now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	history := h.touch(historyKey{gvr: gvr, namespace: obj.GetNamespace(), name: obj.GetName()}, false)
+	if history == nil {
+		return
+	}
+	h.append(history, ObjectVersion{
+		ObservedAt:      now,
+		ResourceVersion: obj.GetResourceVersion(),
+		Deleted:         true,
+		Manifest:        historyManifest(obj),
+	})
+}
+
+// touch returns the history of key marked as most recently changed, creating
+// it (and dropping the least recently changed object) when create is set.
+// Assumes lock held.
+func (h *historyRecorder) touch(key historyKey, create bool) *ObjectHistory {
+	if elem, ok := h.objects[key]; ok {
+		h.lru.MoveToFront(elem)
+		return elem.Value.(*ObjectHistory)
+	}
+	if !create {
+		return nil
+	}
+
+	history := &ObjectHistory{GVR: key.gvr, Namespace: key.namespace, Name: key.name}
+	h.objects[key] = h.lru.PushFront(history)
+	for h.lru.Len() > h.maxObjects {
+		oldest := h.lru.Back()
+		evicted := h.lru.Remove(oldest).(*ObjectHistory)
+		delete(h.objects, historyKey{gvr: evicted.GVR, namespace: evicted.Namespace, name: evicted.Name})
+	}
+	return history
+}
+
+// append adds a version, dropping the oldest beyond the limit. Assumes lock held.
+func (h *historyRecorder) append(history *ObjectHistory, version ObjectVersion) {
+	history.Versions = append(history.Versions, version)
+	if excess := len(history.Versions) - h.maxVersions; excess > 0 {
+		history.Versions = append([]ObjectVersion(nil), history.Versions[excess:]...)
+	}
+}
+
+// get returns a copy of the history of key (empty if not tracked)
+func (h *historyRecorder) get(key historyKey) *ObjectHistory {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	result := &ObjectHistory{GVR: key.gvr, Namespace: key.namespace, Name: key.name}
+	if elem, ok := h.objects[key]; ok {
+		result.Versions = append([]ObjectVersion(nil), elem.Value.(*ObjectHistory).Versions...)
+	}
+	return result
+}
+
+// historyManifest prints an object as YAML without the fields that change on
+// every update (versions are diffed against each other)
+func historyManifest(obj *unstructured.Unstructured) string {
+	copied := obj.DeepCopy()
+	copied.SetManagedFields(nil)
+	copied.SetResourceVersion("")
+	data, err := yaml.Marshal(copied.Object)
+	if err != nil {
+		return fmt.Sprintf("# failed to print YAML: %v\n", err)
+	}
+	return string(data)
+}
+
+// changedFieldPaths returns the sorted field paths (e.g.
+// spec.template.spec.containers[0].image) whose values differ between two
+// objects
+func changedFieldPaths(oldObj, newObj map[string]any) []string {
+	oldFields := make(map[string]any)
+	newFields := make(map[string]any)
+	flattenFields("", oldObj, oldFields)
+	flattenFields("", newObj, newFields)
+
+	var changes []string
+	for path, oldValue := range oldFields {
+		if newValue, ok := newFields[path]; !ok || !reflect.DeepEqual(oldValue, newValue) {
+			changes = append(changes, path)
+		}
+	}
+	for path := range newFields {
+		if _, ok := oldFields[path]; !ok {
+			changes = append(changes, path)
+		}
+	}
+
+	filtered := changes[:0]
+	for _, path := range changes {
+		if !isIgnoredHistoryPath(path) {
+			filtered = append(filtered, path)
+		}
+	}
+	sort.Strings(filtered)
+	return filtered
+}
+
+func isIgnoredHistoryPath(path string) bool {
+	for _, ignored := range historyIgnoredPaths {
+		if path == ignored || strings.HasPrefix(path, ignored+".") || strings.HasPrefix(path, ignored+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenFields maps the path of every leaf value (scalars, empty maps and
+// empty lists) to the value
+func flattenFields(prefix string, value any, fields map[string]any) {
+	switch typed := value.(type) {
+	case map[string]any:
+		if len(typed) == 0 && prefix != "" {
+			fields[prefix] = typed
+			return
+		}
+		for key, child := range typed {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			flattenFields(path, child, fields)
+		}
+	case []any:
+		if len(typed) == 0 {
+			fields[prefix] = typed
+			return
+		}
+		for i, child := range typed {
+			flattenFields(fmt.Sprintf("%s[%d]", prefix, i), child, fields)
+		}
+	default:
+		fields[prefix] = typed
+	}
+}
@@ -0,0 +1,147 @@
+package k8s
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var historyDeploymentGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+func historyDeployment(name, resourceVersion string, replicas int64, image string) *unstructured.Unstructured {
+	obj := snapshotObject("apps/v1", "Deployment", "web", name, name+"-uid", map[string]any{
+		"spec": map[string]any{
+			"replicas": replicas,
+			"template": map[string]any{"spec": map[string]any{
+				"containers": []any{map[string]any{"name": "api", "image": image}},
+			}},
+		},
+	})
+	obj.SetResourceVersion(resourceVersion)
+	return &obj
+}
+
+func TestChangedFieldPaths(t *testing.T) {
+	oldObj := historyDeployment("api", "1", 2, "api:1").Object
+	newObj := historyDeployment("api", "2", 3, "api:2").Object
+	newObj["metadata"].(map[string]any)["labels"] = map[string]any{"team": "payments"}
+	newObj["metadata"].(map[string]any)["managedFields"] = []any{map[string]any{"manager": "kubectl"}}
+
+	assert.Equal(t, []string{
+		"metadata.labels.team",
+		"spec.replicas",
+		"spec.template.spec.containers[0].image",
+	}, changedFieldPaths(oldObj, newObj), "resourceVersion and managedFields are noise")
+
+	// Removed fields and emptied lists are changes too
+	emptied := historyDeployment("api", "3", 2, "api:1").Object
+	unstructured.SetNestedSlice(emptied, []any{}, "spec", "template", "spec", "containers")
+	delete(emptied["spec"].(map[string]any), "replicas")
+	assert.Equal(t, []string{
+		"spec.replicas",
+		"spec.template.spec.containers",
+		"spec.template.spec.containers[0].image",
+		"spec.template.spec.containers[0].name",
+	}, changedFieldPaths(oldObj, emptied))
+
+	assert.Empty(t, changedFieldPaths(oldObj, historyDeployment("api", "4", 2, "api:1").Object))
+}
+
+func TestHistoryRecorder(t *testing.T) {
+	recorder := newHistoryRecorder(ObjectHistoryOptions{MaxVersions: 3, MaxObjects: 2})
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	key := historyKey{gvr: historyDeploymentGVR, namespace: "web", name: "api"}
+
+	// Deleting an untracked object records nothing
+	recorder.recordDelete(historyDeploymentGVR, historyDeployment("api", "1", 2, "api:1"), now)
+	assert.Empty(t, recorder.get(key).Versions)
+
+	// The first change records the previous version as the baseline
+	recorder.recordUpdate(historyDeploymentGVR, historyDeployment("api", "1", 2, "api:1"), historyDeployment("api", "2", 3, "api:1"), now)
+	versions := recorder.get(key).Versions
+	require.Len(t, versions, 2)
+	assert.Equal(t, "1", versions[0].ResourceVersion)
+	assert.True(t, versions[0].ObservedAt.IsZero(), "the baseline was seen before history started")
+	assert.Empty(t, versions[0].Changes)
+	assert.Contains(t, versions[0].Manifest, "replicas: 2")
+	assert.Equal(t, []string{"spec.replicas"}, versions[1].Changes)
+	assert.Equal(t, now, versions[1].ObservedAt)
+	assert.Contains(t, versions[1].Manifest, "replicas: 3")
+
+	// Versions are bounded per object (oldest dropped)
+	recorder.recordUpdate(historyDeploymentGVR, historyDeployment("api", "2", 3, "api:1"), historyDeployment("api", "3", 3, "api:2"), now.Add(time.Minute))
+	recorder.recordDelete(historyDeploymentGVR, historyDeployment("api", "4", 3, "api:2"), now.Add(2*time.Minute))
+	versions = recorder.get(key).Versions
+	require.Len(t, versions, 3)
+	assert.Equal(t, []string{"2", "3", "4"}, []string{versions[0].ResourceVersion, versions[1].ResourceVersion, versions[2].ResourceVersion})
+	assert.True(t, versions[2].Deleted)
+
+	// Objects are bounded too (least recently changed dropped)
+	for i := range 2 {
+		name := fmt.Sprintf("worker-%d", i)
+		recorder.recordUpdate(historyDeploymentGVR, historyDeployment(name, "1", 1, "w:1"), historyDeployment(name, "2", 2, "w:1"), now)
+	}
+	assert.Empty(t, recorder.get(key).Versions)
+	assert.Len(t, recorder.get(historyKey{gvr: historyDeploymentGVR, namespace: "web", name: "worker-0"}).Versions, 2)
+
+	// Returned histories are copies
+	history := recorder.get(historyKey{gvr: historyDeploymentGVR, namespace: "web", name: "worker-1"})
+	history.Versions[0].ResourceVersion = "changed"
+	assert.Equal(t, "1", recorder.get(historyKey{gvr: historyDeploymentGVR, namespace: "web", name: "worker-1"}).Versions[0].ResourceVersion)
+}
+
+func TestInformerRepository_GetObjectHistory(t *testing.T) {
+	repo := &InformerRepository{}
+
+	_, err := repo.GetObjectHistory(historyDeploymentGVR, "web", "api")
+	assert.ErrorContains(t, err, "-object-history")
+
+	repo.EnableObjectHistory(ObjectHistoryOptions{})
+	history, err := repo.GetObjectHistory(historyDeploymentGVR, "web", "api")
+	require.NoError(t, err)
+	assert.Equal(t, "api", history.Name)
+	assert.Empty(t, history.Versions)
+}
+
+func TestInformerRepository_TrackHistory(t *testing.T) {
+	snapshotRepo, err := NewSnapshotRepository(newTestSnapshot())
+	require.NoError(t, err)
+	defer snapshotRepo.Close()
+	repo := snapshotRepo.InformerRepository
+	repo.EnableObjectHistory(ObjectHistoryOptions{})
+
+	require.Eventually(t, func() bool { return repo.IsInformerSynced(historyDeploymentGVR) }, 5*time.Second, 10*time.Millisecond)
+	client := repo.dynamicClient.Resource(historyDeploymentGVR).Namespace("web")
+	deployment, err := client.Get(t.Context(), "api", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	// Scaled by a controller
+	require.NoError(t, unstructured.SetNestedField(deployment.Object, int64(5), "spec", "replicas"))
+	deployment.SetResourceVersion("2")
+	_, err = client.Update(t.Context(), deployment, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	var history *ObjectHistory
+	require.Eventually(t, func() bool {
+		history, err = repo.GetObjectHistory(historyDeploymentGVR, "web", "api")
+		return err == nil && len(history.Versions) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"spec.replicas"}, history.Versions[1].Changes)
+
+	require.NoError(t, client.Delete(t.Context(), "api", metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		history, err = repo.GetObjectHistory(historyDeploymentGVR, "web", "api")
+		return err == nil && len(history.Versions) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, history.Versions[2].Deleted)
+
+	// Snapshots never change
+	_, err = snapshotRepo.GetObjectHistory(historyDeploymentGVR, "web", "api")
+	assert.Error(t, err)
+}
@@ -0,0 +1,100 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PreviousContainerLogs are the logs of the previous (terminated) instance of
+// a container with how that instance ended
+type PreviousContainerLogs struct {
+	Container    string
+	RestartCount int32
+	Reason       string // lastState.terminated.reason (Error, OOMKilled, ...)
+	Message      string
+	ExitCode     int32
+	Signal       int32
+	FinishedAt   time.Time
+	Logs         string
+}
+
+// GetPreviousContainerLogs fetches the logs of the previous instance of a
+// container (previous=true). When container is empty, the container that
+// terminated most recently is used. tailLines <= 0 fetches all lines.
+func (r *InformerRepository) GetPreviousContainerLogs(namespace, podName, container string, tailLines int64) (*PreviousContainerLogs, error) {
+	pod, err := r.podLister.Pods(namespace).Get(podName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod %s/%s: %w", namespace, podName, err)
+	}
+
+	status, err := previousContainerStatus(pod, container)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &corev1.PodLogOptions{
+		Container: status.Name,
+		Previous:  true,
+	}
+	if tailLines > 0 {
+		opts.TailLines = &tailLines
+	}
+
+	ctx, cancel := context.WithTimeout(r.ctx, LogsFetchTimeout)
+	defer cancel()
+
+	logs, err := r.clientset.CoreV1().Pods(namespace).GetLogs(podName, opts).DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch previous logs of %s/%s (%s): %w", namespace, podName, status.Name, err)
+	}
+
+	terminated := status.LastTerminationState.Terminated
+	return &PreviousContainerLogs{
+		Container:    status.Name,
+		RestartCount: status.RestartCount,
+		Reason:       terminated.Reason,
+		Message:      terminated.Message,
+		ExitCode:     terminated.ExitCode,
+		Signal:       terminated.Signal,
+		FinishedAt:   terminated.FinishedAt.Time,
+		Logs:         string(logs),
+	}, nil
+}
+
+// previousContainerStatus returns the status of the named container (init
+// containers included) if it has a terminated previous instance. Without a
+// name, it picks the container whose previous instance finished last.
+func previousContainerStatus(pod *corev1.Pod, container string) (corev1.ContainerStatus, error) {
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+
+	if container != "" {
+		for _, status := range statuses {
+			if status.Name != container {
+				continue
+			}
+			if status.LastTerminationState.Terminated == nil {
+				return corev1.ContainerStatus{}, fmt.Errorf("container %s has no previous instance", container)
+			}
+			return status, nil
+		}
+		return corev1.ContainerStatus{}, fmt.Errorf("container %s not found in pod %s/%s", container, pod.Namespace, pod.Name)
+	}
+
+	var latest *corev1.ContainerStatus
+	for i := range statuses {
+		terminated := statuses[i].LastTerminationState.Terminated
+		if terminated == nil {
+			continue
+		}
+		if latest == nil || terminated.FinishedAt.After(latest.LastTerminationState.Terminated.FinishedAt.Time) {
+			latest = &statuses[i]
+		}
+	}
+	if latest == nil {
+		return corev1.ContainerStatus{}, fmt.Errorf("no container in pod %s/%s has a previous instance", pod.Namespace, pod.Name)
+	}
+	return *latest, nil
+}
@@ -0,0 +1,70 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func terminatedStatus(name string, finishedAt time.Time) corev1.ContainerStatus {
+	return corev1.ContainerStatus{
+		Name: name,
+		LastTerminationState: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{
+				Reason:     "Error",
+				ExitCode:   1,
+				FinishedAt: metav1.NewTime(finishedAt),
+			},
+		},
+	}
+}
+
+func TestPreviousContainerStatus(t *testing.T) {
+	now := time.Now()
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				terminatedStatus("migrate", now.Add(-time.Hour)),
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "sidecar"},
+				terminatedStatus("app", now.Add(-time.Minute)),
+			},
+		},
+	}
+
+	t.Run("most recently terminated container by default", func(t *testing.T) {
+		status, err := previousContainerStatus(pod, "")
+		require.NoError(t, err)
+		assert.Equal(t, "app", status.Name)
+	})
+
+	t.Run("named init container", func(t *testing.T) {
+		status, err := previousContainerStatus(pod, "migrate")
+		require.NoError(t, err)
+		assert.Equal(t, "migrate", status.Name)
+	})
+
+	t.Run("container that never restarted", func(t *testing.T) {
+		_, err := previousContainerStatus(pod, "sidecar")
+		assert.ErrorContains(t, err, "no previous instance")
+	})
+
+	t.Run("unknown container", func(t *testing.T) {
+		_, err := previousContainerStatus(pod, "missing")
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("no restarted containers", func(t *testing.T) {
+		healthy := &corev1.Pod{Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "app"}},
+		}}
+		_, err := previousContainerStatus(healthy, "")
+		assert.Error(t, err)
+	})
+}
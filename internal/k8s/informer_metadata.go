@@ -0,0 +1,181 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+// Metadata-only informers
+//
+// Secrets (and ConfigMaps) are listed on every screen load but the UI only
+// needs their metadata, type and number of keys. Keeping full payloads in
+// the informer cache for every loaded context costs memory and leaves
+// decoded credentials sitting in the process for its whole lifetime.
+//
+// Informers for resources marked MetadataOnly install projectMetadataOnly as
+// their cache transform: each object is reduced to a PartialObjectMetadata
+// shape (apiVersion, kind, metadata) plus the few scalar fields the list
+// columns need, and the data key count is recorded under dataCountField.
+// Payloads are dropped before the object reaches the store, so listers and
+// indexes never see them. Full objects are fetched from the API server only
+// when the user explicitly asks (YAML view, secret decode, etc.).
+
+// dataCountField stores the projected number of data keys on metadata-only
+// objects (same "__k1" prefix convention as the __gvr_* selection keys).
+const dataCountField = "__k1_dataCount"
+
+// projectedScalarFields are top-level fields kept on metadata-only objects
+// because list transforms need them (e.g. Secret type).
+var projectedScalarFields = []string{"type", "immutable"}
+
+// lastAppliedAnnotation holds the full object as applied by kubectl, which
+// for Secrets includes the payload, so it is dropped from projections.
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// projectMetadataOnly is a cache.TransformFunc that strips payloads from
+// objects before they are stored in the informer cache
+func projectMetadataOnly(obj any) (any, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		// Tombstones and unknown types are passed through untouched
+		return obj, nil
+	}
+
+	// Already projected (transforms can run more than once on relists)
+	if _, found := u.Object[dataCountField]; found {
+		return u, nil
+	}
+
+	projected := map[string]any{
+		"apiVersion":   u.GetAPIVersion(),
+		"kind":         u.GetKind(),
+		dataCountField: int64(countDataKeys(u)),
+	}
+
+	if metadata, found, _ := unstructured.NestedMap(u.Object, "metadata"); found {
+		delete(metadata, "managedFields")
+		if annotations, ok := metadata["annotations"].(map[string]any); ok {
+			delete(annotations, lastAppliedAnnotation)
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+		projected["metadata"] = metadata
+	}
+
+	for _, field := range projectedScalarFields {
+		if value, found := u.Object[field]; found {
+			projected[field] = value
+		}
+	}
+
+	return &unstructured.Unstructured{Object: projected}, nil
+}
+
+// countDataKeys counts the keys of the data map of a full object
+func countDataKeys(u *unstructured.Unstructured) int {
+	data, _, _ := unstructured.NestedMap(u.Object, "data")
+	return len(data)
+}
+
+// dataKeyCount returns the number of data keys, using the projected count
+// for metadata-only objects and counting the data map otherwise
+func dataKeyCount(u *unstructured.Unstructured) int {
+	if count, found, _ := unstructured.NestedInt64(u.Object, dataCountField); found {
+		return int(count)
+	}
+	return countDataKeys(u)
+}
+
+// isMetadataOnly reports whether the informer for gvr caches projections only
+func (r *InformerRepository) isMetadataOnly(gvr schema.GroupVersionResource) bool {
+	for _, cfg := range r.resources {
+		if cfg.GVR == gvr {
+			return cfg.MetadataOnly
+		}
+	}
+	return false
+}
+
+// fetchObject fetches a single full object directly from the API server
+// (used for metadata-only resources whose cache holds no payloads and for
+// informers whose cache has not synced yet)
+func (r *InformerRepository) fetchObject(gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	ctx, cancel := context.WithTimeout(r.ctx, OnDemandFetchTimeout)
+	defer cancel()
+
+	var obj *unstructured.Unstructured
+	var err error
+	if namespace != "" {
+		obj, err = r.dynamicClient.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	} else {
+		obj, err = r.dynamicClient.Resource(gvr).Get(ctx, name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s %s: %w", gvr.Resource, name, err)
+	}
+	return obj, nil
+}
+
+// GetSecretData fetches a Secret from the API server and returns its decoded
+// data (the informer cache only holds a metadata projection)
+func (r *InformerRepository) GetSecretData(namespace, name string) (map[string][]byte, error) {
+	ctx, cancel := context.WithTimeout(r.ctx, OnDemandFetchTimeout)
+	defer cancel()
+
+	secret, err := r.clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch secret %s/%s: %w", namespace, name, err)
+	}
+	return secret.Data, nil
+}
+
+// ConfigMapData is the payload of a ConfigMap, with data and binaryData
+// merged into one map
+type ConfigMapData struct {
+	Values map[string][]byte
+	Binary map[string]bool // Keys of binaryData (patched there, not in data)
+}
+
+// GetConfigMapData fetches a ConfigMap from the API server and returns its
+// payload (the informer cache only holds a metadata projection)
+func (r *InformerRepository) GetConfigMapData(namespace, name string) (*ConfigMapData, error) {
+	ctx, cancel := context.WithTimeout(r.ctx, OnDemandFetchTimeout)
+	defer cancel()
+
+	cm, err := r.clientset.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch configmap %s/%s: %w", namespace, name, err)
+	}
+
+	data := &ConfigMapData{
+		Values: make(map[string][]byte, len(cm.Data)+len(cm.BinaryData)),
+		Binary: make(map[string]bool, len(cm.BinaryData)),
+	}
+	for key, value := range cm.Data {
+		data.Values[key] = []byte(value)
+	}
+	for key, value := range cm.BinaryData {
+		data.Values[key] = value
+		data.Binary[key] = true
+	}
+	return data, nil
+}
+
+// PatchConfigMap applies a JSON merge patch to a ConfigMap through the API
+// (values can be up to 1 MiB, too large for a kubectl argument)
+func (r *InformerRepository) PatchConfigMap(namespace, name string, patch []byte) error {
+	ctx, cancel := context.WithTimeout(r.ctx, OnDemandFetchTimeout)
+	defer cancel()
+
+	_, err := r.clientset.CoreV1().ConfigMaps(namespace).Patch(ctx, name, k8stypes.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to patch configmap %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newTestSecret() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"name":              "db-creds",
+				"namespace":         "default",
+				"creationTimestamp": metav1.NewTime(time.Now()).Format(time.RFC3339),
+				"labels":            map[string]interface{}{"app": "db"},
+				"annotations": map[string]interface{}{
+					lastAppliedAnnotation: `{"data":{"password":"c2VjcmV0"}}`,
+				},
+				"managedFields": []interface{}{map[string]interface{}{"manager": "kubectl"}},
+			},
+			"type": "Opaque",
+			"data": map[string]interface{}{
+				"password": "c2VjcmV0",
+				"username": "YWRtaW4=",
+			},
+		},
+	}
+}
+
+func TestProjectMetadataOnly(t *testing.T) {
+	result, err := projectMetadataOnly(newTestSecret())
+	require.NoError(t, err)
+
+	projected, ok := result.(*unstructured.Unstructured)
+	require.True(t, ok)
+
+	// Payload and payload-bearing metadata are dropped
+	_, hasData := projected.Object["data"]
+	assert.False(t, hasData)
+	assert.Empty(t, projected.GetManagedFields())
+	assert.NotContains(t, projected.GetAnnotations(), lastAppliedAnnotation)
+
+	// Metadata and list columns survive
+	assert.Equal(t, "db-creds", projected.GetName())
+	assert.Equal(t, "default", projected.GetNamespace())
+	assert.Equal(t, "Secret", projected.GetKind())
+	assert.Equal(t, map[string]string{"app": "db"}, projected.GetLabels())
+	assert.Equal(t, 2, dataKeyCount(projected))
+
+	secret, err := transformSecret(projected, extractMetadata(projected))
+	require.NoError(t, err)
+	assert.Equal(t, "Opaque", secret.(Secret).Type)
+	assert.Equal(t, 2, secret.(Secret).Data)
+}
+
+func TestProjectMetadataOnly_Idempotent(t *testing.T) {
+	first, err := projectMetadataOnly(newTestSecret())
+	require.NoError(t, err)
+
+	second, err := projectMetadataOnly(first)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, dataKeyCount(second.(*unstructured.Unstructured)))
+}
+
+func TestProjectMetadataOnly_PassesThroughTombstones(t *testing.T) {
+	tombstone := cache.DeletedFinalStateUnknown{Key: "default/db-creds", Obj: newTestSecret()}
+
+	result, err := projectMetadataOnly(tombstone)
+	require.NoError(t, err)
+	assert.Equal(t, tombstone, result)
+}
+
+func TestDataKeyCount_FullObject(t *testing.T) {
+	assert.Equal(t, 2, dataKeyCount(newTestSecret()))
+	assert.Equal(t, 0, dataKeyCount(&unstructured.Unstructured{Object: map[string]interface{}{}}))
+}
+
+func TestResourceRegistry_MetadataOnly(t *testing.T) {
+	registry := getResourceRegistry()
+	assert.True(t, registry[ResourceTypeSecret].MetadataOnly)
+	assert.True(t, registry[ResourceTypeConfigMap].MetadataOnly)
+	assert.False(t, registry[ResourceTypePod].MetadataOnly)
+}
+
+func TestGetConfigMapData(t *testing.T) {
+	repo := &InformerRepository{
+		ctx: context.Background(),
+		clientset: fake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "web", Name: "app-config"},
+			Data:       map[string]string{"app.yaml": "port: 8080\n"},
+			BinaryData: map[string][]byte{"banner.txt": []byte("hello")},
+		}),
+	}
+
+	data, err := repo.GetConfigMapData("web", "app-config")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"app.yaml": []byte("port: 8080\n"), "banner.txt": []byte("hello")}, data.Values)
+	assert.Equal(t, map[string]bool{"banner.txt": true}, data.Binary)
+
+	_, err = repo.GetConfigMapData("web", "missing")
+	assert.Error(t, err)
+}
+
+func TestPatchConfigMap(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "web", Name: "app-config"},
+		Data:       map[string]string{"app.yaml": "port: 8080\n", "LOG_LEVEL": "info"},
+	})
+	repo := &InformerRepository{ctx: context.Background(), clientset: clientset}
+
+	require.NoError(t, repo.PatchConfigMap("web", "app-config", []byte(`{"data":{"LOG_LEVEL":"debug"}}`)))
+	cm, err := clientset.CoreV1().ConfigMaps("web").Get(context.Background(), "app-config", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app.yaml": "port: 8080\n", "LOG_LEVEL": "debug"}, cm.Data)
+
+	assert.Error(t, repo.PatchConfigMap("web", "missing", []byte(`{"data":{}}`)))
+}
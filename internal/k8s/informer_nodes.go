@@ -0,0 +1,206 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	resourcehelper "k8s.io/kubectl/pkg/util/resource"
+)
+
+// Node allocation
+//
+// "Why won't anything schedule on this node" comes down to the sum of the
+// requests of the pods bound to it versus its allocatable resources (the
+// scheduler never looks at actual usage). Everything needed is cached: the
+// node in its dynamic informer and its pods in the podsByNode index.
+
+// nodeDetailResources are the resources shown in node allocation, in order
+var nodeDetailResources = []corev1.ResourceName{
+	corev1.ResourceCPU,
+	corev1.ResourceMemory,
+	corev1.ResourceEphemeralStorage,
+	corev1.ResourcePods,
+}
+
+// NodeDetail is a node with the allocation of the pods bound to it
+type NodeDetail struct {
+	Name          string
+	Status        string // Ready, NotReady or Unknown
+	Unschedulable bool
+	Conditions    []NodeCondition
+	Taints        []string // key=value:Effect
+	Addresses     []string // Type: address
+	Resources     []NodeResourceAllocation
+	Pods          []NodePodAllocation // Non-terminated pods, sorted by namespace/name
+}
+
+// NodeCondition is a node status condition
+type NodeCondition struct {
+	Type           string
+	Status         string
+	Reason         string
+	Message        string
+	LastTransition time.Time
+}
+
+// NodeResourceAllocation compares capacity, allocatable and the summed
+// requests/limits of the pods on the node for one resource
+type NodeResourceAllocation struct {
+	Name        string // cpu, memory, ephemeral-storage, pods
+	Capacity    resource.Quantity
+	Allocatable resource.Quantity
+	Requests    resource.Quantity
+	Limits      resource.Quantity
+}
+
+// RequestsPercent returns requests as a percentage of allocatable
+func (a NodeResourceAllocation) RequestsPercent() float64 {
+	return percentOf(a.Requests, a.Allocatable)
+}
+
+// LimitsPercent returns limits as a percentage of allocatable (can exceed 100)
+func (a NodeResourceAllocation) LimitsPercent() float64 {
+	return percentOf(a.Limits, a.Allocatable)
+}
+
+// NodePodAllocation is a pod on a node with its effective requests/limits
+type NodePodAllocation struct {
+	Namespace      string
+	Name           string
+	Status         string
+	CPURequests    resource.Quantity
+	CPULimits      resource.Quantity
+	MemoryRequests resource.Quantity
+	MemoryLimits   resource.Quantity
+	Age            time.Duration
+}
+
+// GetNodeDetail returns a node with the allocation of the pods bound to it
+// (uses the node informer cache and the podsByNode index)
+func (r *InformerRepository) GetNodeDetail(name string) (*NodeDetail, error) {
+	nodeGVR := r.resources[ResourceTypeNode].GVR
+	obj, err := r.getCachedObject(nodeGVR, "", name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", name, err)
+	}
+
+	var node corev1.Node
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &node); err != nil {
+		return nil, fmt.Errorf("failed to convert node %s: %w", name, err)
+	}
+
+	r.mu.RLock()
+	pods := make([]*corev1.Pod, len(r.podsByNode[name]))
+	copy(pods, r.podsByNode[name])
+	r.mu.RUnlock()
+
+	return buildNodeDetail(&node, pods, time.Now()), nil
+}
+
+// buildNodeDetail computes the allocation of a node from its pods. Requests
+// and limits use the same effective pod values as kubectl describe node
+// (init containers, sidecars and pod overhead included).
+func buildNodeDetail(node *corev1.Node, pods []*corev1.Pod, now time.Time) *NodeDetail {
+	detail := &NodeDetail{
+		Name:          node.Name,
+		Status:        "Unknown",
+		Unschedulable: node.Spec.Unschedulable,
+	}
+
+	for _, c := range node.Status.Conditions {
+		detail.Conditions = append(detail.Conditions, NodeCondition{
+			Type:           string(c.Type),
+			Status:         string(c.Status),
+			Reason:         c.Reason,
+			Message:        c.Message,
+			LastTransition: c.LastTransitionTime.Time,
+		})
+		if c.Type == corev1.NodeReady {
+			detail.Status = "NotReady"
+			if c.Status == corev1.ConditionTrue {
+				detail.Status = "Ready"
+			}
+		}
+	}
+
+	for _, taint := range node.Spec.Taints {
+		entry := taint.Key
+		if taint.Value != "" {
+			entry += "=" + taint.Value
+		}
+		detail.Taints = append(detail.Taints, entry+":"+string(taint.Effect))
+	}
+
+	for _, address := range node.Status.Addresses {
+		detail.Addresses = append(detail.Addresses, string(address.Type)+": "+address.Address)
+	}
+
+	requests := corev1.ResourceList{}
+	limits := corev1.ResourceList{}
+	podCount := 0
+	for _, pod := range pods {
+		// Terminated pods no longer hold resources
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		podCount++
+
+		podRequests, podLimits := resourcehelper.PodRequestsAndLimits(pod)
+		addResources(requests, podRequests)
+		addResources(limits, podLimits)
+
+		detail.Pods = append(detail.Pods, NodePodAllocation{
+			Namespace:      pod.Namespace,
+			Name:           pod.Name,
+			Status:         string(pod.Status.Phase),
+			CPURequests:    podRequests[corev1.ResourceCPU],
+			CPULimits:      podLimits[corev1.ResourceCPU],
+			MemoryRequests: podRequests[corev1.ResourceMemory],
+			MemoryLimits:   podLimits[corev1.ResourceMemory],
+			Age:            now.Sub(pod.CreationTimestamp.Time),
+		})
+	}
+	requests[corev1.ResourcePods] = *resource.NewQuantity(int64(podCount), resource.DecimalSI)
+
+	for _, name := range nodeDetailResources {
+		detail.Resources = append(detail.Resources, NodeResourceAllocation{
+			Name:        string(name),
+			Capacity:    node.Status.Capacity[name],
+			Allocatable: node.Status.Allocatable[name],
+			Requests:    requests[name],
+			Limits:      limits[name],
+		})
+	}
+
+	sort.Slice(detail.Pods, func(i, j int) bool {
+		if detail.Pods[i].Namespace != detail.Pods[j].Namespace {
+			return detail.Pods[i].Namespace < detail.Pods[j].Namespace
+		}
+		return detail.Pods[i].Name < detail.Pods[j].Name
+	})
+	return detail
+}
+
+// addResources adds every quantity of add to list
+func addResources(list, add corev1.ResourceList) {
+	for name, quantity := range add {
+		if current, ok := list[name]; ok {
+			current.Add(quantity)
+			list[name] = current
+		} else {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+// percentOf returns part as a percentage of whole (0 when whole is zero)
+func percentOf(part, whole resource.Quantity) float64 {
+	if whole.IsZero() {
+		return 0
+	}
+	return float64(part.MilliValue()) / float64(whole.MilliValue()) * 100
+}
@@ -0,0 +1,95 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newNodeDetailPod(namespace, name string, phase corev1.PodPhase, cpuRequest, memoryLimit string) *corev1.Pod {
+	container := corev1.Container{Name: "app"}
+	if cpuRequest != "" {
+		container.Resources.Requests = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpuRequest)}
+	}
+	if memoryLimit != "" {
+		container.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(memoryLimit)}
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{container}},
+		Status:     corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestBuildNodeDetail(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Spec: corev1.NodeSpec{
+			Unschedulable: true,
+			Taints: []corev1.Taint{
+				{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "node.kubernetes.io/unschedulable", Effect: corev1.TaintEffectNoSchedule},
+			},
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse},
+				{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Reason: "KubeletNotReady"},
+			},
+			Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeInternalIP, Address: "10.0.1.10"},
+			},
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+				corev1.ResourcePods:   resource.MustParse("110"),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+				corev1.ResourcePods:   resource.MustParse("110"),
+			},
+		},
+	}
+	pods := []*corev1.Pod{
+		newNodeDetailPod("web", "nginx", corev1.PodRunning, "500m", "1Gi"),
+		newNodeDetailPod("default", "worker", corev1.PodPending, "1", "3Gi"),
+		newNodeDetailPod("default", "migrate", corev1.PodSucceeded, "1", "1Gi"), // Terminated, not counted
+	}
+
+	detail := buildNodeDetail(node, pods, time.Now())
+
+	assert.Equal(t, "node-1", detail.Name)
+	assert.Equal(t, "NotReady", detail.Status)
+	assert.True(t, detail.Unschedulable)
+	assert.Equal(t, []string{"dedicated=gpu:NoSchedule", "node.kubernetes.io/unschedulable:NoSchedule"}, detail.Taints)
+	assert.Equal(t, []string{"InternalIP: 10.0.1.10"}, detail.Addresses)
+	assert.Len(t, detail.Conditions, 2)
+
+	require.Len(t, detail.Pods, 2)
+	assert.Equal(t, "default", detail.Pods[0].Namespace)
+	assert.Equal(t, "worker", detail.Pods[0].Name)
+	assert.Equal(t, "nginx", detail.Pods[1].Name)
+
+	require.Len(t, detail.Resources, 4)
+	cpu := detail.Resources[0]
+	assert.Equal(t, "cpu", cpu.Name)
+	assert.Equal(t, "1500m", cpu.Requests.String())
+	assert.InDelta(t, 75, cpu.RequestsPercent(), 0.01)
+
+	memory := detail.Resources[1]
+	assert.Equal(t, "4Gi", memory.Limits.String())
+	assert.InDelta(t, 100, memory.LimitsPercent(), 0.01)
+
+	// No allocatable ephemeral storage reported
+	assert.Zero(t, detail.Resources[2].RequestsPercent())
+
+	podCount := detail.Resources[3]
+	assert.Equal(t, "pods", podCount.Name)
+	assert.Equal(t, int64(2), podCount.Requests.Value())
+}
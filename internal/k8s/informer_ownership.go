@@ -0,0 +1,505 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/renato0307/k1/internal/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Ownership tree
+//
+// podsByOwnerUID, replicaSetsByOwnerUID and jobsByOwnerUID answer "which
+// pods/replicasets/jobs does X own" for the built-in controller chains. The
+// ownership tree generalises them: every dynamic informer (built-ins loaded
+// at startup and CR informers loaded on demand) feeds objectsByOwnerUID, so
+// descendants of any object can be found through ownerReferences whatever
+// their kind, e.g. Certificate → CertificateRequest → Order.
+
+// maxOwnershipDepth bounds tree walks (ownerReference cycles are invalid but
+// not rejected by the API server)
+const maxOwnershipDepth = 10
+
+// ownedObject identifies an object that has an ownerReference
+type ownedObject struct {
+	GVR       schema.GroupVersionResource
+	Namespace string
+	Name      string
+}
+
+// OwnershipNode is an object in an ownership tree with its descendants
+type OwnershipNode struct {
+	GVR       schema.GroupVersionResource
+	Kind      string
+	Namespace string
+	Name      string
+	Status    string // Short status summary (e.g. "Running 1/1", "Ready 3/3")
+	CreatedAt time.Time
+	Children  []*OwnershipNode
+}
+
+// ResourceRef identifies an object together with its resource type
+type ResourceRef struct {
+	GVR       schema.GroupVersionResource
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// kindResource is the discovered resource for an owner kind
+type kindResource struct {
+	gvr        schema.GroupVersionResource
+	namespaced bool
+}
+
+// trackOwnership registers ownership index maintenance on a dynamic informer
+// (idempotent - on-demand CR informers may be ensured many times)
+func (r *InformerRepository) trackOwnership(gvr schema.GroupVersionResource, informer cache.SharedIndexInformer) {
+	r.mu.Lock()
+	if r.ownershipTracked[gvr] {
+		r.mu.Unlock()
+		return
+	}
+	r.ownershipTracked[gvr] = true
+	r.mu.Unlock()
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if unstr, ok := obj.(*unstructured.Unstructured); ok {
+				r.updateOwnershipIndex(gvr, unstr, nil)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// Owner references can change (adoption, orphaning)
+			oldUnstr, _ := oldObj.(*unstructured.Unstructured)
+			if newUnstr, ok := newObj.(*unstructured.Unstructured); ok {
+				r.updateOwnershipIndex(gvr, newUnstr, oldUnstr)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			// Handle DeletedFinalStateUnknown wrapper
+			unstr, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					return
+				}
+				unstr, ok = tombstone.Obj.(*unstructured.Unstructured)
+				if !ok {
+					return
+				}
+			}
+			r.removeFromOwnershipIndex(unstr)
+		},
+	})
+	if err != nil {
+		logging.Warn("Failed to track ownership", "resource", gvr.Resource, "error", err)
+	}
+}
+
+// updateOwnershipIndex indexes an object under each of its owners
+func (r *InformerRepository) updateOwnershipIndex(gvr schema.GroupVersionResource, newObj, oldObj *unstructured.Unstructured) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if oldObj != nil {
+		r.removeFromOwnershipIndexLocked(oldObj)
+	}
+
+	uid := string(newObj.GetUID())
+	for _, ownerRef := range newObj.GetOwnerReferences() {
+		ownerUID := string(ownerRef.UID)
+		if r.objectsByOwnerUID[ownerUID] == nil {
+			r.objectsByOwnerUID[ownerUID] = make(map[string]ownedObject)
+		}
+		r.objectsByOwnerUID[ownerUID][uid] = ownedObject{
+			GVR:       gvr,
+			Namespace: newObj.GetNamespace(),
+			Name:      newObj.GetName(),
+		}
+	}
+}
+
+// removeFromOwnershipIndex removes an object from the ownership index (acquires lock)
+func (r *InformerRepository) removeFromOwnershipIndex(obj *unstructured.Unstructured) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.removeFromOwnershipIndexLocked(obj)
+}
+
+// removeFromOwnershipIndexLocked removes an object from the ownership index (assumes lock held)
+func (r *InformerRepository) removeFromOwnershipIndexLocked(obj *unstructured.Unstructured) {
+	uid := string(obj.GetUID())
+	for _, ownerRef := range obj.GetOwnerReferences() {
+		ownerUID := string(ownerRef.UID)
+		delete(r.objectsByOwnerUID[ownerUID], uid)
+		if len(r.objectsByOwnerUID[ownerUID]) == 0 {
+			delete(r.objectsByOwnerUID, ownerUID)
+		}
+	}
+}
+
+// GetOwnershipTree returns the object and every descendant found through
+// ownerReferences (children sorted by kind, then name). Descendants are only
+// found for kinds with a loaded informer; CR kinds from the same API group
+// family as any CR in the tree are loaded in the background so they show up
+// on the next refresh.
+func (r *InformerRepository) GetOwnershipTree(gvr schema.GroupVersionResource, namespace, name string) (*OwnershipNode, error) {
+	root, err := r.getObject(gvr, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]bool)
+	visited := make(map[string]bool)
+	node := r.buildOwnershipNode(gvr, root, 0, visited, groups)
+
+	for group := range groups {
+		r.ensureRelatedCRInformers(group)
+	}
+	return node, nil
+}
+
+// buildOwnershipNode converts an object to a tree node and recurses into the
+// objects it owns
+func (r *InformerRepository) buildOwnershipNode(
+	gvr schema.GroupVersionResource,
+	obj *unstructured.Unstructured,
+	depth int,
+	visited map[string]bool,
+	groups map[string]bool,
+) *OwnershipNode {
+	uid := string(obj.GetUID())
+	visited[uid] = true
+	if isCustomResourceGroup(gvr.Group) {
+		groups[gvr.Group] = true
+	}
+
+	node := &OwnershipNode{
+		GVR:       gvr,
+		Kind:      obj.GetKind(),
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Status:    SummarizeObjectStatus(obj),
+		CreatedAt: obj.GetCreationTimestamp().Time,
+	}
+	if depth >= maxOwnershipDepth {
+		return node
+	}
+
+	r.mu.RLock()
+	owned := make(map[string]ownedObject, len(r.objectsByOwnerUID[uid]))
+	for childUID, child := range r.objectsByOwnerUID[uid] {
+		owned[childUID] = child
+	}
+	r.mu.RUnlock()
+
+	for childUID, child := range owned {
+		if visited[childUID] {
+			continue
+		}
+		childObj, err := r.getCachedObject(child.GVR, child.Namespace, child.Name)
+		if err != nil {
+			// Deleted between index lookup and get - skip
+			continue
+		}
+		node.Children = append(node.Children, r.buildOwnershipNode(child.GVR, childObj, depth+1, visited, groups))
+	}
+
+	sort.Slice(node.Children, func(i, j int) bool {
+		if node.Children[i].Kind != node.Children[j].Kind {
+			return node.Children[i].Kind < node.Children[j].Kind
+		}
+		return node.Children[i].Name < node.Children[j].Name
+	})
+	return node
+}
+
+// GetTopLevelOwner follows controller ownerReferences up from an object and
+// returns the top-level owner (e.g. Pod → ReplicaSet → Deployment → Rollout).
+// Owners that no longer exist end the walk at the last existing object.
+func (r *InformerRepository) GetTopLevelOwner(gvr schema.GroupVersionResource, namespace, name string) (ResourceRef, error) {
+	obj, err := r.getObject(gvr, namespace, name)
+	if err != nil {
+		return ResourceRef{}, err
+	}
+
+	var owner ResourceRef
+	for depth := 0; depth < maxOwnershipDepth; depth++ {
+		ref := controllerOf(obj)
+		if ref == nil {
+			break
+		}
+
+		resource, err := r.resolveKind(ref.APIVersion, ref.Kind)
+		if err != nil {
+			if depth == 0 {
+				return ResourceRef{}, err
+			}
+			break
+		}
+		ownerNamespace := ""
+		if resource.namespaced {
+			ownerNamespace = obj.GetNamespace()
+		}
+
+		ownerObj, err := r.getObject(resource.gvr, ownerNamespace, ref.Name)
+		if err != nil {
+			if depth == 0 {
+				return ResourceRef{}, fmt.Errorf("owner %s/%s not found: %w", ref.Kind, ref.Name, err)
+			}
+			break
+		}
+
+		obj = ownerObj
+		owner = ResourceRef{
+			GVR:       resource.gvr,
+			Kind:      ref.Kind,
+			Namespace: ownerNamespace,
+			Name:      ref.Name,
+		}
+	}
+
+	if owner.Name == "" {
+		return ResourceRef{}, fmt.Errorf("%s %s has no owner", gvr.Resource, name)
+	}
+	return owner, nil
+}
+
+// controllerOf returns the controller ownerReference of an object (nil if none)
+func controllerOf(obj *unstructured.Unstructured) *metav1.OwnerReference {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Controller != nil && *ref.Controller {
+			return &ref
+		}
+	}
+	return nil
+}
+
+// resolveKind maps an ownerReference apiVersion/kind to its resource using
+// API discovery (cached, kinds do not change resource during a session)
+func (r *InformerRepository) resolveKind(apiVersion, kind string) (kindResource, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return kindResource{}, fmt.Errorf("invalid apiVersion %q: %w", apiVersion, err)
+	}
+	gvk := gv.WithKind(kind)
+
+	r.mu.RLock()
+	resource, ok := r.kindResources[gvk]
+	r.mu.RUnlock()
+	if ok {
+		return resource, nil
+	}
+
+	resources, err := r.clientset.Discovery().ServerResourcesForGroupVersion(apiVersion)
+	if err != nil {
+		return kindResource{}, fmt.Errorf("failed to discover %s: %w", apiVersion, err)
+	}
+	for _, apiResource := range resources.APIResources {
+		// Skip subresources (e.g. deployments/scale)
+		if apiResource.Kind != kind || strings.Contains(apiResource.Name, "/") {
+			continue
+		}
+		resource = kindResource{
+			gvr:        gv.WithResource(apiResource.Name),
+			namespaced: apiResource.Namespaced,
+		}
+		r.mu.Lock()
+		r.kindResources[gvk] = resource
+		r.mu.Unlock()
+		return resource, nil
+	}
+	return kindResource{}, fmt.Errorf("unknown kind %s in %s", kind, apiVersion)
+}
+
+// getObject returns an object from the informer cache, falling back to the
+// API server when its kind has no synced informer
+func (r *InformerRepository) getObject(gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	if obj, err := r.getCachedObject(gvr, namespace, name); err == nil {
+		return obj, nil
+	}
+	return r.fetchObject(gvr, namespace, name)
+}
+
+// getCachedObject returns an object from the dynamic informer cache
+func (r *InformerRepository) getCachedObject(gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	r.mu.RLock()
+	lister, ok := r.dynamicListers[gvr]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("informer not registered for %v", gvr)
+	}
+
+	var err error
+	var obj interface{}
+	if namespace != "" {
+		obj, err = lister.ByNamespace(namespace).Get(name)
+	} else {
+		obj, err = lister.Get(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	unstr, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type: %T", obj)
+	}
+	return unstr, nil
+}
+
+// ensureRelatedCRInformers starts informers for CRDs in the same API group
+// family as group (operators usually create their child CRs in the group or
+// a subdomain of it, e.g. cert-manager.io and acme.cert-manager.io)
+func (r *InformerRepository) ensureRelatedCRInformers(group string) {
+	crdGVR := r.resources[ResourceTypeCRD].GVR
+	if !r.IsInformerSynced(crdGVR) {
+		// Start loading CRDs; related kinds are picked up on the next refresh
+		if err := r.EnsureResourceTypeInformer(ResourceTypeCRD); err != nil {
+			logging.Warn("Failed to load CRDs for ownership tree", "error", err)
+		}
+		return
+	}
+
+	crds, err := r.GetResources(ResourceTypeCRD)
+	if err != nil {
+		return
+	}
+	for _, item := range crds {
+		crd, ok := item.(CustomResourceDefinition)
+		if !ok || !sameAPIGroupFamily(crd.Group, group) {
+			continue
+		}
+		gvr := schema.GroupVersionResource{Group: crd.Group, Version: crd.Version, Resource: crd.Plural}
+		if err := r.EnsureCRInformer(gvr); err != nil {
+			logging.Warn("Failed to load related CR informer", "resource", gvr.Resource, "error", err)
+		}
+	}
+}
+
+// isCustomResourceGroup reports whether an API group belongs to a CRD
+// (built-in groups have no dots or live under k8s.io)
+func isCustomResourceGroup(group string) bool {
+	return strings.Contains(group, ".") && !strings.HasSuffix(group, ".k8s.io")
+}
+
+// sameAPIGroupFamily reports whether one group equals or is a subdomain of the other
+func sameAPIGroupFamily(a, b string) bool {
+	return a == b || strings.HasSuffix(a, "."+b) || strings.HasSuffix(b, "."+a)
+}
+
+// SummarizeObjectStatus returns a short status for any object: replica
+// counts for workloads, phase and readiness for pods, completion for jobs
+// and the Ready condition (or phase/state) for everything else
+func SummarizeObjectStatus(u *unstructured.Unstructured) string {
+	switch u.GetKind() {
+	case "Pod":
+		return summarizePodStatus(u)
+	case "Deployment", "ReplicaSet", "StatefulSet":
+		ready, _, _ := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+		desired, found, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+		if !found {
+			desired = 1
+		}
+		return fmt.Sprintf("Ready %d/%d", ready, desired)
+	case "DaemonSet":
+		ready, _, _ := unstructured.NestedInt64(u.Object, "status", "numberReady")
+		desired, _, _ := unstructured.NestedInt64(u.Object, "status", "desiredNumberScheduled")
+		return fmt.Sprintf("Ready %d/%d", ready, desired)
+	case "Job":
+		if status := conditionStatus(u, "Failed"); status == "True" {
+			return "Failed"
+		}
+		if status := conditionStatus(u, "Complete"); status == "True" {
+			return "Complete"
+		}
+		succeeded, _, _ := unstructured.NestedInt64(u.Object, "status", "succeeded")
+		completions, found, _ := unstructured.NestedInt64(u.Object, "spec", "completions")
+		if !found {
+			completions = 1
+		}
+		return fmt.Sprintf("Running %d/%d", succeeded, completions)
+	case "CronJob":
+		if suspend, _, _ := unstructured.NestedBool(u.Object, "spec", "suspend"); suspend {
+			return "Suspended"
+		}
+		active, _, _ := unstructured.NestedSlice(u.Object, "status", "active")
+		return fmt.Sprintf("Active %d", len(active))
+	}
+
+	switch conditionStatus(u, "Ready") {
+	case "True":
+		return "Ready"
+	case "False", "Unknown":
+		if reason := conditionReason(u, "Ready"); reason != "" {
+			return "NotReady: " + reason
+		}
+		return "NotReady"
+	}
+	if phase, _, _ := unstructured.NestedString(u.Object, "status", "phase"); phase != "" {
+		return phase
+	}
+	if state, _, _ := unstructured.NestedString(u.Object, "status", "state"); state != "" {
+		return state
+	}
+	return ""
+}
+
+// summarizePodStatus returns the pod phase (or the waiting reason of a
+// container, e.g. CrashLoopBackOff) with the ready container count
+func summarizePodStatus(u *unstructured.Unstructured) string {
+	status, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+	containerStatuses, _, _ := unstructured.NestedSlice(u.Object, "status", "containerStatuses")
+
+	ready := 0
+	for _, cs := range containerStatuses {
+		csMap, ok := cs.(map[string]any)
+		if !ok {
+			continue
+		}
+		if isReady, _, _ := unstructured.NestedBool(csMap, "ready"); isReady {
+			ready++
+		}
+		if reason, _, _ := unstructured.NestedString(csMap, "state", "waiting", "reason"); reason != "" {
+			status = reason
+		}
+	}
+	return fmt.Sprintf("%s %d/%d", status, ready, len(containerStatuses))
+}
+
+// conditionStatus returns the status of a condition ("" if absent)
+func conditionStatus(u *unstructured.Unstructured, conditionType string) string {
+	status, _ := findCondition(u, conditionType)
+	return status
+}
+
+// conditionReason returns the reason of a condition ("" if absent)
+func conditionReason(u *unstructured.Unstructured, conditionType string) string {
+	_, reason := findCondition(u, conditionType)
+	return reason
+}
+
+// findCondition returns status and reason of a status.conditions entry
+func findCondition(u *unstructured.Unstructured, conditionType string) (string, string) {
+	conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, c := range conditions {
+		cMap, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		if t, _, _ := unstructured.NestedString(cMap, "type"); t != conditionType {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(cMap, "status")
+		reason, _, _ := unstructured.NestedString(cMap, "reason")
+		return status, reason
+	}
+	return "", ""
+}
@@ -0,0 +1,193 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newOwnedObject(kind, name, uid string, ownerUIDs ...string) *unstructured.Unstructured {
+	ownerRefs := make([]interface{}, 0, len(ownerUIDs))
+	for _, ownerUID := range ownerUIDs {
+		ownerRefs = append(ownerRefs, map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Owner",
+			"name":       "owner-" + ownerUID,
+			"uid":        ownerUID,
+		})
+	}
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       kind,
+			"metadata": map[string]interface{}{
+				"name":            name,
+				"namespace":       "default",
+				"uid":             uid,
+				"ownerReferences": ownerRefs,
+			},
+		},
+	}
+}
+
+func TestOwnershipIndex(t *testing.T) {
+	repo := &InformerRepository{objectsByOwnerUID: make(map[string]map[string]ownedObject)}
+	gvr := schema.GroupVersionResource{Group: "acme.cert-manager.io", Version: "v1", Resource: "orders"}
+
+	order := newOwnedObject("Order", "web-tls-1", "order-uid", "request-uid")
+	repo.updateOwnershipIndex(gvr, order, nil)
+	assert.Equal(t, ownedObject{GVR: gvr, Namespace: "default", Name: "web-tls-1"},
+		repo.objectsByOwnerUID["request-uid"]["order-uid"])
+
+	// Re-parenting moves the object to its new owner
+	adopted := newOwnedObject("Order", "web-tls-1", "order-uid", "other-uid")
+	repo.updateOwnershipIndex(gvr, adopted, order)
+	assert.NotContains(t, repo.objectsByOwnerUID, "request-uid")
+	assert.Contains(t, repo.objectsByOwnerUID["other-uid"], "order-uid")
+
+	repo.removeFromOwnershipIndex(adopted)
+	assert.Empty(t, repo.objectsByOwnerUID)
+}
+
+func TestSameAPIGroupFamily(t *testing.T) {
+	assert.True(t, sameAPIGroupFamily("cert-manager.io", "cert-manager.io"))
+	assert.True(t, sameAPIGroupFamily("acme.cert-manager.io", "cert-manager.io"))
+	assert.True(t, sameAPIGroupFamily("cert-manager.io", "acme.cert-manager.io"))
+	assert.False(t, sameAPIGroupFamily("manager.io", "cert-manager.io"))
+
+	assert.True(t, isCustomResourceGroup("cert-manager.io"))
+	assert.False(t, isCustomResourceGroup("apps"))
+	assert.False(t, isCustomResourceGroup("networking.k8s.io"))
+}
+
+func TestSummarizeObjectStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		spec     map[string]interface{}
+		status   map[string]interface{}
+		expected string
+	}{
+		{
+			name: "running pod",
+			kind: "Pod",
+			status: map[string]interface{}{
+				"phase": "Running",
+				"containerStatuses": []interface{}{
+					map[string]interface{}{"ready": true},
+					map[string]interface{}{"ready": false},
+				},
+			},
+			expected: "Running 1/2",
+		},
+		{
+			name: "crashing pod shows waiting reason",
+			kind: "Pod",
+			status: map[string]interface{}{
+				"phase": "Running",
+				"containerStatuses": []interface{}{
+					map[string]interface{}{"ready": false, "state": map[string]interface{}{
+						"waiting": map[string]interface{}{"reason": "CrashLoopBackOff"},
+					}},
+				},
+			},
+			expected: "CrashLoopBackOff 0/1",
+		},
+		{
+			name:     "deployment",
+			kind:     "Deployment",
+			spec:     map[string]interface{}{"replicas": int64(3)},
+			status:   map[string]interface{}{"readyReplicas": int64(2)},
+			expected: "Ready 2/3",
+		},
+		{
+			name:     "daemonset",
+			kind:     "DaemonSet",
+			status:   map[string]interface{}{"numberReady": int64(4), "desiredNumberScheduled": int64(5)},
+			expected: "Ready 4/5",
+		},
+		{
+			name: "completed job",
+			kind: "Job",
+			status: map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Complete", "status": "True"},
+			}},
+			expected: "Complete",
+		},
+		{
+			name:     "running job",
+			kind:     "Job",
+			spec:     map[string]interface{}{"completions": int64(3)},
+			status:   map[string]interface{}{"succeeded": int64(1)},
+			expected: "Running 1/3",
+		},
+		{
+			name:     "suspended cronjob",
+			kind:     "CronJob",
+			spec:     map[string]interface{}{"suspend": true},
+			expected: "Suspended",
+		},
+		{
+			name: "ready custom resource",
+			kind: "Certificate",
+			status: map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			}},
+			expected: "Ready",
+		},
+		{
+			name: "not ready custom resource",
+			kind: "Certificate",
+			status: map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "False", "reason": "Issuing"},
+			}},
+			expected: "NotReady: Issuing",
+		},
+		{
+			name:     "custom resource state",
+			kind:     "Order",
+			status:   map[string]interface{}{"state": "pending"},
+			expected: "pending",
+		},
+		{
+			name:     "no status",
+			kind:     "ConfigMap",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &unstructured.Unstructured{Object: map[string]interface{}{"kind": tt.kind}}
+			if tt.spec != nil {
+				u.Object["spec"] = tt.spec
+			}
+			if tt.status != nil {
+				u.Object["status"] = tt.status
+			}
+			assert.Equal(t, tt.expected, SummarizeObjectStatus(u))
+		})
+	}
+}
+
+func TestControllerOf(t *testing.T) {
+	isController := true
+	pod := newOwnedObject("Pod", "nginx-abc123-xyz789", "pod-uid")
+	pod.SetOwnerReferences([]metav1.OwnerReference{
+		{APIVersion: "v1", Kind: "ConfigMap", Name: "adopter", UID: "cm-uid"},
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "nginx-abc123", UID: "rs-uid", Controller: &isController},
+	})
+
+	ref := controllerOf(pod)
+	if assert.NotNil(t, ref) {
+		assert.Equal(t, "ReplicaSet", ref.Kind)
+		assert.Equal(t, "nginx-abc123", ref.Name)
+	}
+
+	// Non-controller owners are not followed
+	orphan := newOwnedObject("Pod", "debug", "debug-uid", "cm-uid")
+	assert.Nil(t, controllerOf(orphan))
+}
@@ -0,0 +1,447 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Cluster pulse
+//
+// The pulse is an at-a-glance health summary of the active context: one tile
+// per check (pods by phase and error reason, not-ready nodes, workloads with
+// missing replicas, failed jobs, unbound PVCs, HPAs at max replicas and the
+// Warning event rate). Tiles are computed from the informer caches on every
+// call and keep the keys of the matching objects, so list screens can drill
+// down into exactly the objects a tile counts. Warning events are not cached
+// by informers; they are listed from the API server at most once per
+// pulseEventsTTL.
+
+// PulseSeverity is the health of a pulse tile
+type PulseSeverity int
+
+const (
+	PulseOK PulseSeverity = iota
+	PulseWarning
+	PulseCritical
+)
+
+// String returns the severity label
+func (s PulseSeverity) String() string {
+	switch s {
+	case PulseCritical:
+		return "CRITICAL"
+	case PulseWarning:
+		return "WARNING"
+	default:
+		return "OK"
+	}
+}
+
+// PulseTile is one health check of the cluster pulse
+type PulseTile struct {
+	ID           string // Stable identifier (drill-down filter value)
+	Group        string // Pods, Nodes, Workloads, Jobs, Storage, Autoscaling, Events
+	Title        string
+	Count        int
+	Severity     PulseSeverity
+	Loaded       bool         // False when the informer behind the tile is not loaded yet
+	ResourceType ResourceType // List screen to drill down into
+	Keys         []string     // namespace/name of the matching objects
+}
+
+// ClusterPulse is the health summary of the active context
+type ClusterPulse struct {
+	Tiles         []PulseTile
+	WarningEvents []PulseEvent // Warning events seen within PulseEventWindow, newest first
+}
+
+// PulseEvent is a Warning event counted by the pulse
+type PulseEvent struct {
+	Namespace string
+	Kind      string // Kind of the involved object
+	Name      string // Name of the involved object
+	Reason    string
+	Message   string
+	Count     int32
+	LastSeen  time.Time
+}
+
+// Tile returns the tile with the given ID
+func (p *ClusterPulse) Tile(id string) (PulseTile, bool) {
+	for _, tile := range p.Tiles {
+		if tile.ID == id {
+			return tile, true
+		}
+	}
+	return PulseTile{}, false
+}
+
+const (
+	// PulseEventWindow is the window of the Warning event rate
+	PulseEventWindow = 10 * time.Minute
+
+	// pulseEventsTTL limits how often Warning events are listed (the pulse
+	// screen refreshes more often than that)
+	pulseEventsTTL = 30 * time.Second
+
+	// pulseEventsPageSize is the number of Warning events listed per request
+	pulseEventsPageSize = 500
+
+	// pulseEventsMax caps the number of Warning events listed per fetch
+	pulseEventsMax = 10000
+)
+
+// PulseTileWarningEvents is the ID of the Warning event rate tile (it drills
+// down into the events themselves rather than a list screen)
+const PulseTileWarningEvents = "events-warning"
+
+// pulseInputs are the cached objects the pulse is computed from (nil slices
+// for resource types whose informers are not loaded)
+type pulseInputs struct {
+	pods          []*corev1.Pod
+	nodes         []*corev1.Node
+	deployments   []*appsv1.Deployment
+	statefulSets  []*appsv1.StatefulSet
+	daemonSets    []*appsv1.DaemonSet
+	jobs          []*batchv1.Job
+	pvcs          []*corev1.PersistentVolumeClaim
+	hpas          []*autoscalingv2.HorizontalPodAutoscaler
+	events        []corev1.Event
+	loaded        map[ResourceType]bool
+	eventsFetched bool
+}
+
+// GetClusterPulse computes the cluster pulse from the informer caches
+func (r *InformerRepository) GetClusterPulse() (*ClusterPulse, error) {
+	in := pulseInputs{loaded: make(map[ResourceType]bool)}
+
+	if r.typedInformersReady.Load() {
+		var err error
+		if in.pods, err = r.podLister.List(labels.Everything()); err != nil {
+			return nil, fmt.Errorf("failed to list pods: %w", err)
+		}
+		if in.deployments, err = r.deploymentLister.List(labels.Everything()); err != nil {
+			return nil, fmt.Errorf("failed to list deployments: %w", err)
+		}
+		if in.statefulSets, err = r.statefulSetLister.List(labels.Everything()); err != nil {
+			return nil, fmt.Errorf("failed to list statefulsets: %w", err)
+		}
+		if in.daemonSets, err = r.daemonSetLister.List(labels.Everything()); err != nil {
+			return nil, fmt.Errorf("failed to list daemonsets: %w", err)
+		}
+		in.loaded[ResourceTypePod] = true
+		in.loaded[ResourceTypeDeployment] = true
+		in.loaded[ResourceTypeStatefulSet] = true
+		in.loaded[ResourceTypeDaemonSet] = true
+	}
+
+	in.nodes, in.loaded[ResourceTypeNode] = listCachedAs[corev1.Node](r, ResourceTypeNode)
+	in.jobs, in.loaded[ResourceTypeJob] = listCachedAs[batchv1.Job](r, ResourceTypeJob)
+	in.pvcs, in.loaded[ResourceTypePersistentVolumeClaim] = listCachedAs[corev1.PersistentVolumeClaim](r, ResourceTypePersistentVolumeClaim)
+	in.hpas, in.loaded[ResourceTypeHPA] = listCachedAs[autoscalingv2.HorizontalPodAutoscaler](r, ResourceTypeHPA)
+
+	in.events, in.eventsFetched = r.recentWarningEvents()
+
+	return buildClusterPulse(in, time.Now()), nil
+}
+
+// listCachedAs lists a resource type from its dynamic informer cache as typed
+// objects (false when the informer is not loaded)
+func listCachedAs[T any](r *InformerRepository, resourceType ResourceType) ([]*T, bool) {
+	config, ok := r.resources[resourceType]
+	if !ok {
+		return nil, false
+	}
+	r.mu.RLock()
+	lister, ok := r.dynamicListers[config.GVR]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	objs, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, false
+	}
+	items := make([]*T, 0, len(objs))
+	for _, obj := range objs {
+		unstr, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		item := new(T)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstr.Object, item); err != nil {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, true
+}
+
+// recentWarningEvents returns Warning events of all namespaces, listed from
+// the API server at most once per pulseEventsTTL (false if never fetched)
+func (r *InformerRepository) recentWarningEvents() ([]corev1.Event, bool) {
+	r.pulseEventsMu.Lock()
+	defer r.pulseEventsMu.Unlock()
+
+	if time.Since(r.pulseEventsFetched) < pulseEventsTTL {
+		return r.pulseEvents, true
+	}
+
+	ctx, cancel := context.WithTimeout(r.ctx, OnDemandFetchTimeout)
+	defer cancel()
+	events, err := listWarningEvents(ctx, r.clientset)
+	if err != nil {
+		// Keep showing the last fetch (if any) rather than blanking the tile
+		return r.pulseEvents, !r.pulseEventsFetched.IsZero()
+	}
+
+	r.pulseEvents = events
+	r.pulseEventsFetched = time.Now()
+	return r.pulseEvents, true
+}
+
+// listWarningEvents lists the Warning events of all namespaces page by page
+// (at most pulseEventsMax events, within the deadline of ctx)
+func listWarningEvents(ctx context.Context, clientset kubernetes.Interface) ([]corev1.Event, error) {
+	opts := metav1.ListOptions{
+		FieldSelector: "type=" + corev1.EventTypeWarning,
+		Limit:         pulseEventsPageSize,
+	}
+	var events []corev1.Event
+	for {
+		list, err := clientset.CoreV1().Events("").List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, list.Items...)
+		if list.Continue == "" || len(events) >= pulseEventsMax {
+			return events, nil
+		}
+		opts.Continue = list.Continue
+	}
+}
+
+// buildClusterPulse computes the pulse tiles from cached objects
+func buildClusterPulse(in pulseInputs, now time.Time) *ClusterPulse {
+	pulse := &ClusterPulse{}
+	add := func(tile PulseTile, severity PulseSeverity) {
+		tile.Loaded = in.loaded[tile.ResourceType]
+		tile.Count = len(tile.Keys)
+		if tile.Count > 0 {
+			tile.Severity = severity
+		}
+		pulse.Tiles = append(pulse.Tiles, tile)
+	}
+
+	// Pods by phase, then by error reason (only reasons that occur)
+	phases := []struct {
+		phase    corev1.PodPhase
+		severity PulseSeverity
+	}{
+		{corev1.PodRunning, PulseOK},
+		{corev1.PodPending, PulseWarning},
+		{corev1.PodFailed, PulseCritical},
+		{corev1.PodUnknown, PulseCritical},
+	}
+	for _, p := range phases {
+		tile := PulseTile{ID: "pods-phase-" + string(p.phase), Group: "Pods", Title: string(p.phase), ResourceType: ResourceTypePod}
+		for _, pod := range in.pods {
+			if pod.Status.Phase == p.phase {
+				tile.Keys = append(tile.Keys, pod.Namespace+"/"+pod.Name)
+			}
+		}
+		add(tile, p.severity)
+	}
+
+	podsByReason := make(map[string][]string)
+	for _, pod := range in.pods {
+		for _, reason := range podErrorReasons(pod) {
+			podsByReason[reason] = append(podsByReason[reason], pod.Namespace+"/"+pod.Name)
+		}
+	}
+	for _, reason := range sortedKeys(podsByReason) {
+		add(PulseTile{
+			ID:           "pods-reason-" + reason,
+			Group:        "Pods",
+			Title:        reason,
+			ResourceType: ResourceTypePod,
+			Keys:         podsByReason[reason],
+		}, PulseCritical)
+	}
+
+	// Nodes
+	notReady := PulseTile{ID: "nodes-not-ready", Group: "Nodes", Title: "Not ready", ResourceType: ResourceTypeNode}
+	cordoned := PulseTile{ID: "nodes-unschedulable", Group: "Nodes", Title: "Unschedulable (cordoned)", ResourceType: ResourceTypeNode}
+	for _, node := range in.nodes {
+		if !nodeIsReady(node) {
+			notReady.Keys = append(notReady.Keys, "/"+node.Name)
+		}
+		if node.Spec.Unschedulable {
+			cordoned.Keys = append(cordoned.Keys, "/"+node.Name)
+		}
+	}
+	add(notReady, PulseCritical)
+	add(cordoned, PulseWarning)
+
+	// Workloads with fewer available than desired replicas
+	deployments := PulseTile{ID: "deployments-unavailable", Group: "Workloads", Title: "Deployments missing replicas", ResourceType: ResourceTypeDeployment}
+	for _, d := range in.deployments {
+		if d.Status.AvailableReplicas < replicasOrDefault(d.Spec.Replicas) {
+			deployments.Keys = append(deployments.Keys, d.Namespace+"/"+d.Name)
+		}
+	}
+	add(deployments, PulseWarning)
+
+	statefulSets := PulseTile{ID: "statefulsets-unavailable", Group: "Workloads", Title: "StatefulSets missing replicas", ResourceType: ResourceTypeStatefulSet}
+	for _, s := range in.statefulSets {
+		if s.Status.AvailableReplicas < replicasOrDefault(s.Spec.Replicas) {
+			statefulSets.Keys = append(statefulSets.Keys, s.Namespace+"/"+s.Name)
+		}
+	}
+	add(statefulSets, PulseWarning)
+
+	daemonSets := PulseTile{ID: "daemonsets-unavailable", Group: "Workloads", Title: "DaemonSets missing pods", ResourceType: ResourceTypeDaemonSet}
+	for _, d := range in.daemonSets {
+		if d.Status.NumberAvailable < d.Status.DesiredNumberScheduled {
+			daemonSets.Keys = append(daemonSets.Keys, d.Namespace+"/"+d.Name)
+		}
+	}
+	add(daemonSets, PulseWarning)
+
+	// Jobs
+	failedJobs := PulseTile{ID: "jobs-failed", Group: "Jobs", Title: "Failed", ResourceType: ResourceTypeJob}
+	for _, job := range in.jobs {
+		for _, c := range job.Status.Conditions {
+			if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+				failedJobs.Keys = append(failedJobs.Keys, job.Namespace+"/"+job.Name)
+				break
+			}
+		}
+	}
+	add(failedJobs, PulseCritical)
+
+	// Storage
+	unbound := PulseTile{ID: "pvcs-unbound", Group: "Storage", Title: "Unbound PVCs", ResourceType: ResourceTypePersistentVolumeClaim}
+	for _, pvc := range in.pvcs {
+		if pvc.Status.Phase != corev1.ClaimBound {
+			unbound.Keys = append(unbound.Keys, pvc.Namespace+"/"+pvc.Name)
+		}
+	}
+	add(unbound, PulseWarning)
+
+	// Autoscaling
+	atMax := PulseTile{ID: "hpas-at-max", Group: "Autoscaling", Title: "HPAs at max replicas", ResourceType: ResourceTypeHPA}
+	for _, hpa := range in.hpas {
+		if hpa.Spec.MaxReplicas > 0 && hpa.Status.CurrentReplicas >= hpa.Spec.MaxReplicas {
+			atMax.Keys = append(atMax.Keys, hpa.Namespace+"/"+hpa.Name)
+		}
+	}
+	add(atMax, PulseWarning)
+
+	// Warning event rate (no list screen - the keys are the involved objects)
+	for _, event := range in.events {
+		if t := eventTime(event); !t.IsZero() && now.Sub(t) <= PulseEventWindow {
+			pulse.WarningEvents = append(pulse.WarningEvents, PulseEvent{
+				Namespace: event.InvolvedObject.Namespace,
+				Kind:      event.InvolvedObject.Kind,
+				Name:      event.InvolvedObject.Name,
+				Reason:    event.Reason,
+				Message:   event.Message,
+				Count:     max(event.Count, 1),
+				LastSeen:  t,
+			})
+		}
+	}
+	sort.SliceStable(pulse.WarningEvents, func(i, j int) bool {
+		return pulse.WarningEvents[i].LastSeen.After(pulse.WarningEvents[j].LastSeen)
+	})
+	events := PulseTile{
+		ID:     PulseTileWarningEvents,
+		Group:  "Events",
+		Title:  fmt.Sprintf("Warning events (last %s)", formatEventAge(PulseEventWindow)),
+		Count:  len(pulse.WarningEvents),
+		Loaded: in.eventsFetched,
+	}
+	for _, event := range pulse.WarningEvents {
+		events.Keys = append(events.Keys, event.Namespace+"/"+event.Name)
+	}
+	if events.Count > 0 {
+		events.Severity = PulseWarning
+	}
+	pulse.Tiles = append(pulse.Tiles, events)
+
+	return pulse
+}
+
+// podErrorReasons returns the error reasons of a pod: the pod reason (e.g.
+// Evicted), container waiting reasons (CrashLoopBackOff, ImagePullBackOff,
+// ...), failed terminations and OOMKilled last terminations
+func podErrorReasons(pod *corev1.Pod) []string {
+	seen := make(map[string]bool)
+	var reasons []string
+	addReason := func(reason string) {
+		if reason != "" && !seen[reason] {
+			seen[reason] = true
+			reasons = append(reasons, reason)
+		}
+	}
+
+	if pod.Status.Phase == corev1.PodFailed {
+		addReason(pod.Status.Reason)
+	}
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if waiting := status.State.Waiting; waiting != nil &&
+			waiting.Reason != "ContainerCreating" && waiting.Reason != "PodInitializing" {
+			addReason(waiting.Reason)
+		}
+		if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			addReason(terminated.Reason)
+		}
+		if last := status.LastTerminationState.Terminated; last != nil && last.Reason == "OOMKilled" {
+			addReason(last.Reason)
+		}
+	}
+	return reasons
+}
+
+// nodeIsReady reports whether the Ready condition of a node is True
+func nodeIsReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// replicasOrDefault returns spec.replicas (defaults to 1 when unset)
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,204 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestBuildClusterPulse(t *testing.T) {
+	now := time.Now()
+	objectMeta := func(namespace, name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Namespace: namespace, Name: name}
+	}
+
+	in := pulseInputs{
+		pods: []*corev1.Pod{
+			{ObjectMeta: objectMeta("web", "ok"), Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			{ObjectMeta: objectMeta("web", "crash"), Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:                 "app",
+					State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+				}},
+			}},
+			{ObjectMeta: objectMeta("web", "creating"), Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "app",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+				}},
+			}},
+			{ObjectMeta: objectMeta("batch", "evicted"), Status: corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted"}},
+		},
+		nodes: []*corev1.Node{
+			{ObjectMeta: objectMeta("", "node-1"), Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}}},
+			{ObjectMeta: objectMeta("", "node-2"), Spec: corev1.NodeSpec{Unschedulable: true}, Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionUnknown}}}},
+		},
+		deployments: []*appsv1.Deployment{
+			{ObjectMeta: objectMeta("web", "full"), Spec: appsv1.DeploymentSpec{Replicas: int32Ptr(2)}, Status: appsv1.DeploymentStatus{AvailableReplicas: 2}},
+			{ObjectMeta: objectMeta("web", "short"), Spec: appsv1.DeploymentSpec{Replicas: int32Ptr(3)}, Status: appsv1.DeploymentStatus{AvailableReplicas: 1}},
+		},
+		statefulSets: []*appsv1.StatefulSet{
+			{ObjectMeta: objectMeta("db", "pg"), Status: appsv1.StatefulSetStatus{AvailableReplicas: 0}},
+		},
+		daemonSets: []*appsv1.DaemonSet{
+			{ObjectMeta: objectMeta("kube-system", "proxy"), Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, NumberAvailable: 2}},
+		},
+		jobs: []*batchv1.Job{
+			{ObjectMeta: objectMeta("batch", "done"), Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}}},
+			{ObjectMeta: objectMeta("batch", "broken"), Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}}},
+		},
+		pvcs: []*corev1.PersistentVolumeClaim{
+			{ObjectMeta: objectMeta("db", "data-pg-0"), Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending}},
+			{ObjectMeta: objectMeta("db", "bound"), Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound}},
+		},
+		hpas: []*autoscalingv2.HorizontalPodAutoscaler{
+			{ObjectMeta: objectMeta("web", "full"), Spec: autoscalingv2.HorizontalPodAutoscalerSpec{MaxReplicas: 5}, Status: autoscalingv2.HorizontalPodAutoscalerStatus{CurrentReplicas: 5}},
+		},
+		events: []corev1.Event{
+			{InvolvedObject: corev1.ObjectReference{Kind: "Pod", Namespace: "web", Name: "crash"}, Reason: "BackOff", Count: 4, LastTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+			{InvolvedObject: corev1.ObjectReference{Kind: "Pod", Namespace: "web", Name: "creating"}, Reason: "FailedMount", LastTimestamp: metav1.NewTime(now.Add(-30 * time.Second))},
+			{InvolvedObject: corev1.ObjectReference{Kind: "Node", Name: "node-2"}, Reason: "NodeNotReady", LastTimestamp: metav1.NewTime(now.Add(-time.Hour))},
+		},
+		loaded: map[ResourceType]bool{
+			ResourceTypePod:                   true,
+			ResourceTypeNode:                  true,
+			ResourceTypeDeployment:            true,
+			ResourceTypeStatefulSet:           true,
+			ResourceTypeDaemonSet:             true,
+			ResourceTypeJob:                   true,
+			ResourceTypePersistentVolumeClaim: true,
+		},
+		eventsFetched: true,
+	}
+
+	pulse := buildClusterPulse(in, now)
+
+	tests := []struct {
+		id       string
+		keys     []string
+		severity PulseSeverity
+	}{
+		{"pods-phase-Running", []string{"web/ok", "web/crash"}, PulseOK},
+		{"pods-phase-Pending", []string{"web/creating"}, PulseWarning},
+		{"pods-phase-Failed", []string{"batch/evicted"}, PulseCritical},
+		{"pods-phase-Unknown", nil, PulseOK},
+		{"pods-reason-CrashLoopBackOff", []string{"web/crash"}, PulseCritical},
+		{"pods-reason-OOMKilled", []string{"web/crash"}, PulseCritical},
+		{"pods-reason-Evicted", []string{"batch/evicted"}, PulseCritical},
+		{"nodes-not-ready", []string{"/node-2"}, PulseCritical},
+		{"nodes-unschedulable", []string{"/node-2"}, PulseWarning},
+		{"deployments-unavailable", []string{"web/short"}, PulseWarning},
+		{"statefulsets-unavailable", []string{"db/pg"}, PulseWarning}, // Replicas defaults to 1
+		{"daemonsets-unavailable", nil, PulseOK},
+		{"jobs-failed", []string{"batch/broken"}, PulseCritical},
+		{"pvcs-unbound", []string{"db/data-pg-0"}, PulseWarning},
+		{"hpas-at-max", []string{"web/full"}, PulseWarning},
+		{PulseTileWarningEvents, []string{"web/creating", "web/crash"}, PulseWarning},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			tile, ok := pulse.Tile(tt.id)
+			require.True(t, ok, "tile %s not found", tt.id)
+			assert.Equal(t, tt.keys, tile.Keys)
+			assert.Equal(t, len(tt.keys), tile.Count)
+			assert.Equal(t, tt.severity, tile.Severity)
+		})
+	}
+
+	// ContainerCreating is not an error reason
+	_, ok := pulse.Tile("pods-reason-ContainerCreating")
+	assert.False(t, ok)
+
+	// HPA informer not loaded
+	hpaTile, _ := pulse.Tile("hpas-at-max")
+	assert.False(t, hpaTile.Loaded)
+
+	// Events outside the window are dropped, newest first
+	require.Len(t, pulse.WarningEvents, 2)
+	assert.Equal(t, "FailedMount", pulse.WarningEvents[0].Reason)
+	assert.Equal(t, int32(1), pulse.WarningEvents[0].Count)
+	assert.Equal(t, "BackOff", pulse.WarningEvents[1].Reason)
+	assert.Equal(t, int32(4), pulse.WarningEvents[1].Count)
+}
+
+func TestBuildClusterPulse_NothingLoaded(t *testing.T) {
+	pulse := buildClusterPulse(pulseInputs{}, time.Now())
+
+	require.NotEmpty(t, pulse.Tiles)
+	for _, tile := range pulse.Tiles {
+		assert.False(t, tile.Loaded, tile.ID)
+		assert.Zero(t, tile.Count, tile.ID)
+		assert.Equal(t, PulseOK, tile.Severity, tile.ID)
+	}
+}
+
+// newPagedEventsClientset returns a clientset listing total Warning events
+// in pages (following Continue tokens), counting the requests
+func newPagedEventsClientset(total int) (*fake.Clientset, *int) {
+	clientset := fake.NewSimpleClientset()
+	requests := 0
+	clientset.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		requests++
+		opts := action.(k8stesting.ListActionImpl).ListOptions
+		start := 0
+		if opts.Continue != "" {
+			fmt.Sscan(opts.Continue, &start)
+		}
+		end := min(start+int(opts.Limit), total)
+
+		list := &corev1.EventList{}
+		for i := start; i < end; i++ {
+			list.Items = append(list.Items, corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("event-%d", i)}})
+		}
+		if end < total {
+			list.Continue = fmt.Sprint(end)
+		}
+		return true, list, nil
+	})
+	return clientset, &requests
+}
+
+func TestListWarningEvents_FollowsContinue(t *testing.T) {
+	clientset, requests := newPagedEventsClientset(2*pulseEventsPageSize + 10)
+
+	events, err := listWarningEvents(context.Background(), clientset)
+	require.NoError(t, err)
+	assert.Len(t, events, 2*pulseEventsPageSize+10)
+	assert.Equal(t, 3, *requests)
+	assert.Equal(t, "event-1009", events[len(events)-1].Name)
+}
+
+func TestListWarningEvents_Capped(t *testing.T) {
+	clientset, requests := newPagedEventsClientset(pulseEventsMax + 3*pulseEventsPageSize)
+
+	events, err := listWarningEvents(context.Background(), clientset)
+	require.NoError(t, err)
+	assert.Len(t, events, pulseEventsMax)
+	assert.Equal(t, pulseEventsMax/pulseEventsPageSize, *requests)
+}
+
+func TestListWarningEvents_Error(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+
+	_, err := listWarningEvents(context.Background(), clientset)
+	assert.Error(t, err)
+}
@@ -0,0 +1,454 @@
+package k8s
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RBAC explorer
+//
+// Roles and bindings are cached like any other resource, so permissions can
+// be computed for any subject, not only for the current user (which is all
+// kubectl auth can-i answers). Rules are evaluated like the RBAC authorizer
+// does: a rule grants a request when its verbs, API groups and resources
+// match (or are "*"), RoleBindings grant in their namespace and
+// ClusterRoleBindings in all namespaces and at cluster scope. Aggregated
+// ClusterRoles are already filled in by the controller manager.
+//
+// The groups of a user are decided by the authenticator and never stored in
+// the cluster, so only the groups every request carries are considered:
+// system:authenticated, plus system:serviceaccounts and
+// system:serviceaccounts:<namespace> for service accounts.
+
+// RBAC subject kinds
+const (
+	SubjectUser           = rbacv1.UserKind
+	SubjectGroup          = rbacv1.GroupKind
+	SubjectServiceAccount = rbacv1.ServiceAccountKind
+)
+
+// RBACSubject identifies a user, group or service account
+type RBACSubject struct {
+	Kind      string // User, Group or ServiceAccount
+	Namespace string // Service accounts only
+	Name      string
+}
+
+// String formats a subject as "ServiceAccount web/api" or "User alice"
+func (s RBACSubject) String() string {
+	if s.Kind == SubjectServiceAccount {
+		return s.Kind + " " + s.Namespace + "/" + s.Name
+	}
+	return s.Kind + " " + s.Name
+}
+
+// PolicyRule is a rule of a Role or ClusterRole
+type PolicyRule struct {
+	Name            string // Rule number in the role ("#1"), keeps the selection across refreshes
+	Verbs           string
+	Resources       string // "deployments.apps, pods/log" (kubectl describe format)
+	ResourceNames   string // Empty = all objects
+	NonResourceURLs string
+}
+
+// RBACGrant is a rule granted to a subject through a binding
+type RBACGrant struct {
+	Name            string // Unique row key (binding, subject and rule), not shown
+	Subject         string // "ServiceAccount web/api"
+	Scope           string // Namespace the rule applies in, "*" for all namespaces and cluster scope
+	Binding         string // "RoleBinding web/api-edit"
+	Role            string // "ClusterRole/edit"
+	Verbs           string
+	Resources       string
+	ResourceNames   string
+	NonResourceURLs string
+}
+
+// rbacScopeCluster is the scope of rules granted by ClusterRoleBindings
+const rbacScopeCluster = "*"
+
+// rbacInputs holds the cached RBAC objects
+type rbacInputs struct {
+	roles               []*rbacv1.Role
+	clusterRoles        []*rbacv1.ClusterRole
+	roleBindings        []*rbacv1.RoleBinding
+	clusterRoleBindings []*rbacv1.ClusterRoleBinding
+}
+
+// loadRBACInputs lists the RBAC objects from the informer caches
+func (r *InformerRepository) loadRBACInputs() (*rbacInputs, error) {
+	in := &rbacInputs{}
+	var loaded [4]bool
+	in.roles, loaded[0] = listCachedAs[rbacv1.Role](r, ResourceTypeRole)
+	in.clusterRoles, loaded[1] = listCachedAs[rbacv1.ClusterRole](r, ResourceTypeClusterRole)
+	in.roleBindings, loaded[2] = listCachedAs[rbacv1.RoleBinding](r, ResourceTypeRoleBinding)
+	in.clusterRoleBindings, loaded[3] = listCachedAs[rbacv1.ClusterRoleBinding](r, ResourceTypeClusterRoleBinding)
+	if slices.Contains(loaded[:], false) {
+		return nil, fmt.Errorf("roles and bindings are not loaded yet (listing them needs RBAC read access)")
+	}
+	return in, nil
+}
+
+// GetBindingsForSubject returns the RoleBindings and ClusterRoleBindings
+// that bind a subject, directly or through the groups it always belongs to
+func (r *InformerRepository) GetBindingsForSubject(kind, namespace, name string) ([]RoleBinding, error) {
+	in, err := r.loadRBACInputs()
+	if err != nil {
+		return nil, err
+	}
+	return bindingsForSubject(in, RBACSubject{Kind: kind, Namespace: namespace, Name: name}), nil
+}
+
+// GetRulesForRole returns the rules of a Role (namespace set) or
+// ClusterRole
+func (r *InformerRepository) GetRulesForRole(kind, namespace, name string) ([]PolicyRule, error) {
+	in, err := r.loadRBACInputs()
+	if err != nil {
+		return nil, err
+	}
+	rules, found := in.roleRules(kind, namespace, name)
+	if !found {
+		return nil, fmt.Errorf("%s %s not found", kind, name)
+	}
+
+	result := make([]PolicyRule, len(rules))
+	for i, rule := range rules {
+		result[i] = PolicyRule{
+			Name:            fmt.Sprintf("#%d", i+1),
+			Verbs:           strings.Join(rule.Verbs, ", "),
+			Resources:       ruleResources(rule),
+			ResourceNames:   strings.Join(rule.ResourceNames, ", "),
+			NonResourceURLs: strings.Join(rule.NonResourceURLs, ", "),
+		}
+	}
+	return result, nil
+}
+
+// GetSubjectsWhoCan returns the grants that allow verb on resource
+// ("pods", "deployments.apps", "pods/log" or a non-resource URL such as
+// "/metrics") in a namespace ("" = in any namespace)
+func (r *InformerRepository) GetSubjectsWhoCan(verb, resource, namespace string) ([]RBACGrant, error) {
+	in, err := r.loadRBACInputs()
+	if err != nil {
+		return nil, err
+	}
+	return subjectsWhoCan(in, parseRBACRequest(verb, resource), namespace), nil
+}
+
+// GetSubjectPermissions returns every rule granted to a subject
+func (r *InformerRepository) GetSubjectPermissions(kind, namespace, name string) ([]RBACGrant, error) {
+	in, err := r.loadRBACInputs()
+	if err != nil {
+		return nil, err
+	}
+	return subjectPermissions(in, RBACSubject{Kind: kind, Namespace: namespace, Name: name}), nil
+}
+
+// rbacBinding is a RoleBinding (namespace set) or ClusterRoleBinding
+type rbacBinding struct {
+	kind      string
+	namespace string
+	name      string
+	createdAt time.Time
+	roleRef   rbacv1.RoleRef
+	subjects  []RBACSubject
+}
+
+func (b rbacBinding) String() string {
+	if b.namespace != "" {
+		return b.kind + " " + b.namespace + "/" + b.name
+	}
+	return b.kind + " " + b.name
+}
+
+// scope returns the namespace the binding grants in
+func (b rbacBinding) scope() string {
+	if b.namespace != "" {
+		return b.namespace
+	}
+	return rbacScopeCluster
+}
+
+// roleBinding converts the binding to a RoleBinding row
+func (b rbacBinding) roleBinding() RoleBinding {
+	return RoleBinding{
+		ResourceMetadata: ResourceMetadata{
+			Namespace: b.namespace,
+			Name:      b.name,
+			Age:       time.Since(b.createdAt),
+			CreatedAt: b.createdAt,
+		},
+		Kind:     b.kind,
+		Role:     b.roleRef.Kind + "/" + b.roleRef.Name,
+		Subjects: formatSubjects(b.subjects),
+	}
+}
+
+// bindings returns the RoleBindings and ClusterRoleBindings as one list
+func (in *rbacInputs) bindings() []rbacBinding {
+	result := make([]rbacBinding, 0, len(in.roleBindings)+len(in.clusterRoleBindings))
+	for _, rb := range in.roleBindings {
+		result = append(result, rbacBinding{
+			kind:      "RoleBinding",
+			namespace: rb.Namespace,
+			name:      rb.Name,
+			createdAt: rb.CreationTimestamp.Time,
+			roleRef:   rb.RoleRef,
+			subjects:  bindingSubjects(rb.Subjects, rb.Namespace),
+		})
+	}
+	for _, crb := range in.clusterRoleBindings {
+		result = append(result, rbacBinding{
+			kind:      "ClusterRoleBinding",
+			name:      crb.Name,
+			createdAt: crb.CreationTimestamp.Time,
+			roleRef:   crb.RoleRef,
+			subjects:  bindingSubjects(crb.Subjects, ""),
+		})
+	}
+	return result
+}
+
+// roleRules returns the rules of a role (false if it does not exist)
+func (in *rbacInputs) roleRules(kind, namespace, name string) ([]rbacv1.PolicyRule, bool) {
+	if kind == "ClusterRole" {
+		for _, role := range in.clusterRoles {
+			if role.Name == name {
+				return role.Rules, true
+			}
+		}
+		return nil, false
+	}
+	for _, role := range in.roles {
+		if role.Namespace == namespace && role.Name == name {
+			return role.Rules, true
+		}
+	}
+	return nil, false
+}
+
+// rbacRequest is the request checked by who-can
+type rbacRequest struct {
+	verb        string
+	group       string // "*" when unknown (resource given without group)
+	resource    string
+	subresource string
+	url         string // Non-resource requests only
+	namespaced  bool   // Cluster-scoped resources are granted by ClusterRoleBindings only
+}
+
+// parseRBACRequest parses "resource[.group][/subresource]" or a non-resource
+// URL. Without a group, built-in resources use their registry group.
+func parseRBACRequest(verb, resource string) rbacRequest {
+	req := rbacRequest{verb: verb, namespaced: true}
+	if strings.HasPrefix(resource, "/") {
+		req.url = resource
+		req.namespaced = false
+		return req
+	}
+
+	resource, req.subresource, _ = strings.Cut(resource, "/")
+	req.resource, req.group, _ = strings.Cut(resource, ".")
+	if req.group == "" {
+		req.group = "*"
+		if config, ok := GetResourceConfig(ResourceType(req.resource)); ok {
+			req.group = config.GVR.Group
+			req.namespaced = config.Namespaced
+		}
+	} else if resourceType, ok := FindResourceTypeByGVR(schema.GroupVersionResource{Group: req.group, Resource: req.resource}); ok {
+		config, _ := GetResourceConfig(resourceType)
+		req.namespaced = config.Namespaced
+	}
+	return req
+}
+
+// allows reports whether a rule grants the request
+func (req rbacRequest) allows(rule rbacv1.PolicyRule) bool {
+	if !matchesAny(rule.Verbs, req.verb) {
+		return false
+	}
+	if req.url != "" {
+		for _, url := range rule.NonResourceURLs {
+			if url == "*" || url == req.url || (strings.HasSuffix(url, "*") && strings.HasPrefix(req.url, strings.TrimSuffix(url, "*"))) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if req.group != "*" && !matchesAny(rule.APIGroups, req.group) {
+		return false
+	}
+	resource := req.resource
+	if req.subresource != "" {
+		resource += "/" + req.subresource
+	}
+	return matchesResource(rule.Resources, resource, req.subresource)
+}
+
+// subjectsWhoCan returns a grant per subject of every binding with a rule
+// allowing the request in namespace ("" = any namespace)
+func subjectsWhoCan(in *rbacInputs, req rbacRequest, namespace string) []RBACGrant {
+	var grants []RBACGrant
+	for _, binding := range in.bindings() {
+		if binding.namespace != "" && (!req.namespaced || (namespace != "" && binding.namespace != namespace)) {
+			continue
+		}
+		rules, found := in.roleRules(binding.roleRef.Kind, binding.namespace, binding.roleRef.Name)
+		if !found {
+			continue
+		}
+		for i, rule := range rules {
+			if !req.allows(rule) {
+				continue
+			}
+			for _, subject := range binding.subjects {
+				grants = append(grants, newRBACGrant(subject, binding, i, rule))
+			}
+		}
+	}
+
+	sort.Slice(grants, func(i, j int) bool {
+		if grants[i].Subject != grants[j].Subject {
+			return grants[i].Subject < grants[j].Subject
+		}
+		return grants[i].Name < grants[j].Name
+	})
+	return grants
+}
+
+// subjectPermissions returns a grant per rule of every binding of a subject
+func subjectPermissions(in *rbacInputs, subject RBACSubject) []RBACGrant {
+	var grants []RBACGrant
+	for _, binding := range in.bindings() {
+		bound, ok := bindsSubject(binding, subject)
+		if !ok {
+			continue
+		}
+		rules, found := in.roleRules(binding.roleRef.Kind, binding.namespace, binding.roleRef.Name)
+		if !found {
+			continue
+		}
+		for i, rule := range rules {
+			grants = append(grants, newRBACGrant(bound, binding, i, rule))
+		}
+	}
+
+	// Cluster-wide first, then by namespace
+	sort.Slice(grants, func(i, j int) bool {
+		if grants[i].Scope != grants[j].Scope {
+			return grants[i].Scope < grants[j].Scope
+		}
+		return grants[i].Name < grants[j].Name
+	})
+	return grants
+}
+
+// bindingsForSubject returns the bindings of a subject
+func bindingsForSubject(in *rbacInputs, subject RBACSubject) []RoleBinding {
+	var result []RoleBinding
+	for _, binding := range in.bindings() {
+		if _, ok := bindsSubject(binding, subject); ok {
+			result = append(result, binding.roleBinding())
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// bindsSubject returns the subject of a binding that applies to subject
+// (itself or one of its implicit groups)
+func bindsSubject(binding rbacBinding, subject RBACSubject) (RBACSubject, bool) {
+	groups := implicitGroups(subject)
+	for _, bound := range binding.subjects {
+		if bound == subject || (bound.Kind == SubjectGroup && slices.Contains(groups, bound.Name)) {
+			return bound, true
+		}
+	}
+	return RBACSubject{}, false
+}
+
+// implicitGroups returns the groups a subject always belongs to
+func implicitGroups(subject RBACSubject) []string {
+	switch subject.Kind {
+	case SubjectServiceAccount:
+		return []string{"system:serviceaccounts", "system:serviceaccounts:" + subject.Namespace, "system:authenticated"}
+	case SubjectUser:
+		return []string{"system:authenticated"}
+	}
+	return nil
+}
+
+// bindingSubjects converts the subjects of a binding
+func bindingSubjects(subjects []rbacv1.Subject, bindingNamespace string) []RBACSubject {
+	result := make([]RBACSubject, len(subjects))
+	for i, subject := range subjects {
+		result[i] = newRBACSubject(subject.Kind, subject.Namespace, subject.Name, bindingNamespace)
+	}
+	return result
+}
+
+// newRBACSubject creates a binding subject (service accounts of
+// RoleBindings default to the binding namespace, like the authorizer does)
+func newRBACSubject(kind, namespace, name, bindingNamespace string) RBACSubject {
+	if kind != SubjectServiceAccount {
+		return RBACSubject{Kind: kind, Name: name}
+	}
+	if namespace == "" {
+		namespace = bindingNamespace
+	}
+	return RBACSubject{Kind: kind, Namespace: namespace, Name: name}
+}
+
+// formatSubjects formats binding subjects ("ServiceAccount web/api, Group dev")
+func formatSubjects(subjects []RBACSubject) string {
+	formatted := make([]string, len(subjects))
+	for i, subject := range subjects {
+		formatted[i] = subject.String()
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func newRBACGrant(subject RBACSubject, binding rbacBinding, ruleIndex int, rule rbacv1.PolicyRule) RBACGrant {
+	return RBACGrant{
+		Name:            fmt.Sprintf("%s|%s|%03d", binding, subject, ruleIndex),
+		Subject:         subject.String(),
+		Scope:           binding.scope(),
+		Binding:         binding.String(),
+		Role:            binding.roleRef.Kind + "/" + binding.roleRef.Name,
+		Verbs:           strings.Join(rule.Verbs, ", "),
+		Resources:       ruleResources(rule),
+		ResourceNames:   strings.Join(rule.ResourceNames, ", "),
+		NonResourceURLs: strings.Join(rule.NonResourceURLs, ", "),
+	}
+}
+
+// ruleResources formats the resources of a rule like kubectl describe
+// ("deployments.apps", "pods" for the core group)
+func ruleResources(rule rbacv1.PolicyRule) string {
+	var resources []string
+	for _, resource := range rule.Resources {
+		if len(rule.APIGroups) == 0 {
+			resources = append(resources, resource)
+		}
+		for _, group := range rule.APIGroups {
+			if group == "" {
+				resources = append(resources, resource)
+			} else {
+				resources = append(resources, resource+"."+group)
+			}
+		}
+	}
+	return strings.Join(resources, ", ")
+}
@@ -0,0 +1,157 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testRBACInputs() *rbacInputs {
+	objectMeta := func(namespace, name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Namespace: namespace, Name: name}
+	}
+	sa := func(namespace, name string) rbacv1.Subject {
+		return rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: namespace, Name: name}
+	}
+
+	return &rbacInputs{
+		roles: []*rbacv1.Role{
+			{ObjectMeta: objectMeta("web", "pod-reader"), Rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}, Verbs: []string{"get", "list"}},
+			}},
+		},
+		clusterRoles: []*rbacv1.ClusterRole{
+			{ObjectMeta: objectMeta("", "edit"), Rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"*"}},
+				{APIGroups: []string{""}, Resources: []string{"configmaps"}, ResourceNames: []string{"settings"}, Verbs: []string{"update"}},
+			}},
+			{ObjectMeta: objectMeta("", "node-viewer"), Rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"get"}},
+				{NonResourceURLs: []string{"/metrics*"}, Verbs: []string{"get"}},
+			}},
+		},
+		roleBindings: []*rbacv1.RoleBinding{
+			// Service account without namespace: the binding's
+			{ObjectMeta: objectMeta("web", "api-reader"), RoleRef: rbacv1.RoleRef{Kind: "Role", Name: "pod-reader"},
+				Subjects: []rbacv1.Subject{sa("", "api"), {Kind: rbacv1.UserKind, Name: "alice"}}},
+			// ClusterRole granted in one namespace
+			{ObjectMeta: objectMeta("web", "deployers"), RoleRef: rbacv1.RoleRef{Kind: "ClusterRole", Name: "edit"},
+				Subjects: []rbacv1.Subject{{Kind: rbacv1.GroupKind, Name: "system:serviceaccounts:web"}}},
+			{ObjectMeta: objectMeta("batch", "dangling"), RoleRef: rbacv1.RoleRef{Kind: "Role", Name: "missing"},
+				Subjects: []rbacv1.Subject{sa("batch", "job")}},
+		},
+		clusterRoleBindings: []*rbacv1.ClusterRoleBinding{
+			{ObjectMeta: objectMeta("", "monitoring"), RoleRef: rbacv1.RoleRef{Kind: "ClusterRole", Name: "node-viewer"},
+				Subjects: []rbacv1.Subject{sa("monitoring", "prometheus")}},
+		},
+	}
+}
+
+func TestParseRBACRequest(t *testing.T) {
+	tests := []struct {
+		resource string
+		want     rbacRequest
+	}{
+		{"pods", rbacRequest{verb: "get", group: "", resource: "pods", namespaced: true}},
+		{"deployments", rbacRequest{verb: "get", group: "apps", resource: "deployments", namespaced: true}},
+		{"pods/log", rbacRequest{verb: "get", group: "", resource: "pods", subresource: "log", namespaced: true}},
+		{"nodes", rbacRequest{verb: "get", group: "", resource: "nodes", namespaced: false}},
+		{"widgets.example.com", rbacRequest{verb: "get", group: "example.com", resource: "widgets", namespaced: true}},
+		{"widgets", rbacRequest{verb: "get", group: "*", resource: "widgets", namespaced: true}},
+		{"/healthz", rbacRequest{verb: "get", url: "/healthz"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resource, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseRBACRequest("get", tt.resource))
+		})
+	}
+}
+
+func TestSubjectsWhoCan(t *testing.T) {
+	in := testRBACInputs()
+
+	grants := subjectsWhoCan(in, parseRBACRequest("get", "pods"), "")
+	require.Len(t, grants, 2)
+	assert.Equal(t, "ServiceAccount web/api", grants[0].Subject)
+	assert.Equal(t, "User alice", grants[1].Subject)
+	assert.Equal(t, "web", grants[0].Scope)
+	assert.Equal(t, "RoleBinding web/api-reader", grants[0].Binding)
+	assert.Equal(t, "Role/pod-reader", grants[0].Role)
+	assert.Equal(t, "pods, pods/log", grants[0].Resources)
+
+	// Wildcard verbs, other namespaces
+	grants = subjectsWhoCan(in, parseRBACRequest("delete", "deployments"), "web")
+	require.Len(t, grants, 1)
+	assert.Equal(t, "Group system:serviceaccounts:web", grants[0].Subject)
+	assert.Equal(t, "deployments.apps", grants[0].Resources)
+	assert.Empty(t, subjectsWhoCan(in, parseRBACRequest("delete", "deployments"), "batch"))
+
+	// Subresources need their own rule
+	assert.Len(t, subjectsWhoCan(in, parseRBACRequest("get", "pods/log"), "web"), 2)
+	assert.Empty(t, subjectsWhoCan(in, parseRBACRequest("get", "pods/exec"), "web"))
+
+	// Cluster-scoped resources and URLs are granted by ClusterRoleBindings
+	grants = subjectsWhoCan(in, parseRBACRequest("get", "nodes"), "")
+	require.Len(t, grants, 1)
+	assert.Equal(t, "*", grants[0].Scope)
+	grants = subjectsWhoCan(in, parseRBACRequest("get", "/metrics/cadvisor"), "")
+	require.Len(t, grants, 1)
+	assert.Equal(t, "/metrics*", grants[0].NonResourceURLs)
+	assert.Empty(t, subjectsWhoCan(in, parseRBACRequest("get", "/healthz"), ""))
+}
+
+func TestSubjectPermissions(t *testing.T) {
+	in := testRBACInputs()
+
+	// Direct binding plus the namespace group of the service account
+	grants := subjectPermissions(in, RBACSubject{Kind: SubjectServiceAccount, Namespace: "web", Name: "api"})
+	require.Len(t, grants, 3)
+	for _, grant := range grants {
+		assert.Equal(t, "web", grant.Scope)
+	}
+	assert.Equal(t, "ServiceAccount web/api", grants[0].Subject)
+	assert.Equal(t, "Group system:serviceaccounts:web", grants[1].Subject, "the subject is the one bound")
+	assert.Equal(t, "ClusterRole/edit", grants[1].Role)
+	assert.Equal(t, "settings", grants[2].ResourceNames)
+
+	grants = subjectPermissions(in, RBACSubject{Kind: SubjectServiceAccount, Namespace: "monitoring", Name: "prometheus"})
+	require.Len(t, grants, 2)
+	assert.Equal(t, "*", grants[0].Scope)
+
+	// Roles that do not exist grant nothing
+	assert.Empty(t, subjectPermissions(in, RBACSubject{Kind: SubjectServiceAccount, Namespace: "batch", Name: "job"}))
+	assert.Empty(t, subjectPermissions(in, RBACSubject{Kind: SubjectUser, Name: "bob"}))
+}
+
+func TestBindingsForSubject(t *testing.T) {
+	in := testRBACInputs()
+
+	bindings := bindingsForSubject(in, RBACSubject{Kind: SubjectServiceAccount, Namespace: "web", Name: "api"})
+	require.Len(t, bindings, 2)
+	assert.Equal(t, "api-reader", bindings[0].Name)
+	assert.Equal(t, "RoleBinding", bindings[0].Kind)
+	assert.Equal(t, "Role/pod-reader", bindings[0].Role)
+	assert.Equal(t, "ServiceAccount web/api, User alice", bindings[0].Subjects)
+	assert.Equal(t, "deployers", bindings[1].Name)
+
+	// Same name in another namespace
+	assert.Empty(t, bindingsForSubject(in, RBACSubject{Kind: SubjectServiceAccount, Namespace: "batch", Name: "api"}))
+	assert.Len(t, bindingsForSubject(in, RBACSubject{Kind: SubjectUser, Name: "alice"}), 1)
+}
+
+func TestRBACInputs_RoleRules(t *testing.T) {
+	in := testRBACInputs()
+
+	rules, found := in.roleRules("Role", "web", "pod-reader")
+	assert.True(t, found)
+	assert.Len(t, rules, 1)
+	_, found = in.roleRules("Role", "batch", "pod-reader")
+	assert.False(t, found)
+	rules, found = in.roleRules("ClusterRole", "", "node-viewer")
+	assert.True(t, found)
+	assert.Equal(t, "nodes", ruleResources(rules[0]))
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/renato0307/k1/internal/logging"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -23,6 +24,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	appsv1listers "k8s.io/client-go/listers/apps/v1"
 	v1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -36,7 +38,7 @@ var (
 // InformerRepository implements Repository using Kubernetes informers
 type InformerRepository struct {
 	// Typed client and informers (legacy, preserved for compatibility)
-	clientset         *kubernetes.Clientset
+	clientset         kubernetes.Interface
 	factory           informers.SharedInformerFactory
 	podLister         v1listers.PodLister
 	deploymentLister  appsv1listers.DeploymentLister
@@ -67,6 +69,15 @@ type InformerRepository struct {
 	replicaSetsByOwnerUID map[string][]string                 // deploymentUID → RS keys
 	podsByPVC             map[string][]*corev1.Pod            // ns/pvcName → pods
 
+	// Ownership index over all dynamic informers (see informer_ownership.go)
+	objectsByOwnerUID map[string]map[string]ownedObject        // ownerUID → owned object UID → object
+	ownershipTracked  map[schema.GroupVersionResource]bool     // informers feeding objectsByOwnerUID
+	kindResources     map[schema.GroupVersionKind]kindResource // owner kind → discovered resource
+
+	// Object history, nil until enabled (see informer_history.go)
+	history        atomic.Pointer[historyRecorder]
+	historyTracked map[schema.GroupVersionResource]bool // informers feeding the history
+
 	// Statistics tracking (channel-based, no locks needed)
 	resourceStats map[schema.GroupVersionResource]*ResourceStats
 	statsUpdateCh chan statsUpdateMsg
@@ -76,6 +87,14 @@ type InformerRepository struct {
 	typedInformersSyncError atomic.Value // stores error
 	dynamicInformerErrors   map[schema.GroupVersionResource]error
 
+	// RBAC access reviews (cached, see access.go)
+	access *accessReviewer
+
+	// Warning events listed for the cluster pulse (not informer-backed)
+	pulseEventsMu      sync.Mutex
+	pulseEvents        []corev1.Event
+	pulseEventsFetched time.Time
+
 	closed atomic.Bool // Atomic flag for safe close detection
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -120,18 +139,9 @@ func NewInformerRepositoryWithProgress(kubeconfig, contextName string, progress
 	}
 
 	// Build config
-	loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig}
-	configOverrides := &clientcmd.ConfigOverrides{}
-	if contextName != "" {
-		configOverrides.CurrentContext = contextName
-	}
-
-	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
-		loadingRules,
-		configOverrides,
-	).ClientConfig()
+	config, err := loadRestConfig(kubeconfig, contextName)
 	if err != nil {
-		return nil, fmt.Errorf("error building kubeconfig: %w", err)
+		return nil, err
 	}
 
 	// Use protobuf for better performance
@@ -176,6 +186,13 @@ func NewInformerRepositoryWithProgress(kubeconfig, contextName string, progress
 	}
 	logging.Debug("Dynamic client created")
 
+	return newInformerRepositoryForClients(clientset, dynamicClient, kubeconfig, contextName, progress)
+}
+
+// newInformerRepositoryForClients creates the informers and indexes of a
+// repository on top of connected clients (also used to replay snapshots
+// through in-memory clients, see snapshot_repository.go)
+func newInformerRepositoryForClients(clientset kubernetes.Interface, dynamicClient dynamic.Interface, kubeconfig, contextName string, progress chan<- ContextLoadProgress) (*InformerRepository, error) {
 	// Create shared informer factories with resync period
 	factoryStart := logging.Start("create informer factories")
 	factory := informers.NewSharedInformerFactory(clientset, InformerResyncPeriod)
@@ -222,6 +239,12 @@ func NewInformerRepositoryWithProgress(kubeconfig, contextName string, progress
 			continue
 		}
 		informer := dynamicFactory.ForResource(resCfg.GVR).Informer()
+		if resCfg.MetadataOnly {
+			// Drop payloads before they reach the cache (must be set before start)
+			if err := informer.SetTransform(projectMetadataOnly); err != nil {
+				logging.Warn("Failed to set metadata-only transform", "resource", resCfg.GVR.Resource, "error", err)
+			}
+		}
 		// Don't add lister yet - will be added by background goroutine after sync
 		dynamicInformers[resCfg.GVR] = informer
 	}
@@ -308,9 +331,14 @@ func NewInformerRepositoryWithProgress(kubeconfig, contextName string, progress
 		jobsByNamespace:       make(map[string][]string),
 		replicaSetsByOwnerUID: make(map[string][]string),
 		podsByPVC:             make(map[string][]*corev1.Pod),
+		objectsByOwnerUID:     make(map[string]map[string]ownedObject),
+		ownershipTracked:      make(map[schema.GroupVersionResource]bool),
+		historyTracked:        make(map[schema.GroupVersionResource]bool),
+		kindResources:         make(map[schema.GroupVersionKind]kindResource),
 		resourceStats:         resourceStats,
 		statsUpdateCh:         make(chan statsUpdateMsg, 1000), // Buffered channel for high-frequency events
 		dynamicInformerErrors: make(map[schema.GroupVersionResource]error),
+		access:                newAccessReviewer(clientset.AuthorizationV1()),
 		ctx:                   ctx,
 		cancel:                cancel,
 	}
@@ -318,6 +346,10 @@ func NewInformerRepositoryWithProgress(kubeconfig, contextName string, progress
 	// Start statistics updater goroutine
 	go repo.statsUpdater()
 
+	// Find resource types the user cannot list (reported as forbidden
+	// instead of not synced)
+	go repo.checkInformerAccess()
+
 	// Setup pod indexes with event handlers
 	repo.setupPodIndexes()
 	repo.setupJobIndexes()
@@ -425,6 +457,10 @@ func NewInformerRepositoryWithProgress(kubeconfig, contextName string, progress
 					// Specific API error (likely RBAC)
 					errMsg = fmt.Sprintf("Cannot access %s: %v", gvr.Resource, err)
 					logging.Warn("Dynamic informer sync failed", "resource", gvr.Resource, "tier", tier, "error", err)
+					if stats, ok := repo.resourceStats[gvr]; ok && apierrors.IsForbidden(err) {
+						stats.Forbidden = true
+						stats.ForbiddenReason = err.Error()
+					}
 				} else {
 					// API call succeeded but sync timed out
 					errMsg = fmt.Sprintf("%s informer timed out (cluster may be slow)", gvr.Resource)
@@ -453,6 +489,25 @@ func NewInformerRepositoryWithProgress(kubeconfig, contextName string, progress
 	return repo, nil
 }
 
+// loadRestConfig builds the client config of a kubeconfig context (the
+// current context when contextName is empty)
+func loadRestConfig(kubeconfig, contextName string) (*rest.Config, error) {
+	loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig}
+	configOverrides := &clientcmd.ConfigOverrides{}
+	if contextName != "" {
+		configOverrides.CurrentContext = contextName
+	}
+
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		loadingRules,
+		configOverrides,
+	).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error building kubeconfig: %w", err)
+	}
+	return config, nil
+}
+
 // NewInformerRepository creates a new informer-based repository (backward compatible)
 func NewInformerRepository(kubeconfig, contextName string) (*InformerRepository, error) {
 	return NewInformerRepositoryWithProgress(kubeconfig, contextName, nil)
@@ -783,6 +838,8 @@ func (r *InformerRepository) EnsureCRInformer(gvr schema.GroupVersionResource) e
 
 	// Get informer (safe, idempotent - returns same informer if called multiple times)
 	informer := r.dynamicFactory.ForResource(gvr).Informer()
+	r.trackOwnership(gvr, informer)
+	r.trackHistory(gvr, informer)
 
 	// Check if already synced (might have been loaded by another goroutine)
 	if informer.HasSynced() {
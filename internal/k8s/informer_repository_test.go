@@ -892,6 +892,10 @@ func createTestRepository(t *testing.T, namespace string) *InformerRepository {
 		podsBySecret:      make(map[string]map[string][]*corev1.Pod),
 		jobsByOwnerUID:    make(map[string][]string),
 		jobsByNamespace:   make(map[string][]string),
+		objectsByOwnerUID: make(map[string]map[string]ownedObject),
+		ownershipTracked:  make(map[schema.GroupVersionResource]bool),
+		historyTracked:    make(map[schema.GroupVersionResource]bool),
+		kindResources:     make(map[schema.GroupVersionKind]kindResource),
 		ctx:               ctx,
 		cancel:            cancel,
 	}
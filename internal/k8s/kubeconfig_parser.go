@@ -13,6 +13,7 @@ type ContextInfo struct {
 	Cluster   string
 	User      string
 	Namespace string
+	Server    string // API server URL of the cluster
 }
 
 // parseKubeconfig loads kubeconfig and extracts all contexts
@@ -26,12 +27,16 @@ func parseKubeconfig(kubeconfigPath string) ([]*ContextInfo, error) {
 	// Extract contexts
 	contexts := make([]*ContextInfo, 0, len(config.Contexts))
 	for name, ctx := range config.Contexts {
-		contexts = append(contexts, &ContextInfo{
+		info := &ContextInfo{
 			Name:      name,
 			Cluster:   ctx.Cluster,
 			User:      ctx.AuthInfo,
 			Namespace: ctx.Namespace,
-		})
+		}
+		if cluster, ok := config.Clusters[ctx.Cluster]; ok {
+			info.Server = cluster.Server
+		}
+		contexts = append(contexts, info)
 	}
 
 	// Sort alphabetically by name to ensure stable order
@@ -71,11 +71,13 @@ func TestParseKubeconfig(t *testing.T) {
 				assert.Equal(t, "cluster1", contexts[0].Cluster)
 				assert.Equal(t, "user1", contexts[0].User)
 				assert.Equal(t, "default", contexts[0].Namespace)
+				assert.Equal(t, "https://cluster1.example.com", contexts[0].Server)
 
 				// Verify second context fields
 				assert.Equal(t, "cluster2", contexts[1].Cluster)
 				assert.Equal(t, "user2", contexts[1].User)
 				assert.Equal(t, "kube-system", contexts[1].Namespace)
+				assert.Equal(t, "https://cluster2.example.com", contexts[1].Server)
 
 				// Verify empty namespace handling
 				assert.Equal(t, "", contexts[2].Namespace)
@@ -27,8 +27,17 @@ const (
 	ResourceTypeIngress               ResourceType = "ingresses"
 	ResourceTypeEndpoints             ResourceType = "endpoints"
 	ResourceTypeHPA                   ResourceType = "horizontalpodautoscalers"
+	ResourceTypePersistentVolume      ResourceType = "persistentvolumes"
+	ResourceTypeStorageClass          ResourceType = "storageclasses"
+	ResourceTypeVolumeAttachment      ResourceType = "volumeattachments"
+	ResourceTypeServiceAccount        ResourceType = "serviceaccounts"
+	ResourceTypeRole                  ResourceType = "roles"
+	ResourceTypeClusterRole           ResourceType = "clusterroles"
+	ResourceTypeRoleBinding           ResourceType = "rolebindings"
+	ResourceTypeClusterRoleBinding    ResourceType = "clusterrolebindings"
 	ResourceTypeCRD                   ResourceType = "customresourcedefinitions"
 	ResourceTypeContext               ResourceType = "contexts"
+	ResourceTypeContainer             ResourceType = "containers" // Containers of one pod (not an API resource)
 )
 
 // ResourceConfig defines configuration for a resource type
@@ -38,6 +47,11 @@ type ResourceConfig struct {
 	Namespaced bool
 	Tier       int // 0=on-demand only, 1=critical (block UI), 2=background, 3=deferred
 	Transform  TransformFunc
+
+	// MetadataOnly informers cache a metadata projection instead of the full
+	// object (payloads are dropped on arrival, only the data key count is kept).
+	// Full objects are fetched from the API server on demand.
+	MetadataOnly bool
 }
 
 // TransformFunc converts an unstructured resource to a typed struct
@@ -55,6 +69,11 @@ type ResourceStats struct {
 	DeleteEvents int64
 	Synced       bool
 	MemoryBytes  int64 // Approximate
+
+	// Forbidden resource types cannot be listed and watched by the user, so
+	// their informers never sync
+	Forbidden       bool
+	ForbiddenReason string
 }
 
 // Repository provides access to Kubernetes resources
@@ -91,11 +110,42 @@ type Repository interface {
 	GetPodsForReplicaSet(namespace, name string) ([]Pod, error)
 	GetReplicaSetsForDeployment(namespace, name string) ([]ReplicaSet, error)
 	GetPodsForPVC(namespace, name string) ([]Pod, error)
+	GetContainersForPod(namespace, name string) ([]Container, error)
+
+	// Pod diagnosis (container states, dependencies and recent Warning events)
+	DiagnosePod(namespace, name string) (*PodDiagnosis, error)
+
+	// Cluster pulse (health summary computed from the informer caches)
+	GetClusterPulse() (*ClusterPulse, error)
+
+	// Node allocation (node detail screen)
+	GetNodeDetail(name string) (*NodeDetail, error)
+
+	// Ownership (descendants via ownerReferences of any loaded kind, owners via controller references)
+	GetOwnershipTree(gvr schema.GroupVersionResource, namespace, name string) (*OwnershipNode, error)
+	GetTopLevelOwner(gvr schema.GroupVersionResource, namespace, name string) (ResourceRef, error)
+
+	// RBAC explorer (computed from the cached roles and bindings)
+	GetBindingsForSubject(kind, namespace, name string) ([]RoleBinding, error)
+	GetRulesForRole(kind, namespace, name string) ([]PolicyRule, error)
+	GetSubjectsWhoCan(verb, resource, namespace string) ([]RBACGrant, error)
+	GetSubjectPermissions(kind, namespace, name string) ([]RBACGrant, error)
+
+	// Object history (versions observed by the informers, opt-in)
+	GetObjectHistory(gvr schema.GroupVersionResource, namespace, name string) (*ObjectHistory, error)
 
 	// Resource detail commands (using kubectl libraries)
 	GetResourceYAML(gvr schema.GroupVersionResource, namespace, name string) (string, error)
 	DescribeResource(gvr schema.GroupVersionResource, namespace, name string) (string, error)
 
+	// On-demand payload access (metadata-only informers never cache payloads)
+	GetSecretData(namespace, name string) (map[string][]byte, error)
+	GetConfigMapData(namespace, name string) (*ConfigMapData, error)
+	PatchConfigMap(namespace, name string, patch []byte) error // JSON merge patch
+
+	// Container logs (fetched on demand, never cached)
+	GetPreviousContainerLogs(namespace, podName, container string, tailLines int64) (*PreviousContainerLogs, error)
+
 	// Kubeconfig and context (for kubectl subprocess commands)
 	GetKubeconfig() string
 	GetContext() string
@@ -103,6 +153,9 @@ type Repository interface {
 	// Statistics (for system resources screen)
 	GetResourceStats() []ResourceStats
 
+	// RBAC (cached SelfSubjectRulesReview/SelfSubjectAccessReview; namespace "" = cluster scope)
+	CheckAccess(verb string, gvr schema.GroupVersionResource, subresource, namespace string) AccessDecision
+
 	// Context management (for repository pool)
 	SwitchContext(contextName string, progress chan<- ContextLoadProgress) error
 	GetAllContexts() []ContextWithStatus
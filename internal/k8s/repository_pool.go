@@ -2,7 +2,11 @@ package k8s
 
 import (
 	"container/list"
+	"errors"
 	"fmt"
+	"reflect"
+	"slices"
+	"sort"
 	"sync"
 	"time"
 
@@ -45,6 +49,13 @@ type RepositoryPool struct {
 	kubeconfig string
 	contexts   []*ContextInfo // All contexts from kubeconfig
 	loading    sync.Map       // map[string]*loadingState - coordinate concurrent loads
+
+	// Aggregated mode: list screens merge resources of all loaded contexts
+	aggregated         bool
+	aggregatedContexts []string // Contexts to merge (empty = all loaded)
+
+	// Object history enabled on every context loaded (nil = disabled)
+	objectHistory *ObjectHistoryOptions
 }
 
 // NewRepositoryPool creates a new repository pool
@@ -77,7 +88,8 @@ func NewRepositoryPool(kubeconfig string, maxSize int) (*RepositoryPool, error)
 	}, nil
 }
 
-// NewRepositoryPoolFromRepos creates a pool pre-populated with repositories (for testing)
+// NewRepositoryPoolFromRepos creates a pool pre-populated with repositories
+// (for testing and snapshot browsing)
 func NewRepositoryPoolFromRepos(repos map[string]Repository) (*RepositoryPool, error) {
 	if len(repos) == 0 {
 		return nil, fmt.Errorf("repos cannot be empty")
@@ -171,6 +183,10 @@ func (p *RepositoryPool) LoadContext(contextName string, progress chan<- Context
 		return err
 	}
 
+	if p.objectHistory != nil {
+		repo.EnableObjectHistory(*p.objectHistory)
+	}
+
 	// Check pool size and evict if needed
 	for len(p.repos) > p.maxSize {
 		p.evictLRU()
@@ -197,6 +213,14 @@ func (p *RepositoryPool) LoadContext(contextName string, progress chan<- Context
 	return nil
 }
 
+// SetObjectHistory enables the object history on the contexts loaded from
+// now on (see EnableObjectHistory)
+func (p *RepositoryPool) SetObjectHistory(opts ObjectHistoryOptions) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.objectHistory = &opts
+}
+
 // GetActiveRepository returns the currently active repository
 func (p *RepositoryPool) GetActiveRepository() Repository {
 	p.mu.RLock()
@@ -288,6 +312,19 @@ func (p *RepositoryPool) getAllContextsLocked() []ContextWithStatus {
 	return result
 }
 
+// GetContextServer returns the API server URL of a kubeconfig context
+// (empty when unknown)
+func (p *RepositoryPool) GetContextServer(contextName string) string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, ctx := range p.contexts {
+		if ctx.Name == contextName {
+			return ctx.Server
+		}
+	}
+	return ""
+}
+
 // RetryFailedContext retries loading a failed context
 func (p *RepositoryPool) RetryFailedContext(contextName string, progress chan<- ContextLoadProgress) error {
 	p.mu.Lock()
@@ -317,6 +354,180 @@ func (p *RepositoryPool) SetActive(contextName string) error {
 	return nil
 }
 
+// SetAggregated turns aggregated mode on or off. In aggregated mode list
+// screens merge the resources of the given contexts (all loaded contexts
+// when none are given) and every row carries its context.
+func (p *RepositoryPool) SetAggregated(enabled bool, contexts []string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, name := range contexts {
+		if entry, ok := p.repos[name]; !ok || entry.Status != StatusLoaded {
+			return fmt.Errorf("context %s not loaded", name)
+		}
+	}
+
+	p.aggregated = enabled
+	p.aggregatedContexts = nil
+	if enabled {
+		p.aggregatedContexts = append([]string(nil), contexts...)
+	}
+	return nil
+}
+
+// IsAggregated returns true if list screens merge all loaded contexts
+func (p *RepositoryPool) IsAggregated() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.aggregated
+}
+
+// GetAggregatedContexts returns the loaded contexts merged in aggregated
+// mode, sorted by name
+func (p *RepositoryPool) GetAggregatedContexts() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.aggregatedContextsLocked()
+}
+
+// aggregatedContextsLocked returns the merged contexts (must be called with lock held)
+func (p *RepositoryPool) aggregatedContextsLocked() []string {
+	names := make([]string, 0, len(p.repos))
+	for name, entry := range p.repos {
+		if entry.Status != StatusLoaded {
+			continue
+		}
+		if len(p.aggregatedContexts) > 0 && !slices.Contains(p.aggregatedContexts, name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetRepository returns the repository of a loaded context (nil if the
+// context is not loaded)
+func (p *RepositoryPool) GetRepository(contextName string) Repository {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if entry, ok := p.repos[contextName]; ok && entry.Status == StatusLoaded {
+		return entry.Repo
+	}
+	return nil
+}
+
+// aggregatedRepositories returns the merged repositories by context name
+func (p *RepositoryPool) aggregatedRepositories() ([]string, map[string]Repository) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	names := p.aggregatedContextsLocked()
+	repos := make(map[string]Repository, len(names))
+	for _, name := range names {
+		repos[name] = p.repos[name].Repo
+	}
+	return names, repos
+}
+
+// getAggregatedResources lists resources of every merged context, tags each
+// with its context and sorts the result like a single repository (newest
+// first). Contexts that fail are skipped; an error is returned only when
+// all of them fail.
+func (p *RepositoryPool) getAggregatedResources(list func(Repository) ([]any, error)) ([]any, error) {
+	names, repos := p.aggregatedRepositories()
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no loaded contexts")
+	}
+
+	results := make([][]any, len(names))
+	errs := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			results[i], errs[i] = list(repos[name])
+		}(i, name)
+	}
+	wg.Wait()
+
+	var resources []Resource
+	var others []any
+	var firstErr error
+	failed := 0
+	for i, name := range names {
+		if errs[i] != nil {
+			logging.Warn("Skipping context in aggregated list", "context", name, "error", errs[i])
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%s: %w", name, errs[i])
+			}
+			failed++
+			continue
+		}
+		for _, item := range results[i] {
+			tagged := withContext(item, name)
+			if resource, ok := tagged.(Resource); ok {
+				resources = append(resources, resource)
+			} else {
+				others = append(others, tagged)
+			}
+		}
+	}
+	if failed == len(names) {
+		return nil, firstErr
+	}
+
+	// Contexts are merged in name order, so equal timestamps keep that order
+	sortByAge(resources)
+
+	items := make([]any, 0, len(resources)+len(others))
+	for _, resource := range resources {
+		items = append(items, resource)
+	}
+	return append(items, others...), nil
+}
+
+// ensureAggregatedInformers starts an informer in every merged context
+// (in parallel, informer syncs can take seconds per cluster)
+func (p *RepositoryPool) ensureAggregatedInformers(ensure func(Repository) error) error {
+	names, repos := p.aggregatedRepositories()
+
+	errs := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			if err := ensure(repos[name]); err != nil {
+				errs[i] = fmt.Errorf("%s: %w", name, err)
+			}
+		}(i, name)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// withContext returns a copy of a resource with ResourceMetadata.Context set
+// (resources without the field are returned unchanged)
+func withContext(item any, contextName string) any {
+	v := reflect.ValueOf(item)
+	if v.Kind() != reflect.Struct {
+		return item
+	}
+
+	tagged := reflect.New(v.Type()).Elem()
+	tagged.Set(v)
+	field := tagged.FieldByName("Context")
+	if !field.IsValid() || !field.CanSet() || field.Kind() != reflect.String {
+		return item
+	}
+	field.SetString(contextName)
+	return tagged.Interface()
+}
+
 // Close closes all repositories in the pool
 func (p *RepositoryPool) Close() {
 	p.mu.Lock()
@@ -333,6 +544,8 @@ func (p *RepositoryPool) Close() {
 	p.repos = make(map[string]*RepositoryEntry)
 	p.lru = list.New()
 	p.active = ""
+	p.aggregated = false
+	p.aggregatedContexts = nil
 }
 
 // Repository interface delegation methods (delegate to active repository)
@@ -352,6 +565,12 @@ func (p *RepositoryPool) GetResources(resourceType ResourceType) ([]any, error)
 		return result, nil
 	}
 
+	if p.IsAggregated() {
+		return p.getAggregatedResources(func(repo Repository) ([]any, error) {
+			return repo.GetResources(resourceType)
+		})
+	}
+
 	// All other resources delegate to active repository
 	repo := p.GetActiveRepository()
 	if repo == nil {
@@ -477,6 +696,114 @@ func (p *RepositoryPool) GetPodsUsingSecret(namespace, name string) ([]Pod, erro
 	return repo.GetPodsUsingSecret(namespace, name)
 }
 
+// GetNodeDetail delegates to active repository
+func (p *RepositoryPool) GetNodeDetail(name string) (*NodeDetail, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetNodeDetail(name)
+}
+
+// GetOwnershipTree delegates to active repository
+func (p *RepositoryPool) GetOwnershipTree(gvr schema.GroupVersionResource, namespace, name string) (*OwnershipNode, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetOwnershipTree(gvr, namespace, name)
+}
+
+// GetTopLevelOwner delegates to active repository
+func (p *RepositoryPool) GetTopLevelOwner(gvr schema.GroupVersionResource, namespace, name string) (ResourceRef, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return ResourceRef{}, fmt.Errorf("no active repository")
+	}
+	return repo.GetTopLevelOwner(gvr, namespace, name)
+}
+
+// GetBindingsForSubject delegates to active repository
+func (p *RepositoryPool) GetBindingsForSubject(kind, namespace, name string) ([]RoleBinding, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetBindingsForSubject(kind, namespace, name)
+}
+
+// GetRulesForRole delegates to active repository
+func (p *RepositoryPool) GetRulesForRole(kind, namespace, name string) ([]PolicyRule, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetRulesForRole(kind, namespace, name)
+}
+
+// GetSubjectsWhoCan delegates to active repository
+func (p *RepositoryPool) GetSubjectsWhoCan(verb, resource, namespace string) ([]RBACGrant, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetSubjectsWhoCan(verb, resource, namespace)
+}
+
+// GetSubjectPermissions delegates to active repository
+func (p *RepositoryPool) GetSubjectPermissions(kind, namespace, name string) ([]RBACGrant, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetSubjectPermissions(kind, namespace, name)
+}
+
+// GetObjectHistory delegates to active repository
+func (p *RepositoryPool) GetObjectHistory(gvr schema.GroupVersionResource, namespace, name string) (*ObjectHistory, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetObjectHistory(gvr, namespace, name)
+}
+
+// GetSecretData delegates to active repository
+func (p *RepositoryPool) GetSecretData(namespace, name string) (map[string][]byte, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetSecretData(namespace, name)
+}
+
+// GetConfigMapData delegates to active repository
+func (p *RepositoryPool) GetConfigMapData(namespace, name string) (*ConfigMapData, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetConfigMapData(namespace, name)
+}
+
+// PatchConfigMap delegates to active repository
+func (p *RepositoryPool) PatchConfigMap(namespace, name string, patch []byte) error {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return fmt.Errorf("no active repository")
+	}
+	return repo.PatchConfigMap(namespace, name, patch)
+}
+
+// GetPreviousContainerLogs delegates to active repository
+func (p *RepositoryPool) GetPreviousContainerLogs(namespace, podName, container string, tailLines int64) (*PreviousContainerLogs, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetPreviousContainerLogs(namespace, podName, container, tailLines)
+}
+
 // GetPodsForReplicaSet delegates to active repository
 func (p *RepositoryPool) GetPodsForReplicaSet(namespace, name string) ([]Pod, error) {
 	repo := p.GetActiveRepository()
@@ -504,6 +831,33 @@ func (p *RepositoryPool) GetPodsForPVC(namespace, name string) ([]Pod, error) {
 	return repo.GetPodsForPVC(namespace, name)
 }
 
+// DiagnosePod delegates to active repository
+func (p *RepositoryPool) DiagnosePod(namespace, name string) (*PodDiagnosis, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.DiagnosePod(namespace, name)
+}
+
+// GetClusterPulse delegates to active repository
+func (p *RepositoryPool) GetClusterPulse() (*ClusterPulse, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetClusterPulse()
+}
+
+// GetContainersForPod delegates to active repository
+func (p *RepositoryPool) GetContainersForPod(namespace, name string) ([]Container, error) {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return nil, fmt.Errorf("no active repository")
+	}
+	return repo.GetContainersForPod(namespace, name)
+}
+
 // GetResourceYAML delegates to active repository
 func (p *RepositoryPool) GetResourceYAML(gvr schema.GroupVersionResource, namespace, name string) (string, error) {
 	repo := p.GetActiveRepository()
@@ -541,6 +895,16 @@ func (p *RepositoryPool) GetResourceStats() []ResourceStats {
 	return repo.GetResourceStats()
 }
 
+// CheckAccess delegates to active repository (no repository = allowed, the
+// API server decides)
+func (p *RepositoryPool) CheckAccess(verb string, gvr schema.GroupVersionResource, subresource, namespace string) AccessDecision {
+	repo := p.GetActiveRepository()
+	if repo == nil {
+		return AccessDecision{Allowed: true}
+	}
+	return repo.CheckAccess(verb, gvr, subresource, namespace)
+}
+
 // EnsureCRInformer delegates to active repository
 func (p *RepositoryPool) IsInformerSynced(gvr schema.GroupVersionResource) bool {
 	repo := p.GetActiveRepository()
@@ -575,6 +939,12 @@ func (p *RepositoryPool) GetDynamicInformerSyncError(gvr schema.GroupVersionReso
 }
 
 func (p *RepositoryPool) EnsureCRInformer(gvr schema.GroupVersionResource) error {
+	if p.IsAggregated() {
+		return p.ensureAggregatedInformers(func(repo Repository) error {
+			return repo.EnsureCRInformer(gvr)
+		})
+	}
+
 	repo := p.GetActiveRepository()
 	if repo == nil {
 		return fmt.Errorf("no active repository")
@@ -583,6 +953,12 @@ func (p *RepositoryPool) EnsureCRInformer(gvr schema.GroupVersionResource) error
 }
 
 func (p *RepositoryPool) EnsureResourceTypeInformer(resourceType ResourceType) error {
+	if p.IsAggregated() {
+		return p.ensureAggregatedInformers(func(repo Repository) error {
+			return repo.EnsureResourceTypeInformer(resourceType)
+		})
+	}
+
 	repo := p.GetActiveRepository()
 	if repo == nil {
 		return fmt.Errorf("no active repository")
@@ -592,6 +968,12 @@ func (p *RepositoryPool) EnsureResourceTypeInformer(resourceType ResourceType) e
 
 // GetResourcesByGVR delegates to active repository
 func (p *RepositoryPool) GetResourcesByGVR(gvr schema.GroupVersionResource, transform TransformFunc) ([]any, error) {
+	if p.IsAggregated() {
+		return p.getAggregatedResources(func(repo Repository) ([]any, error) {
+			return repo.GetResourcesByGVR(gvr, transform)
+		})
+	}
+
 	repo := p.GetActiveRepository()
 	if repo == nil {
 		return nil, fmt.Errorf("no active repository")
@@ -416,3 +416,64 @@ func TestRepositoryPool_DescribeResource(t *testing.T) {
 	// Error is expected (pod doesn't exist), but method should work
 	assert.Error(t, err) // Pod not found is fine
 }
+
+// TestRepositoryPool_Aggregated tests merging resources of all loaded contexts
+func TestRepositoryPool_Aggregated(t *testing.T) {
+	pool, err := NewRepositoryPoolFromRepos(map[string]Repository{
+		"eu": NewDummyRepository(),
+		"us": NewDummyRepository(),
+	})
+	require.NoError(t, err)
+
+	// Not aggregated: active context only, no context tags
+	pods, err := pool.GetResources(ResourceTypePod)
+	require.NoError(t, err)
+	require.Len(t, pods, 4)
+	assert.Empty(t, pods[0].(Pod).Context)
+
+	require.NoError(t, pool.SetAggregated(true, nil))
+	assert.True(t, pool.IsAggregated())
+	assert.Equal(t, []string{"eu", "us"}, pool.GetAggregatedContexts())
+
+	pods, err = pool.GetResources(ResourceTypePod)
+	require.NoError(t, err)
+	require.Len(t, pods, 8)
+	counts := map[string]int{}
+	for _, item := range pods {
+		counts[item.(Pod).Context]++
+	}
+	assert.Equal(t, map[string]int{"eu": 4, "us": 4}, counts)
+
+	// Same pod in both contexts: merged in context name order
+	assert.Equal(t, pods[0].(Pod).Name, pods[1].(Pod).Name)
+	assert.Equal(t, "eu", pods[0].(Pod).Context)
+	assert.Equal(t, "us", pods[1].(Pod).Context)
+
+	// Restricted to some contexts
+	require.NoError(t, pool.SetAggregated(true, []string{"us"}))
+	pods, err = pool.GetResources(ResourceTypePod)
+	require.NoError(t, err)
+	require.Len(t, pods, 4)
+	assert.Equal(t, "us", pods[0].(Pod).Context)
+
+	assert.Error(t, pool.SetAggregated(true, []string{"missing"}))
+
+	require.NoError(t, pool.SetAggregated(false, nil))
+	assert.False(t, pool.IsAggregated())
+
+	assert.NotNil(t, pool.GetRepository("us"))
+	assert.Nil(t, pool.GetRepository("missing"))
+}
+
+func TestWithContext(t *testing.T) {
+	pod := Pod{ResourceMetadata: ResourceMetadata{Namespace: "web", Name: "nginx"}}
+
+	tagged := withContext(pod, "eu")
+	assert.Equal(t, "eu", tagged.(Pod).Context)
+	assert.Empty(t, pod.Context, "original must not be modified")
+
+	// Items without the field are returned unchanged
+	ctx := Context{Name: "eu"}
+	assert.Equal(t, ctx, withContext(ctx, "us"))
+	assert.Equal(t, "x", withContext("x", "us"))
+}
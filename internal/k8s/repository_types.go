@@ -18,6 +18,7 @@ type ResourceMetadata struct {
 	Name      string
 	Age       time.Duration
 	CreatedAt time.Time
+	Context   string // Source context (set in aggregated multi-context lists only)
 }
 
 // ResourceMetadata implements Resource interface
@@ -36,6 +37,23 @@ type Pod struct {
 	IP       string
 }
 
+// Container represents a container of a pod (init, sidecar, regular or
+// ephemeral). Containers are listed per pod, not cached on their own.
+type Container struct {
+	Namespace       string
+	Pod             string
+	Name            string
+	Type            string // init, sidecar, app or ephemeral
+	Image           string
+	Ready           string // true/false
+	State           string // Running, Waiting: <reason>, Terminated: <reason>
+	Restarts        int32
+	LastTermination string // Reason (exit code) of the previous instance
+	Ports           string // 8080/TCP,9090/TCP
+	Requests        string // cpu/memory, e.g. 100m/128Mi
+	Limits          string // cpu/memory, e.g. 500m/256Mi
+}
+
 // Deployment represents a Kubernetes deployment
 type Deployment struct {
 	ResourceMetadata
@@ -138,6 +156,39 @@ type PersistentVolumeClaim struct {
 	StorageClass string
 }
 
+// PersistentVolume represents a Kubernetes persistent volume
+type PersistentVolume struct {
+	ResourceMetadata
+	Capacity      string // "10Gi"
+	AccessModes   string
+	ReclaimPolicy string // Retain, Delete, Recycle
+	Status        string // Available, Bound, Released, Failed
+	Claim         string // "namespace/name" of the bound PVC
+	StorageClass  string
+	Driver        string // CSI driver, or the in-tree volume type ("hostPath", "nfs")
+}
+
+// StorageClass represents a Kubernetes storage class
+type StorageClass struct {
+	ResourceMetadata
+	Provisioner       string // CSI driver or in-tree provisioner
+	ReclaimPolicy     string
+	VolumeBindingMode string // Immediate, WaitForFirstConsumer
+	AllowExpansion    bool
+	Default           bool
+}
+
+// VolumeAttachment represents a Kubernetes volume attachment (CSI volume
+// attached to a node)
+type VolumeAttachment struct {
+	ResourceMetadata
+	Attacher         string // CSI driver
+	PersistentVolume string
+	Node             string
+	Attached         bool
+	Error            string // Attach or detach error message
+}
+
 // Ingress represents a Kubernetes ingress
 type Ingress struct {
 	ResourceMetadata
@@ -163,6 +214,31 @@ type HorizontalPodAutoscaler struct {
 	TargetCPU string // "80%" or "N/A"
 }
 
+// ServiceAccount represents a Kubernetes service account
+type ServiceAccount struct {
+	ResourceMetadata
+	Secrets          int
+	ImagePullSecrets int
+	Automount        string // "true", "false" or "" (default, mounted)
+}
+
+// Role represents a Kubernetes Role or ClusterRole (no namespace)
+type Role struct {
+	ResourceMetadata
+	Kind       string // Role or ClusterRole
+	Rules      int
+	Aggregated bool // ClusterRole rules aggregated from other ClusterRoles
+}
+
+// RoleBinding represents a Kubernetes RoleBinding or ClusterRoleBinding (no
+// namespace)
+type RoleBinding struct {
+	ResourceMetadata
+	Kind     string // RoleBinding or ClusterRoleBinding
+	Role     string // "ClusterRole/view"
+	Subjects string // "ServiceAccount web/api, Group dev" (comma-separated)
+}
+
 // CRDColumn represents a column defined in CRD additionalPrinterColumns
 type CRDColumn struct {
 	Name        string // Column name (e.g., "Ready", "Status")
@@ -0,0 +1,63 @@
+package k8s
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// diffNoiseMetadataFields are server-populated metadata fields that differ
+// between any two objects and hide the real differences in a diff
+var diffNoiseMetadataFields = []string{
+	"managedFields",
+	"uid",
+	"resourceVersion",
+	"generation",
+	"creationTimestamp",
+	"selfLink",
+}
+
+// diffNoiseAnnotations are annotations written by tooling rather than users
+var diffNoiseAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"deployment.kubernetes.io/revision",
+}
+
+// CleanManifestYAML strips status and server-populated noise (managedFields,
+// uid, resourceVersion, ...) from a resource YAML so two objects can be
+// compared. With specOnly, apiVersion, kind and metadata are dropped too,
+// leaving the desired state (spec, or data for ConfigMaps and Secrets).
+func CleanManifestYAML(manifest string, specOnly bool) (string, error) {
+	var obj map[string]any
+	if err := yaml.Unmarshal([]byte(manifest), &obj); err != nil {
+		return "", fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if obj == nil {
+		return "", nil
+	}
+
+	delete(obj, "status")
+	if specOnly {
+		delete(obj, "apiVersion")
+		delete(obj, "kind")
+		delete(obj, "metadata")
+	} else if metadata, ok := obj["metadata"].(map[string]any); ok {
+		for _, field := range diffNoiseMetadataFields {
+			delete(metadata, field)
+		}
+		if annotations, ok := metadata["annotations"].(map[string]any); ok {
+			for _, annotation := range diffNoiseAnnotations {
+				delete(annotations, annotation)
+			}
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+
+	cleaned, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("failed to print YAML: %w", err)
+	}
+	return string(cleaned), nil
+}
@@ -0,0 +1,57 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanManifestYAML(t *testing.T) {
+	manifest := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    deployment.kubernetes.io/revision: "4"
+    kubectl.kubernetes.io/last-applied-configuration: '{}'
+  creationTimestamp: "2025-01-01T00:00:00Z"
+  generation: 4
+  labels:
+    app: web
+  managedFields:
+  - manager: kubectl
+  name: web
+  namespace: prod
+  resourceVersion: "12345"
+  uid: 0b1c2d3e
+spec:
+  replicas: 3
+status:
+  availableReplicas: 3
+`
+
+	cleaned, err := CleanManifestYAML(manifest, false)
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    app: web
+  name: web
+  namespace: prod
+spec:
+  replicas: 3
+`, cleaned)
+
+	specOnly, err := CleanManifestYAML(manifest, true)
+	require.NoError(t, err)
+	assert.Equal(t, "spec:\n  replicas: 3\n", specOnly)
+
+	// ConfigMaps keep their data in spec-only mode
+	specOnly, err = CleanManifestYAML("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\ndata:\n  key: value\n", true)
+	require.NoError(t, err)
+	assert.Equal(t, "data:\n  key: value\n", specOnly)
+
+	_, err = CleanManifestYAML("not: [valid", false)
+	assert.Error(t, err)
+}
@@ -18,30 +18,40 @@ import (
 
 // GetResourceYAML returns YAML representation of a resource using kubectl YAMLPrinter
 func (r *InformerRepository) GetResourceYAML(gvr schema.GroupVersionResource, namespace, name string) (string, error) {
-	// Get resource from dynamic informer cache
+	r.mu.RLock()
 	lister, ok := r.dynamicListers[gvr]
-	if !ok {
-		return "", fmt.Errorf("informer not initialized for resource %s", gvr)
-	}
-
-	var runtimeObj any
-	var err error
-
-	// Handle namespaced vs cluster-scoped resources
-	if namespace != "" {
-		runtimeObj, err = lister.ByNamespace(namespace).Get(name)
+	r.mu.RUnlock()
+
+	var obj *unstructured.Unstructured
+	if r.isMetadataOnly(gvr) || !ok {
+		// Cache only holds a projection, or the informer is still syncing
+		// (listers are registered once the cache syncs) - fetch directly
+		fetched, err := r.fetchObject(gvr, namespace, name)
+		if err != nil {
+			return "", err
+		}
+		obj = fetched
 	} else {
-		runtimeObj, err = lister.Get(name)
-	}
+		// Get resource from dynamic informer cache
+		var runtimeObj any
+		var err error
 
-	if err != nil {
-		return "", fmt.Errorf("resource not found: %w", err)
-	}
+		// Handle namespaced vs cluster-scoped resources
+		if namespace != "" {
+			runtimeObj, err = lister.ByNamespace(namespace).Get(name)
+		} else {
+			runtimeObj, err = lister.Get(name)
+		}
 
-	// Type assert to unstructured
-	obj, ok := runtimeObj.(*unstructured.Unstructured)
-	if !ok {
-		return "", fmt.Errorf("unexpected object type: %T", runtimeObj)
+		if err != nil {
+			return "", fmt.Errorf("resource not found: %w", err)
+		}
+
+		// Type assert to unstructured
+		obj, ok = runtimeObj.(*unstructured.Unstructured)
+		if !ok {
+			return "", fmt.Errorf("unexpected object type: %T", runtimeObj)
+		}
 	}
 
 	// Use kubectl YAML printer for exact kubectl output match
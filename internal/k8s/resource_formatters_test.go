@@ -10,7 +10,11 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
 )
 
 // TestInformerRepository_GetResourceYAML tests YAML generation using kubectl YAMLPrinter
@@ -155,6 +159,32 @@ func TestInformerRepository_GetResourceYAML_NotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "not found")
 }
 
+// TestInformerRepository_GetResourceYAML_NotSynced tests the direct fetch used
+// while an on-demand informer has not registered its lister yet
+func TestInformerRepository_GetResourceYAML_NotSynced(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	deployment := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "api", "namespace": "web"},
+		"spec":       map[string]any{"replicas": int64(3)},
+	}}
+	repo := &InformerRepository{
+		ctx: context.Background(),
+		dynamicClient: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{gvr: "DeploymentList"}, deployment),
+		dynamicListers: map[schema.GroupVersionResource]cache.GenericLister{},
+	}
+
+	yaml, err := repo.GetResourceYAML(gvr, "web", "api")
+	require.NoError(t, err)
+	assert.Contains(t, yaml, "name: api")
+	assert.Contains(t, yaml, "replicas: 3")
+
+	_, err = repo.GetResourceYAML(gvr, "web", "missing")
+	assert.ErrorContains(t, err, "not found")
+}
+
 // TestInformerRepository_DescribeResource tests describe output with on-demand events
 func TestInformerRepository_DescribeResource(t *testing.T) {
 	ns := createTestNamespace(t)
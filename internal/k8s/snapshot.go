@@ -0,0 +1,265 @@
+package k8s
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/renato0307/k1/internal/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+)
+
+// SnapshotVersion is the snapshot file format version
+const SnapshotVersion = 1
+
+// eventGVR is captured with the informer resources (describe and the pulse
+// list events on demand)
+var eventGVR = schema.GroupVersionResource{Group: "", Version: "v1", Resource: "events"}
+
+// Snapshot is the state of a context captured for offline browsing
+type Snapshot struct {
+	Version    int                `json:"version"`
+	Context    string             `json:"context"`
+	CapturedAt time.Time          `json:"capturedAt"`
+	Resources  []SnapshotResource `json:"resources"`
+}
+
+// SnapshotResource holds the captured objects of one resource type
+type SnapshotResource struct {
+	Group      string                      `json:"group"`
+	Version    string                      `json:"version"`
+	Resource   string                      `json:"resource"`
+	Kind       string                      `json:"kind"`
+	Namespaced bool                        `json:"namespaced"`
+	Error      string                      `json:"error,omitempty"` // Why the objects could not be listed
+	Items      []unstructured.Unstructured `json:"items"`
+}
+
+// GVR returns the GroupVersionResource of the captured resource type
+func (r SnapshotResource) GVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: r.Group, Version: r.Version, Resource: r.Resource}
+}
+
+// CaptureSnapshot lists every resource type k1 caches (full objects, also
+// for metadata-only informers), events, CRDs and the instances of every CRD.
+// Secret values are never captured, only their keys. Resource types that
+// cannot be listed (e.g. RBAC) are recorded with their error.
+func CaptureSnapshot(ctx context.Context, kubeconfig, contextName string, progress func(message string)) (*Snapshot, error) {
+	if progress == nil {
+		progress = func(string) {}
+	}
+
+	config, err := loadRestConfig(kubeconfig, contextName)
+	if err != nil {
+		return nil, err
+	}
+	config.QPS = 50
+	config.Burst = 100
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dynamic client: %w", err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating discovery client: %w", err)
+	}
+
+	snapshot := &Snapshot{
+		Version:    SnapshotVersion,
+		Context:    contextName,
+		CapturedAt: time.Now().UTC(),
+	}
+	capturer := &snapshotCapturer{
+		ctx:       ctx,
+		dynamic:   dynamicClient,
+		discovery: discoveryClient,
+		groups:    make(map[string]*metav1.APIResourceList),
+	}
+
+	// Informer resources (sorted for a stable file) and events
+	var gvrs []schema.GroupVersionResource
+	for _, cfg := range getResourceRegistry() {
+		gvrs = append(gvrs, cfg.GVR)
+	}
+	sort.Slice(gvrs, func(i, j int) bool { return gvrs[i].String() < gvrs[j].String() })
+	gvrs = append(gvrs, eventGVR)
+
+	for _, gvr := range gvrs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		resource := capturer.capture(gvr, "", false)
+		snapshot.Resources = append(snapshot.Resources, resource)
+		progress(resourceProgress(resource))
+	}
+
+	// Instances of every CRD (at the storage version, as the CR screens list them)
+	for _, resource := range snapshot.Resources {
+		if resource.Resource != "customresourcedefinitions" {
+			continue
+		}
+		for _, item := range resource.Items {
+			crd, err := transformCRD(&item, ResourceMetadata{})
+			if err != nil {
+				continue
+			}
+			def := crd.(CustomResourceDefinition)
+			if def.Version == "" || def.Plural == "" {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			gvr := schema.GroupVersionResource{Group: def.Group, Version: def.Version, Resource: def.Plural}
+			instances := capturer.capture(gvr, def.Kind, def.Scope == "Namespaced")
+			snapshot.Resources = append(snapshot.Resources, instances)
+			progress(resourceProgress(instances))
+		}
+	}
+
+	return snapshot, nil
+}
+
+func resourceProgress(resource SnapshotResource) string {
+	if resource.Error != "" {
+		return fmt.Sprintf("%s: skipped (%s)", resource.GVR().GroupResource(), resource.Error)
+	}
+	return fmt.Sprintf("%s: %d", resource.GVR().GroupResource(), len(resource.Items))
+}
+
+// snapshotCapturer lists resource types (discovery is cached per group version)
+type snapshotCapturer struct {
+	ctx       context.Context
+	dynamic   dynamic.Interface
+	discovery discovery.DiscoveryInterface
+	groups    map[string]*metav1.APIResourceList
+}
+
+// capture lists all objects of gvr. The kind and scope come from discovery
+// unless known (CRD instances).
+func (c *snapshotCapturer) capture(gvr schema.GroupVersionResource, kind string, namespaced bool) SnapshotResource {
+	resource := SnapshotResource{
+		Group:      gvr.Group,
+		Version:    gvr.Version,
+		Resource:   gvr.Resource,
+		Kind:       kind,
+		Namespaced: namespaced,
+		Items:      []unstructured.Unstructured{},
+	}
+
+	if kind == "" {
+		apiResource, err := c.discover(gvr)
+		if err != nil {
+			resource.Error = err.Error()
+			return resource
+		}
+		resource.Kind = apiResource.Kind
+		resource.Namespaced = apiResource.Namespaced
+	}
+
+	opts := metav1.ListOptions{Limit: SnapshotPageSize}
+	for {
+		listCtx, cancel := context.WithTimeout(c.ctx, SnapshotListTimeout)
+		list, err := c.dynamic.Resource(gvr).List(listCtx, opts)
+		cancel()
+		if err != nil {
+			logging.Warn("Snapshot list failed", "resource", gvr.Resource, "error", err)
+			resource.Error = err.Error()
+			resource.Items = []unstructured.Unstructured{}
+			return resource
+		}
+		for i := range list.Items {
+			sanitizeSnapshotObject(gvr, &list.Items[i])
+		}
+		resource.Items = append(resource.Items, list.Items...)
+		if list.GetContinue() == "" {
+			return resource
+		}
+		opts.Continue = list.GetContinue()
+	}
+}
+
+// discover returns the API resource of gvr
+func (c *snapshotCapturer) discover(gvr schema.GroupVersionResource) (metav1.APIResource, error) {
+	groupVersion := gvr.GroupVersion().String()
+	list, ok := c.groups[groupVersion]
+	if !ok {
+		var err error
+		list, err = c.discovery.ServerResourcesForGroupVersion(groupVersion)
+		if err != nil {
+			return metav1.APIResource{}, fmt.Errorf("failed to discover %s: %w", groupVersion, err)
+		}
+		c.groups[groupVersion] = list
+	}
+	for _, apiResource := range list.APIResources {
+		if apiResource.Name == gvr.Resource {
+			return apiResource, nil
+		}
+	}
+	return metav1.APIResource{}, fmt.Errorf("resource %s not served by %s", gvr.Resource, groupVersion)
+}
+
+// sanitizeSnapshotObject drops managed fields (noise) and secret values
+func sanitizeSnapshotObject(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) {
+	obj.SetManagedFields(nil)
+
+	if gvr.Group == "" && gvr.Resource == "secrets" {
+		if data, ok := obj.Object["data"].(map[string]any); ok {
+			for key := range data {
+				data[key] = ""
+			}
+		}
+		delete(obj.Object, "stringData")
+		if annotations := obj.GetAnnotations(); annotations != nil {
+			delete(annotations, lastAppliedAnnotation)
+			obj.SetAnnotations(annotations)
+		}
+	}
+}
+
+// WriteSnapshot writes a snapshot as gzip-compressed JSON
+func WriteSnapshot(w io.Writer, snapshot *Snapshot) error {
+	gz := gzip.NewWriter(w)
+	if err := json.NewEncoder(gz).Encode(snapshot); err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	return gz.Close()
+}
+
+// ReadSnapshot reads a snapshot written by WriteSnapshot
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("not a k1 snapshot: %w", err)
+	}
+	defer gz.Close()
+
+	var snapshot Snapshot
+	if err := json.NewDecoder(gz).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d (expected %d)", snapshot.Version, SnapshotVersion)
+	}
+	return &snapshot, nil
+}
+
+// LoadSnapshot reads a snapshot file
+func LoadSnapshot(path string) (*Snapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer file.Close()
+	return ReadSnapshot(file)
+}
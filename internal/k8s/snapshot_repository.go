@@ -0,0 +1,192 @@
+package k8s
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// snapshotReadVerbs are the only verbs a snapshot can answer
+var snapshotReadVerbs = []string{"get", "list", "watch"}
+
+// SnapshotRepository implements Repository on a captured snapshot (see
+// CaptureSnapshot). The informer repository runs unchanged on top of
+// in-memory clients seeded with the captured objects, so every screen, index
+// and describe works without cluster access. It is read-only.
+type SnapshotRepository struct {
+	*InformerRepository
+	snapshot *Snapshot
+}
+
+// NewSnapshotRepository opens a snapshot for browsing
+func NewSnapshotRepository(snapshot *Snapshot) (*SnapshotRepository, error) {
+	clientset := fake.NewSimpleClientset()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), snapshotListKinds(snapshot))
+
+	discovered := make(map[string]*metav1.APIResourceList)
+	for _, resource := range snapshot.Resources {
+		if resource.Kind == "" {
+			continue
+		}
+		gvr := resource.GVR()
+		gvk := gvr.GroupVersion().WithKind(resource.Kind)
+
+		groupVersion := gvr.GroupVersion().String()
+		if discovered[groupVersion] == nil {
+			discovered[groupVersion] = &metav1.APIResourceList{GroupVersion: groupVersion}
+		}
+		discovered[groupVersion].APIResources = append(discovered[groupVersion].APIResources, metav1.APIResource{
+			Name:       resource.Resource,
+			Kind:       resource.Kind,
+			Namespaced: resource.Namespaced,
+			Verbs:      snapshotReadVerbs,
+		})
+
+		for i := range resource.Items {
+			obj := resource.Items[i].DeepCopy()
+			obj.SetGroupVersionKind(gvk)
+			if err := dynamicClient.Tracker().Create(gvr, obj, obj.GetNamespace()); err != nil {
+				return nil, fmt.Errorf("failed to load %s %s: %w", gvr.Resource, obj.GetName(), err)
+			}
+
+			// Built-in kinds are also served by the typed client (typed
+			// informers, events, on-demand payloads)
+			if !scheme.Scheme.Recognizes(gvk) {
+				continue
+			}
+			typed, err := scheme.Scheme.New(gvk)
+			if err != nil {
+				continue
+			}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, typed); err != nil {
+				return nil, fmt.Errorf("failed to load %s %s: %w", gvr.Resource, obj.GetName(), err)
+			}
+			if err := clientset.Tracker().Create(gvr, typed, obj.GetNamespace()); err != nil {
+				return nil, fmt.Errorf("failed to load %s %s: %w", gvr.Resource, obj.GetName(), err)
+			}
+		}
+	}
+	for _, list := range discovered {
+		clientset.Resources = append(clientset.Resources, list)
+	}
+
+	// The in-memory tracker ignores field selectors, describe and the pulse
+	// rely on them to list events
+	clientset.PrependReactor("list", "events", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		listAction := action.(clienttesting.ListAction)
+		obj, err := clientset.Tracker().List(eventGVR, corev1.SchemeGroupVersion.WithKind("Event"), listAction.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+		events := obj.(*corev1.EventList)
+		selector := listAction.GetListRestrictions().Fields
+
+		filtered := &corev1.EventList{}
+		for _, event := range events.Items {
+			if selector.Matches(eventFields(&event)) {
+				filtered.Items = append(filtered.Items, event)
+			}
+		}
+		return true, filtered, nil
+	})
+
+	// Access reviews: reads only (resource types are never reported as forbidden)
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		review := action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		if attrs := review.Spec.ResourceAttributes; attrs != nil {
+			review.Status.Allowed = slices.Contains(snapshotReadVerbs, attrs.Verb)
+		}
+		if !review.Status.Allowed {
+			review.Status.Reason = "snapshots are read-only"
+		}
+		return true, review, nil
+	})
+
+	repo, err := newInformerRepositoryForClients(clientset, dynamicClient, "", snapshot.Context, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SnapshotRepository{InformerRepository: repo, snapshot: snapshot}, nil
+}
+
+// snapshotListKinds maps every resource the repository may list to its list
+// kind: captured resources, CRD instances and the built-in resource types
+// (the in-memory dynamic client cannot list unknown resources)
+func snapshotListKinds(snapshot *Snapshot) map[schema.GroupVersionResource]string {
+	listKinds := make(map[schema.GroupVersionResource]string)
+	for gvk := range scheme.Scheme.AllKnownTypes() {
+		if gvk.Version == runtime.APIVersionInternal || strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		plural, _ := meta.UnsafeGuessKindToResource(gvk)
+		listKinds[plural] = gvk.Kind + "List"
+	}
+	for _, resource := range snapshot.Resources {
+		if resource.Kind != "" {
+			listKinds[resource.GVR()] = resource.Kind + "List"
+		}
+	}
+	return listKinds
+}
+
+// eventFields are the event fields the API server supports in field selectors
+// (the subset k1 uses)
+func eventFields(event *corev1.Event) fields.Set {
+	return fields.Set{
+		"involvedObject.kind":      event.InvolvedObject.Kind,
+		"involvedObject.name":      event.InvolvedObject.Name,
+		"involvedObject.namespace": event.InvolvedObject.Namespace,
+		"involvedObject.uid":       string(event.InvolvedObject.UID),
+		"reason":                   event.Reason,
+		"type":                     event.Type,
+	}
+}
+
+// Snapshot returns the snapshot being browsed
+func (r *SnapshotRepository) Snapshot() *Snapshot {
+	return r.snapshot
+}
+
+// CheckAccess allows reads only: snapshots cannot be changed, and logs,
+// shells and port forwards need a live cluster
+func (r *SnapshotRepository) CheckAccess(verb string, gvr schema.GroupVersionResource, subresource, namespace string) AccessDecision {
+	if !slices.Contains(snapshotReadVerbs, verb) {
+		return AccessDecision{Reason: "snapshots are read-only"}
+	}
+	if subresource == "log" {
+		return AccessDecision{Reason: "logs are not captured in snapshots"}
+	}
+	return AccessDecision{Allowed: true}
+}
+
+// GetSecretData fails: secret values are never captured
+func (r *SnapshotRepository) GetSecretData(namespace, name string) (map[string][]byte, error) {
+	return nil, fmt.Errorf("secret values are not captured in snapshots")
+}
+
+// PatchConfigMap fails: snapshots are read-only
+func (r *SnapshotRepository) PatchConfigMap(namespace, name string, patch []byte) error {
+	return fmt.Errorf("snapshots are read-only")
+}
+
+// GetPreviousContainerLogs fails: logs are never captured
+func (r *SnapshotRepository) GetPreviousContainerLogs(namespace, podName, container string, tailLines int64) (*PreviousContainerLogs, error) {
+	return nil, fmt.Errorf("logs are not captured in snapshots")
+}
+
+// GetObjectHistory fails: a snapshot holds a single version of each object
+func (r *SnapshotRepository) GetObjectHistory(gvr schema.GroupVersionResource, namespace, name string) (*ObjectHistory, error) {
+	return nil, fmt.Errorf("snapshots hold a single version of each object")
+}
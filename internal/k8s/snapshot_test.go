@@ -0,0 +1,184 @@
+package k8s
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func snapshotObject(apiVersion, kind, namespace, name, uid string, fields map[string]any) unstructured.Unstructured {
+	metadata := map[string]any{
+		"name":              name,
+		"uid":               uid,
+		"creationTimestamp": "2026-01-02T03:04:05Z",
+	}
+	if namespace != "" {
+		metadata["namespace"] = namespace
+	}
+	obj := map[string]any{"apiVersion": apiVersion, "kind": kind, "metadata": metadata}
+	for key, value := range fields {
+		obj[key] = value
+	}
+	return unstructured.Unstructured{Object: obj}
+}
+
+func ownedBy(obj unstructured.Unstructured, kind, name, uid string) unstructured.Unstructured {
+	obj.Object["metadata"].(map[string]any)["ownerReferences"] = []any{
+		map[string]any{"apiVersion": "apps/v1", "kind": kind, "name": name, "uid": uid, "controller": true},
+	}
+	return obj
+}
+
+func newTestSnapshot() *Snapshot {
+	deployment := snapshotObject("apps/v1", "Deployment", "web", "api", "deploy-uid", map[string]any{
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"selector": map[string]any{"matchLabels": map[string]any{"app": "api"}},
+		},
+	})
+	replicaSet := ownedBy(snapshotObject("apps/v1", "ReplicaSet", "web", "api-7d9", "rs-uid", nil), "Deployment", "api", "deploy-uid")
+	pod := ownedBy(snapshotObject("v1", "Pod", "web", "api-7d9-abcde", "pod-uid", map[string]any{
+		"spec":   map[string]any{"nodeName": "node-1", "containers": []any{map[string]any{"name": "api", "image": "api:1"}}},
+		"status": map[string]any{"phase": "Running"},
+	}), "ReplicaSet", "api-7d9", "rs-uid")
+	event := snapshotObject("v1", "Event", "web", "api-7d9-abcde.1", "event-uid", map[string]any{
+		"involvedObject": map[string]any{"kind": "Pod", "namespace": "web", "name": "api-7d9-abcde", "uid": "pod-uid"},
+		"type":           "Warning",
+		"reason":         "BackOff",
+		"message":        "Back-off restarting failed container",
+		"lastTimestamp":  "2026-01-02T03:05:00Z",
+	})
+	otherEvent := snapshotObject("v1", "Event", "web", "db.1", "event-uid-2", map[string]any{
+		"involvedObject": map[string]any{"kind": "Pod", "namespace": "web", "name": "db", "uid": "db-uid"},
+		"type":           "Normal",
+		"reason":         "Pulled",
+	})
+	secret := snapshotObject("v1", "Secret", "web", "api-token", "secret-uid", map[string]any{
+		"type": "Opaque",
+		"data": map[string]any{"token": ""},
+	})
+	certificate := snapshotObject("cert-manager.io/v1", "Certificate", "web", "api-tls", "cert-uid", nil)
+
+	return &Snapshot{
+		Version:    SnapshotVersion,
+		Context:    "prod",
+		CapturedAt: time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC),
+		Resources: []SnapshotResource{
+			{Group: "apps", Version: "v1", Resource: "deployments", Kind: "Deployment", Namespaced: true, Items: []unstructured.Unstructured{deployment}},
+			{Group: "apps", Version: "v1", Resource: "replicasets", Kind: "ReplicaSet", Namespaced: true, Items: []unstructured.Unstructured{replicaSet}},
+			{Group: "", Version: "v1", Resource: "pods", Kind: "Pod", Namespaced: true, Items: []unstructured.Unstructured{pod}},
+			{Group: "", Version: "v1", Resource: "events", Kind: "Event", Namespaced: true, Items: []unstructured.Unstructured{event, otherEvent}},
+			{Group: "", Version: "v1", Resource: "secrets", Kind: "Secret", Namespaced: true, Items: []unstructured.Unstructured{secret}},
+			{Group: "", Version: "v1", Resource: "nodes", Error: "nodes is forbidden", Items: []unstructured.Unstructured{}},
+			{Group: "cert-manager.io", Version: "v1", Resource: "certificates", Kind: "Certificate", Namespaced: true, Items: []unstructured.Unstructured{certificate}},
+		},
+	}
+}
+
+func TestSnapshot_WriteReadRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteSnapshot(&buf, newTestSnapshot()))
+
+	snapshot, err := ReadSnapshot(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, "prod", snapshot.Context)
+	require.Len(t, snapshot.Resources, 7)
+
+	// Integers keep their type (transforms read them with NestedInt64)
+	replicas, found, err := unstructured.NestedInt64(snapshot.Resources[0].Items[0].Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, int64(2), replicas)
+	assert.Equal(t, "nodes is forbidden", snapshot.Resources[5].Error)
+}
+
+func TestReadSnapshot_Invalid(t *testing.T) {
+	_, err := ReadSnapshot(bytes.NewBufferString("not gzip"))
+	assert.Error(t, err)
+
+	var buf bytes.Buffer
+	snapshot := newTestSnapshot()
+	snapshot.Version = SnapshotVersion + 1
+	require.NoError(t, WriteSnapshot(&buf, snapshot))
+	_, err = ReadSnapshot(&buf)
+	assert.ErrorContains(t, err, "unsupported snapshot version")
+}
+
+func TestSanitizeSnapshotObject(t *testing.T) {
+	secret := snapshotObject("v1", "Secret", "web", "api-token", "secret-uid", map[string]any{
+		"data":       map[string]any{"token": "c2VjcmV0"},
+		"stringData": map[string]any{"password": "secret"},
+	})
+	secret.SetAnnotations(map[string]string{lastAppliedAnnotation: `{"data":{"token":"c2VjcmV0"}}`, "team": "api"})
+	secret.SetManagedFields(nil)
+
+	sanitizeSnapshotObject(schema.GroupVersionResource{Version: "v1", Resource: "secrets"}, &secret)
+
+	assert.Equal(t, map[string]any{"token": ""}, secret.Object["data"], "keys are kept, values dropped")
+	assert.NotContains(t, secret.Object, "stringData")
+	assert.Equal(t, map[string]string{"team": "api"}, secret.GetAnnotations())
+}
+
+func TestSnapshotRepository(t *testing.T) {
+	repo, err := NewSnapshotRepository(newTestSnapshot())
+	require.NoError(t, err)
+	defer repo.Close()
+
+	require.Eventually(t, repo.AreTypedInformersReady, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "prod", repo.GetContext())
+
+	pods, err := repo.GetPods()
+	require.NoError(t, err)
+	require.Len(t, pods, 1)
+	assert.Equal(t, "api-7d9-abcde", pods[0].Name)
+
+	// Navigation through the indexes (deployment → replicaset → pods)
+	require.Eventually(t, func() bool {
+		pods, err := repo.GetPodsForDeployment("web", "api")
+		return err == nil && len(pods) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Dynamic informers, CRD instances
+	deploymentGVR := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	require.Eventually(t, func() bool { return repo.IsInformerSynced(deploymentGVR) }, 5*time.Second, 10*time.Millisecond)
+	deployments, err := repo.GetResources(ResourceTypeDeployment)
+	require.NoError(t, err)
+	require.Len(t, deployments, 1)
+	assert.Equal(t, "0/2", deployments[0].(Deployment).Ready)
+
+	certificates := schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+	require.NoError(t, repo.EnsureCRInformer(certificates))
+	require.Eventually(t, func() bool { return repo.IsInformerSynced(certificates) }, 5*time.Second, 10*time.Millisecond)
+	crs, err := repo.GetResourcesByGVR(certificates, CreateGenericTransform("Certificate", nil))
+	require.NoError(t, err)
+	assert.Len(t, crs, 1)
+
+	// Owners are resolved through the captured API resources
+	owner, err := repo.GetTopLevelOwner(podGVR, "web", "api-7d9-abcde")
+	require.NoError(t, err)
+	assert.Equal(t, "api", owner.Name)
+
+	// Describe lists the events of the object only
+	describe, err := repo.DescribeResource(podGVR, "web", "api-7d9-abcde")
+	require.NoError(t, err)
+	assert.Contains(t, describe, "BackOff")
+	assert.NotContains(t, describe, "Pulled")
+
+	yaml, err := repo.GetResourceYAML(podGVR, "web", "api-7d9-abcde")
+	require.NoError(t, err)
+	assert.Contains(t, yaml, "nodeName: node-1")
+
+	// Read-only
+	assert.True(t, repo.CheckAccess("get", podGVR, "", "web").Allowed)
+	decision := repo.CheckAccess("delete", podGVR, "", "web")
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, "snapshots are read-only", decision.Reason)
+	assert.False(t, repo.CheckAccess("get", podGVR, "log", "web").Allowed)
+	_, err = repo.GetSecretData("web", "api-token")
+	assert.Error(t, err)
+}
@@ -192,9 +192,8 @@ func transformService(u *unstructured.Unstructured, common ResourceMetadata) (an
 // transformConfigMap converts an unstructured configmap to a typed ConfigMap
 func transformConfigMap(u *unstructured.Unstructured, common ResourceMetadata) (any, error) {
 
-	// Count data items
-	data, _, _ := unstructured.NestedMap(u.Object, "data")
-	dataCount := len(data)
+	// Count data items (projected count for metadata-only informers)
+	dataCount := dataKeyCount(u)
 
 	return ConfigMap{
 		ResourceMetadata: ResourceMetadata{
@@ -213,9 +212,8 @@ func transformSecret(u *unstructured.Unstructured, common ResourceMetadata) (any
 	// Extract type
 	secretType, _, _ := unstructured.NestedString(u.Object, "type")
 
-	// Count data items
-	data, _, _ := unstructured.NestedMap(u.Object, "data")
-	dataCount := len(data)
+	// Count data items (projected count for metadata-only informers)
+	dataCount := dataKeyCount(u)
 
 	return Secret{
 		ResourceMetadata: ResourceMetadata{
@@ -518,6 +516,121 @@ func transformPVC(u *unstructured.Unstructured, common ResourceMetadata) (any, e
 	}, nil
 }
 
+// inTreeVolumeSources are the PV sources of in-tree volume plugins, shown
+// as the driver of PVs that are not CSI volumes
+var inTreeVolumeSources = []string{
+	"hostPath", "local", "nfs", "iscsi", "fc", "rbd", "cephfs", "glusterfs",
+	"awsElasticBlockStore", "gcePersistentDisk", "azureDisk", "azureFile",
+	"cinder", "vsphereVolume", "portworxVolume",
+}
+
+// transformPV converts an unstructured PV to a typed PersistentVolume
+func transformPV(u *unstructured.Unstructured, common ResourceMetadata) (any, error) {
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+	capacity, _, _ := unstructured.NestedString(u.Object, "spec", "capacity", "storage")
+	accessModes, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "accessModes")
+	reclaimPolicy, _, _ := unstructured.NestedString(u.Object, "spec", "persistentVolumeReclaimPolicy")
+	storageClass, _, _ := unstructured.NestedString(u.Object, "spec", "storageClassName")
+
+	// Bound (or last bound) claim
+	claim := ""
+	claimName, _, _ := unstructured.NestedString(u.Object, "spec", "claimRef", "name")
+	if claimName != "" {
+		claimNamespace, _, _ := unstructured.NestedString(u.Object, "spec", "claimRef", "namespace")
+		claim = claimNamespace + "/" + claimName
+	}
+
+	driver, _, _ := unstructured.NestedString(u.Object, "spec", "csi", "driver")
+	if driver == "" {
+		for _, source := range inTreeVolumeSources {
+			if _, found, _ := unstructured.NestedMap(u.Object, "spec", source); found {
+				driver = source
+				break
+			}
+		}
+	}
+
+	return PersistentVolume{
+		ResourceMetadata: ResourceMetadata{
+			Namespace: common.Namespace,
+			Name:      common.Name,
+			Age:       common.Age,
+			CreatedAt: common.CreatedAt,
+		},
+		Capacity:      capacity,
+		AccessModes:   strings.Join(accessModes, ","),
+		ReclaimPolicy: reclaimPolicy,
+		Status:        phase,
+		Claim:         claim,
+		StorageClass:  storageClass,
+		Driver:        driver,
+	}, nil
+}
+
+// transformStorageClass converts an unstructured storage class to a typed
+// StorageClass
+func transformStorageClass(u *unstructured.Unstructured, common ResourceMetadata) (any, error) {
+	provisioner, _, _ := unstructured.NestedString(u.Object, "provisioner")
+	allowExpansion, _, _ := unstructured.NestedBool(u.Object, "allowVolumeExpansion")
+
+	// Unset fields take the API server defaults
+	reclaimPolicy, _, _ := unstructured.NestedString(u.Object, "reclaimPolicy")
+	if reclaimPolicy == "" {
+		reclaimPolicy = "Delete"
+	}
+	bindingMode, _, _ := unstructured.NestedString(u.Object, "volumeBindingMode")
+	if bindingMode == "" {
+		bindingMode = "Immediate"
+	}
+
+	annotations := u.GetAnnotations()
+	isDefault := annotations["storageclass.kubernetes.io/is-default-class"] == "true" ||
+		annotations["storageclass.beta.kubernetes.io/is-default-class"] == "true"
+
+	return StorageClass{
+		ResourceMetadata: ResourceMetadata{
+			Namespace: common.Namespace,
+			Name:      common.Name,
+			Age:       common.Age,
+			CreatedAt: common.CreatedAt,
+		},
+		Provisioner:       provisioner,
+		ReclaimPolicy:     reclaimPolicy,
+		VolumeBindingMode: bindingMode,
+		AllowExpansion:    allowExpansion,
+		Default:           isDefault,
+	}, nil
+}
+
+// transformVolumeAttachment converts an unstructured volume attachment to a
+// typed VolumeAttachment
+func transformVolumeAttachment(u *unstructured.Unstructured, common ResourceMetadata) (any, error) {
+	attacher, _, _ := unstructured.NestedString(u.Object, "spec", "attacher")
+	pv, _, _ := unstructured.NestedString(u.Object, "spec", "source", "persistentVolumeName")
+	node, _, _ := unstructured.NestedString(u.Object, "spec", "nodeName")
+	attached, _, _ := unstructured.NestedBool(u.Object, "status", "attached")
+
+	// Attach errors while attaching, detach errors while detaching
+	attachError, _, _ := unstructured.NestedString(u.Object, "status", "attachError", "message")
+	if attachError == "" {
+		attachError, _, _ = unstructured.NestedString(u.Object, "status", "detachError", "message")
+	}
+
+	return VolumeAttachment{
+		ResourceMetadata: ResourceMetadata{
+			Namespace: common.Namespace,
+			Name:      common.Name,
+			Age:       common.Age,
+			CreatedAt: common.CreatedAt,
+		},
+		Attacher:         attacher,
+		PersistentVolume: pv,
+		Node:             node,
+		Attached:         attached,
+		Error:            attachError,
+	}, nil
+}
+
 // transformIngress converts an unstructured ingress to a typed Ingress
 func transformIngress(u *unstructured.Unstructured, common ResourceMetadata) (any, error) {
 	ingressClass, _, _ := unstructured.NestedString(u.Object, "spec", "ingressClassName")
@@ -661,6 +774,80 @@ func transformHPA(u *unstructured.Unstructured, common ResourceMetadata) (any, e
 	}, nil
 }
 
+// transformServiceAccount converts an unstructured service account to a typed ServiceAccount
+func transformServiceAccount(u *unstructured.Unstructured, common ResourceMetadata) (any, error) {
+	secrets, _, _ := unstructured.NestedSlice(u.Object, "secrets")
+	imagePullSecrets, _, _ := unstructured.NestedSlice(u.Object, "imagePullSecrets")
+
+	automount := ""
+	if value, found, _ := unstructured.NestedBool(u.Object, "automountServiceAccountToken"); found {
+		automount = fmt.Sprint(value)
+	}
+
+	return ServiceAccount{
+		ResourceMetadata: ResourceMetadata{
+			Namespace: common.Namespace,
+			Name:      common.Name,
+			Age:       common.Age,
+			CreatedAt: common.CreatedAt,
+		},
+		Secrets:          len(secrets),
+		ImagePullSecrets: len(imagePullSecrets),
+		Automount:        automount,
+	}, nil
+}
+
+// transformRole converts an unstructured Role or ClusterRole to a typed Role
+func transformRole(u *unstructured.Unstructured, common ResourceMetadata) (any, error) {
+	rules, _, _ := unstructured.NestedSlice(u.Object, "rules")
+	_, aggregated, _ := unstructured.NestedMap(u.Object, "aggregationRule")
+
+	return Role{
+		ResourceMetadata: ResourceMetadata{
+			Namespace: common.Namespace,
+			Name:      common.Name,
+			Age:       common.Age,
+			CreatedAt: common.CreatedAt,
+		},
+		Kind:       u.GetKind(),
+		Rules:      len(rules),
+		Aggregated: aggregated,
+	}, nil
+}
+
+// transformRoleBinding converts an unstructured RoleBinding or
+// ClusterRoleBinding to a typed RoleBinding
+func transformRoleBinding(u *unstructured.Unstructured, common ResourceMetadata) (any, error) {
+	roleKind, _, _ := unstructured.NestedString(u.Object, "roleRef", "kind")
+	roleName, _, _ := unstructured.NestedString(u.Object, "roleRef", "name")
+
+	// Extract subjects ("ServiceAccount namespace/name", "User name")
+	subjectList, _, _ := unstructured.NestedSlice(u.Object, "subjects")
+	subjects := make([]RBACSubject, 0, len(subjectList))
+	for _, subject := range subjectList {
+		subjectMap, ok := subject.(map[string]any)
+		if !ok {
+			continue
+		}
+		kind, _, _ := unstructured.NestedString(subjectMap, "kind")
+		namespace, _, _ := unstructured.NestedString(subjectMap, "namespace")
+		name, _, _ := unstructured.NestedString(subjectMap, "name")
+		subjects = append(subjects, newRBACSubject(kind, namespace, name, common.Namespace))
+	}
+
+	return RoleBinding{
+		ResourceMetadata: ResourceMetadata{
+			Namespace: common.Namespace,
+			Name:      common.Name,
+			Age:       common.Age,
+			CreatedAt: common.CreatedAt,
+		},
+		Kind:     u.GetKind(),
+		Role:     roleKind + "/" + roleName,
+		Subjects: formatSubjects(subjects),
+	}, nil
+}
+
 // transformCRD converts an unstructured CRD to a typed CustomResourceDefinition
 func transformCRD(u *unstructured.Unstructured, common ResourceMetadata) (any, error) {
 	// Extract CRD spec fields
@@ -755,6 +942,17 @@ func GetResourceConfig(resourceType ResourceType) (ResourceConfig, bool) {
 	return config, exists
 }
 
+// FindResourceTypeByGVR returns the built-in resource type with the given
+// group and resource (any version), false for custom resources
+func FindResourceTypeByGVR(gvr schema.GroupVersionResource) (ResourceType, bool) {
+	for resourceType, config := range getResourceRegistry() {
+		if config.GVR.Group == gvr.Group && config.GVR.Resource == gvr.Resource {
+			return resourceType, true
+		}
+	}
+	return "", false
+}
+
 func getResourceRegistry() map[ResourceType]ResourceConfig {
 	return map[ResourceType]ResourceConfig{
 		ResourceTypePod: {
@@ -796,10 +994,11 @@ func getResourceRegistry() map[ResourceType]ResourceConfig {
 				Version:  "v1",
 				Resource: "configmaps",
 			},
-			Name:       "ConfigMaps",
-			Namespaced: true,
-			Tier:       2,
-			Transform:  transformConfigMap,
+			Name:         "ConfigMaps",
+			Namespaced:   true,
+			Tier:         2,
+			Transform:    transformConfigMap,
+			MetadataOnly: true, // Payloads fetched on demand
 		},
 		ResourceTypeSecret: {
 			GVR: schema.GroupVersionResource{
@@ -807,10 +1006,11 @@ func getResourceRegistry() map[ResourceType]ResourceConfig {
 				Version:  "v1",
 				Resource: "secrets",
 			},
-			Name:       "Secrets",
-			Namespaced: true,
-			Tier:       2,
-			Transform:  transformSecret,
+			Name:         "Secrets",
+			Namespaced:   true,
+			Tier:         2,
+			Transform:    transformSecret,
+			MetadataOnly: true, // Payloads fetched on demand
 		},
 		ResourceTypeNamespace: {
 			GVR: schema.GroupVersionResource{
@@ -933,6 +1133,94 @@ func getResourceRegistry() map[ResourceType]ResourceConfig {
 			Tier:       1,
 			Transform:  transformHPA,
 		},
+		ResourceTypePersistentVolume: {
+			GVR: schema.GroupVersionResource{
+				Group:    "",
+				Version:  "v1",
+				Resource: "persistentvolumes",
+			},
+			Name:       "PersistentVolumes",
+			Namespaced: false, // Cluster-scoped
+			Tier:       2,
+			Transform:  transformPV,
+		},
+		ResourceTypeStorageClass: {
+			GVR: schema.GroupVersionResource{
+				Group:    "storage.k8s.io",
+				Version:  "v1",
+				Resource: "storageclasses",
+			},
+			Name:       "StorageClasses",
+			Namespaced: false, // Cluster-scoped
+			Tier:       2,
+			Transform:  transformStorageClass,
+		},
+		ResourceTypeVolumeAttachment: {
+			GVR: schema.GroupVersionResource{
+				Group:    "storage.k8s.io",
+				Version:  "v1",
+				Resource: "volumeattachments",
+			},
+			Name:       "VolumeAttachments",
+			Namespaced: false, // Cluster-scoped
+			Tier:       2,
+			Transform:  transformVolumeAttachment,
+		},
+		ResourceTypeServiceAccount: {
+			GVR: schema.GroupVersionResource{
+				Group:    "",
+				Version:  "v1",
+				Resource: "serviceaccounts",
+			},
+			Name:       "ServiceAccounts",
+			Namespaced: true,
+			Tier:       3,
+			Transform:  transformServiceAccount,
+		},
+		ResourceTypeRole: {
+			GVR: schema.GroupVersionResource{
+				Group:    "rbac.authorization.k8s.io",
+				Version:  "v1",
+				Resource: "roles",
+			},
+			Name:       "Roles",
+			Namespaced: true,
+			Tier:       3,
+			Transform:  transformRole,
+		},
+		ResourceTypeClusterRole: {
+			GVR: schema.GroupVersionResource{
+				Group:    "rbac.authorization.k8s.io",
+				Version:  "v1",
+				Resource: "clusterroles",
+			},
+			Name:       "ClusterRoles",
+			Namespaced: false, // Cluster-scoped
+			Tier:       3,
+			Transform:  transformRole,
+		},
+		ResourceTypeRoleBinding: {
+			GVR: schema.GroupVersionResource{
+				Group:    "rbac.authorization.k8s.io",
+				Version:  "v1",
+				Resource: "rolebindings",
+			},
+			Name:       "RoleBindings",
+			Namespaced: true,
+			Tier:       3,
+			Transform:  transformRoleBinding,
+		},
+		ResourceTypeClusterRoleBinding: {
+			GVR: schema.GroupVersionResource{
+				Group:    "rbac.authorization.k8s.io",
+				Version:  "v1",
+				Resource: "clusterrolebindings",
+			},
+			Name:       "ClusterRoleBindings",
+			Namespaced: false, // Cluster-scoped
+			Tier:       3,
+			Transform:  transformRoleBinding,
+		},
 		ResourceTypeCRD: {
 			GVR: schema.GroupVersionResource{
 				Group:    "apiextensions.k8s.io",
@@ -1179,6 +1179,226 @@ func TestTransformHPA(t *testing.T) {
 	}
 }
 
+func TestTransformServiceAccount(t *testing.T) {
+	now := time.Now()
+	u := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":              "deployer",
+				"namespace":         "default",
+				"creationTimestamp": metav1.NewTime(now).Format(time.RFC3339),
+			},
+			"secrets":                      []interface{}{map[string]interface{}{"name": "deployer-token"}},
+			"imagePullSecrets":             []interface{}{map[string]interface{}{"name": "registry"}, map[string]interface{}{"name": "mirror"}},
+			"automountServiceAccountToken": false,
+		},
+	}
+
+	common := extractMetadata(u)
+	result, err := transformServiceAccount(u, common)
+	require.NoError(t, err)
+
+	sa, ok := result.(ServiceAccount)
+	require.True(t, ok)
+	assert.Equal(t, "deployer", sa.Name)
+	assert.Equal(t, "default", sa.Namespace)
+	assert.Equal(t, 1, sa.Secrets)
+	assert.Equal(t, 2, sa.ImagePullSecrets)
+	assert.Equal(t, "false", sa.Automount)
+}
+
+func TestTransformRole(t *testing.T) {
+	now := time.Now()
+	u := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind": "ClusterRole",
+			"metadata": map[string]interface{}{
+				"name":              "monitoring",
+				"creationTimestamp": metav1.NewTime(now).Format(time.RFC3339),
+			},
+			"aggregationRule": map[string]interface{}{
+				"clusterRoleSelectors": []interface{}{map[string]interface{}{"matchLabels": map[string]interface{}{"rbac.example.com/aggregate-to-monitoring": "true"}}},
+			},
+			"rules": []interface{}{
+				map[string]interface{}{"apiGroups": []interface{}{""}, "resources": []interface{}{"pods"}, "verbs": []interface{}{"get"}},
+				map[string]interface{}{"nonResourceURLs": []interface{}{"/metrics"}, "verbs": []interface{}{"get"}},
+			},
+		},
+	}
+
+	common := extractMetadata(u)
+	result, err := transformRole(u, common)
+	require.NoError(t, err)
+
+	role, ok := result.(Role)
+	require.True(t, ok)
+	assert.Equal(t, "monitoring", role.Name)
+	assert.Empty(t, role.Namespace)
+	assert.Equal(t, "ClusterRole", role.Kind)
+	assert.Equal(t, 2, role.Rules)
+	assert.True(t, role.Aggregated)
+}
+
+func TestTransformRoleBinding(t *testing.T) {
+	now := time.Now()
+	u := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind": "RoleBinding",
+			"metadata": map[string]interface{}{
+				"name":              "deployer-edit",
+				"namespace":         "default",
+				"creationTimestamp": metav1.NewTime(now).Format(time.RFC3339),
+			},
+			"roleRef": map[string]interface{}{"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "edit"},
+			"subjects": []interface{}{
+				map[string]interface{}{"kind": "ServiceAccount", "name": "deployer"}, // Namespace defaults to the binding's
+				map[string]interface{}{"kind": "ServiceAccount", "namespace": "ci", "name": "runner"},
+				map[string]interface{}{"kind": "Group", "apiGroup": "rbac.authorization.k8s.io", "name": "developers"},
+			},
+		},
+	}
+
+	common := extractMetadata(u)
+	result, err := transformRoleBinding(u, common)
+	require.NoError(t, err)
+
+	binding, ok := result.(RoleBinding)
+	require.True(t, ok)
+	assert.Equal(t, "deployer-edit", binding.Name)
+	assert.Equal(t, "RoleBinding", binding.Kind)
+	assert.Equal(t, "ClusterRole/edit", binding.Role)
+	assert.Equal(t, "ServiceAccount default/deployer, ServiceAccount ci/runner, Group developers", binding.Subjects)
+}
+
+func TestTransformPV(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		spec       map[string]interface{}
+		wantClaim  string
+		wantDriver string
+	}{
+		{
+			name: "CSI volume bound to a claim",
+			spec: map[string]interface{}{
+				"csi":      map[string]interface{}{"driver": "ebs.csi.aws.com", "volumeHandle": "vol-123"},
+				"claimRef": map[string]interface{}{"namespace": "web", "name": "data"},
+			},
+			wantClaim:  "web/data",
+			wantDriver: "ebs.csi.aws.com",
+		},
+		{
+			name:       "in-tree volume without claim",
+			spec:       map[string]interface{}{"hostPath": map[string]interface{}{"path": "/mnt/data"}},
+			wantDriver: "hostPath",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := map[string]interface{}{
+				"capacity":                      map[string]interface{}{"storage": "10Gi"},
+				"accessModes":                   []interface{}{"ReadWriteOnce"},
+				"persistentVolumeReclaimPolicy": "Retain",
+				"storageClassName":              "gp3",
+			}
+			for k, v := range tt.spec {
+				spec[k] = v
+			}
+			u := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"name":              "pv-1",
+						"creationTimestamp": metav1.NewTime(now).Format(time.RFC3339),
+					},
+					"spec":   spec,
+					"status": map[string]interface{}{"phase": "Bound"},
+				},
+			}
+
+			common := extractMetadata(u)
+			result, err := transformPV(u, common)
+			require.NoError(t, err)
+
+			pv, ok := result.(PersistentVolume)
+			require.True(t, ok)
+			assert.Equal(t, "pv-1", pv.Name)
+			assert.Equal(t, "10Gi", pv.Capacity)
+			assert.Equal(t, "ReadWriteOnce", pv.AccessModes)
+			assert.Equal(t, "Retain", pv.ReclaimPolicy)
+			assert.Equal(t, "Bound", pv.Status)
+			assert.Equal(t, "gp3", pv.StorageClass)
+			assert.Equal(t, tt.wantClaim, pv.Claim)
+			assert.Equal(t, tt.wantDriver, pv.Driver)
+		})
+	}
+}
+
+func TestTransformStorageClass(t *testing.T) {
+	now := time.Now()
+	u := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":              "gp3",
+				"creationTimestamp": metav1.NewTime(now).Format(time.RFC3339),
+				"annotations": map[string]interface{}{
+					"storageclass.kubernetes.io/is-default-class": "true",
+				},
+			},
+			"provisioner":          "ebs.csi.aws.com",
+			"allowVolumeExpansion": true,
+			"volumeBindingMode":    "WaitForFirstConsumer",
+		},
+	}
+
+	common := extractMetadata(u)
+	result, err := transformStorageClass(u, common)
+	require.NoError(t, err)
+
+	sc, ok := result.(StorageClass)
+	require.True(t, ok)
+	assert.Equal(t, "gp3", sc.Name)
+	assert.Equal(t, "ebs.csi.aws.com", sc.Provisioner)
+	assert.Equal(t, "Delete", sc.ReclaimPolicy, "unset reclaim policy defaults to Delete")
+	assert.Equal(t, "WaitForFirstConsumer", sc.VolumeBindingMode)
+	assert.True(t, sc.AllowExpansion)
+	assert.True(t, sc.Default)
+}
+
+func TestTransformVolumeAttachment(t *testing.T) {
+	now := time.Now()
+	u := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":              "csi-abc123",
+				"creationTimestamp": metav1.NewTime(now).Format(time.RFC3339),
+			},
+			"spec": map[string]interface{}{
+				"attacher": "ebs.csi.aws.com",
+				"nodeName": "node-1",
+				"source":   map[string]interface{}{"persistentVolumeName": "pv-1"},
+			},
+			"status": map[string]interface{}{
+				"attached":    false,
+				"attachError": map[string]interface{}{"message": "volume is attached to another node"},
+			},
+		},
+	}
+
+	common := extractMetadata(u)
+	result, err := transformVolumeAttachment(u, common)
+	require.NoError(t, err)
+
+	va, ok := result.(VolumeAttachment)
+	require.True(t, ok)
+	assert.Equal(t, "csi-abc123", va.Name)
+	assert.Equal(t, "ebs.csi.aws.com", va.Attacher)
+	assert.Equal(t, "pv-1", va.PersistentVolume)
+	assert.Equal(t, "node-1", va.Node)
+	assert.False(t, va.Attached)
+	assert.Equal(t, "volume is attached to another node", va.Error)
+}
+
 func TestTransformCRD(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -0,0 +1,118 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// OpenAIProvider talks to an OpenAI-compatible chat completions API
+// (Ollama, llama.cpp server, vLLM, LM Studio, ...)
+type OpenAIProvider struct {
+	config Config
+	client *http.Client
+}
+
+// NewOpenAIProvider creates a provider for the configured endpoint and model
+func NewOpenAIProvider(config Config) (*OpenAIProvider, error) {
+	if config.Endpoint == "" {
+		return nil, fmt.Errorf("llm endpoint is required")
+	}
+	if config.Model == "" {
+		return nil, fmt.Errorf("llm model is required")
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+	config.Endpoint = strings.TrimSuffix(config.Endpoint, "/")
+
+	return &OpenAIProvider{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+// Name returns the model name
+func (p *OpenAIProvider) Name() string {
+	return p.config.Model
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model          string            `json:"model"`
+	Messages       []chatMessage     `json:"messages"`
+	Temperature    float64           `json:"temperature"`
+	Stream         bool              `json:"stream"`
+	ResponseFormat map[string]string `json:"response_format"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Translate sends the request to {endpoint}/chat/completions and parses the
+// JSON answer
+func (p *OpenAIProvider) Translate(ctx context.Context, req Request) (*Translation, error) {
+	body, err := json.Marshal(chatRequest{
+		Model: p.config.Model,
+		Messages: []chatMessage{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: buildUserPrompt(req)},
+		},
+		Temperature:    0,
+		ResponseFormat: map[string]string{"type": "json_object"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.Endpoint+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if p.config.APIKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+p.config.APIKey)
+	}
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("LLM request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read LLM response: %w", err)
+	}
+
+	var out chatResponse
+	if err := json.Unmarshal(data, &out); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("failed to decode LLM response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		message := strings.TrimSpace(string(data))
+		if out.Error != nil && out.Error.Message != "" {
+			message = out.Error.Message
+		}
+		return nil, fmt.Errorf("LLM endpoint returned %s: %s", resp.Status, truncate(message, 200))
+	}
+	if len(out.Choices) == 0 {
+		return nil, fmt.Errorf("LLM response has no choices")
+	}
+
+	return parseTranslation(req.Prompt, out.Choices[0].Message.Content)
+}
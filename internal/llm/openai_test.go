@@ -0,0 +1,91 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStubServer(t *testing.T, status int, content string, got *chatRequest) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		if got != nil {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(got))
+		}
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_, _ = w.Write([]byte(`{"error": {"message": "model not found"}}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{{"message": map[string]string{"role": "assistant", "content": content}}},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestOpenAIProvider_Translate(t *testing.T) {
+	var got chatRequest
+	server := newStubServer(t, http.StatusOK,
+		"```json\n{\"command\": \"kubectl logs nginx -n web\", \"explanation\": \"Shows logs of nginx\"}\n```", &got)
+
+	provider, err := NewOpenAIProvider(Config{Endpoint: server.URL + "/v1/", Model: "qwen2.5-coder", APIKey: "secret"})
+	require.NoError(t, err)
+
+	translation, err := provider.Translate(context.Background(), Request{
+		Prompt:    "show logs of this pod",
+		Screen:    "pods",
+		Context:   "kind-dev",
+		Namespace: "web",
+		Selected:  map[string]any{"name": "nginx", "namespace": "web", "restarts": int32(3), "__gvr_group": ""},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "show logs of this pod", translation.Prompt)
+	assert.Equal(t, "kubectl logs nginx -n web", translation.Command)
+	assert.Equal(t, "Shows logs of nginx", translation.Explanation)
+
+	assert.Equal(t, "qwen2.5-coder", got.Model)
+	assert.Equal(t, "json_object", got.ResponseFormat["type"])
+	require.Len(t, got.Messages, 2)
+	assert.Equal(t, "system", got.Messages[0].Role)
+	assert.Equal(t, `Current screen: pods
+Kubernetes context: kind-dev
+Namespace: web
+Selected resource:
+  name: nginx
+  namespace: web
+  restarts: 3
+
+Request: show logs of this pod`, got.Messages[1].Content)
+}
+
+func TestOpenAIProvider_Errors(t *testing.T) {
+	server := newStubServer(t, http.StatusNotFound, "", nil)
+	provider, err := NewOpenAIProvider(Config{Endpoint: server.URL + "/v1", Model: "missing", APIKey: "secret"})
+	require.NoError(t, err)
+	_, err = provider.Translate(context.Background(), Request{Prompt: "list pods"})
+	assert.ErrorContains(t, err, "model not found")
+
+	server = newStubServer(t, http.StatusOK, "I cannot help with that", nil)
+	provider, err = NewOpenAIProvider(Config{Endpoint: server.URL + "/v1", Model: "m", APIKey: "secret"})
+	require.NoError(t, err)
+	_, err = provider.Translate(context.Background(), Request{Prompt: "list pods"})
+	assert.ErrorContains(t, err, "did not answer with JSON")
+
+	server = newStubServer(t, http.StatusOK, `{"command": "", "explanation": ""}`, nil)
+	provider, err = NewOpenAIProvider(Config{Endpoint: server.URL + "/v1", Model: "m", APIKey: "secret"})
+	require.NoError(t, err)
+	_, err = provider.Translate(context.Background(), Request{Prompt: "list pods"})
+	assert.ErrorContains(t, err, "no command")
+
+	_, err = NewOpenAIProvider(Config{Endpoint: server.URL})
+	assert.ErrorContains(t, err, "model is required")
+}
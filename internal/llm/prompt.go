@@ -0,0 +1,115 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// systemPrompt instructs the model to answer with a single kubectl command
+// as JSON, which is what the commandbar preview consumes
+const systemPrompt = `You translate requests from a Kubernetes operator into exactly one kubectl command.
+Rules:
+- Answer with a JSON object only: {"command": "<kubectl command>", "explanation": "<one sentence>"}.
+- The command must start with "kubectl" and must not use shell pipes, redirection or subshells.
+- When the request refers to "this", "it" or "selected", use the selected resource.
+- Use the namespace of the selected resource unless the request names another one.
+- Prefer read-only commands when the request is ambiguous.`
+
+// buildUserPrompt describes the k1 state (screen, context, namespace and
+// selected resource) followed by the request
+func buildUserPrompt(req Request) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Current screen: %s\n", valueOr(req.Screen, "unknown"))
+	fmt.Fprintf(&b, "Kubernetes context: %s\n", valueOr(req.Context, "unknown"))
+	fmt.Fprintf(&b, "Namespace: %s\n", valueOr(req.Namespace, "all namespaces"))
+
+	if selected := selectedFields(req.Selected); len(selected) > 0 {
+		b.WriteString("Selected resource:\n")
+		for _, field := range selected {
+			fmt.Fprintf(&b, "  %s\n", field)
+		}
+	} else {
+		b.WriteString("Selected resource: none\n")
+	}
+
+	fmt.Fprintf(&b, "\nRequest: %s", req.Prompt)
+	return b.String()
+}
+
+// selectedFields returns "key: value" lines for the scalar fields of the
+// selected resource, sorted by key (internal __ fields are skipped)
+func selectedFields(selected map[string]any) []string {
+	var fields []string
+	for key, value := range selected {
+		if strings.HasPrefix(key, "__") {
+			continue
+		}
+		var text string
+		switch v := value.(type) {
+		case string:
+			text = v
+		case bool, int, int32, int64, float32, float64:
+			text = fmt.Sprint(v)
+		case time.Duration:
+			text = v.String()
+		case time.Time:
+			if v.IsZero() {
+				continue
+			}
+			text = v.UTC().Format(time.RFC3339)
+		default:
+			continue
+		}
+		if text == "" {
+			continue
+		}
+		fields = append(fields, key+": "+text)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// parseTranslation extracts the command and explanation from the model
+// output (tolerating code fences or text around the JSON object)
+func parseTranslation(prompt, content string) (*Translation, error) {
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("model did not answer with JSON: %q", truncate(content, 200))
+	}
+
+	var out struct {
+		Command     string `json:"command"`
+		Explanation string `json:"explanation"`
+	}
+	if err := json.Unmarshal([]byte(content[start:end+1]), &out); err != nil {
+		return nil, fmt.Errorf("failed to parse model output: %w", err)
+	}
+
+	command := strings.TrimSpace(out.Command)
+	if command == "" {
+		return nil, fmt.Errorf("model returned no command")
+	}
+	return &Translation{
+		Prompt:      prompt,
+		Command:     command,
+		Explanation: strings.TrimSpace(out.Explanation),
+	}, nil
+}
+
+func valueOr(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "…"
+}
@@ -0,0 +1,49 @@
+// Package llm translates natural-language requests into kubectl commands
+// using a pluggable LLM provider (an OpenAI-compatible endpoint, typically a
+// local server such as Ollama or llama.cpp, so cluster data stays local).
+package llm
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultTimeout bounds a translation when the config sets none (local
+// models on a laptop can take a while for the first token)
+const DefaultTimeout = 60 * time.Second
+
+// Provider translates a natural-language prompt into a kubectl command
+type Provider interface {
+	// Name identifies the provider and model (shown while translating)
+	Name() string
+	// Translate returns the command for the request
+	Translate(ctx context.Context, req Request) (*Translation, error)
+}
+
+// Request is a prompt along with what the user is looking at in k1
+type Request struct {
+	Prompt    string         // Natural-language request
+	Screen    string         // Current screen (pods, deployments, ...)
+	Context   string         // Kubernetes context
+	Namespace string         // Namespace of selected resource (empty = all namespaces)
+	Selected  map[string]any // Selected resource fields (empty if none)
+}
+
+// Translation is the structured output of a provider
+type Translation struct {
+	Prompt      string // Original natural-language prompt
+	Command     string // Generated kubectl command
+	Explanation string // Brief explanation
+}
+
+// Config holds configuration of the OpenAI-compatible provider
+type Config struct {
+	// Endpoint is the base URL of the API (e.g. http://localhost:11434/v1)
+	Endpoint string
+	// Model is the model name (e.g. qwen2.5-coder:7b)
+	Model string
+	// APIKey is sent as bearer token (optional, local servers need none)
+	APIKey string
+	// Timeout bounds each translation (0 = DefaultTimeout)
+	Timeout time.Duration
+}
@@ -0,0 +1,332 @@
+package llm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OperationClass tells how much harm a kubectl operation can do
+type OperationClass int
+
+const (
+	// ClassReadOnly operations only read from the cluster
+	ClassReadOnly OperationClass = iota
+	// ClassMutating operations change objects in place
+	ClassMutating
+	// ClassDestructive operations delete or evict objects, or change many
+	// objects at once (selectors, --all, all namespaces)
+	ClassDestructive
+)
+
+// String returns the class name shown in the preview
+func (c OperationClass) String() string {
+	switch c {
+	case ClassMutating:
+		return "MUTATING"
+	case ClassDestructive:
+		return "DESTRUCTIVE"
+	default:
+		return "READ-ONLY"
+	}
+}
+
+// allowedVerbs is the allowlist of kubectl verbs (subcommands included as
+// "verb sub") that /ai may run, with their class. Anything else (exec, cp,
+// port-forward, apply, edit, run, config, ...) is rejected.
+var allowedVerbs = map[string]OperationClass{
+	"get":             ClassReadOnly,
+	"describe":        ClassReadOnly,
+	"logs":            ClassReadOnly,
+	"top":             ClassReadOnly,
+	"explain":         ClassReadOnly,
+	"events":          ClassReadOnly,
+	"api-resources":   ClassReadOnly,
+	"api-versions":    ClassReadOnly,
+	"version":         ClassReadOnly,
+	"cluster-info":    ClassReadOnly,
+	"rollout status":  ClassReadOnly,
+	"rollout history": ClassReadOnly,
+	"auth can-i":      ClassReadOnly,
+	"auth whoami":     ClassReadOnly,
+	"annotate":        ClassMutating,
+	"autoscale":       ClassMutating,
+	"cordon":          ClassMutating,
+	"uncordon":        ClassMutating,
+	"create":          ClassMutating,
+	"label":           ClassMutating,
+	"patch":           ClassMutating,
+	"scale":           ClassMutating,
+	"set":             ClassMutating,
+	"taint":           ClassMutating,
+	"rollout restart": ClassMutating,
+	"rollout undo":    ClassMutating,
+	"rollout pause":   ClassMutating,
+	"rollout resume":  ClassMutating,
+	"delete":          ClassDestructive,
+	"drain":           ClassDestructive,
+}
+
+// verbsWithSubcommands have their first argument folded into the verb
+var verbsWithSubcommands = map[string]bool{
+	"rollout": true,
+	"auth":    true,
+}
+
+// noDryRunVerbs are mutating verbs kubectl cannot dry-run
+var noDryRunVerbs = map[string]bool{
+	"rollout pause":  true,
+	"rollout resume": true,
+}
+
+// nodeVerbs take node names directly (no resource type argument)
+var nodeVerbs = map[string]bool{
+	"cordon":   true,
+	"uncordon": true,
+	"drain":    true,
+}
+
+// rejectedFlags read or write local files, change the target cluster, its
+// trust or the identity, stream forever or wait for a terminal (k1 sets
+// context and kubeconfig)
+var rejectedFlags = map[string]string{
+	"-f":                         "reads local files or follows output",
+	"--filename":                 "reads local files",
+	"--follow":                   "streams forever",
+	"-k":                         "reads local files",
+	"--kustomize":                "reads local files",
+	"--from-file":                "reads local files",
+	"--from-env-file":            "reads local files",
+	"--patch-file":               "reads local files",
+	"--template":                 "reads local files",
+	"--cache-dir":                "writes local files",
+	"-w":                         "streams forever",
+	"--watch":                    "streams forever",
+	"--watch-only":               "streams forever",
+	"-i":                         "needs a terminal",
+	"--stdin":                    "needs a terminal",
+	"-t":                         "needs a terminal",
+	"--tty":                      "needs a terminal",
+	"--raw":                      "bypasses resource types",
+	"--kubeconfig":               "changes the target cluster",
+	"--context":                  "changes the target cluster",
+	"--cluster":                  "changes the target cluster",
+	"-s":                         "changes the target cluster",
+	"--server":                   "changes the target cluster",
+	"--token":                    "changes the identity",
+	"--user":                     "changes the identity",
+	"--as":                       "changes the identity",
+	"--as-group":                 "changes the identity",
+	"--as-uid":                   "changes the identity",
+	"--username":                 "changes the identity",
+	"--password":                 "changes the identity",
+	"--client-certificate":       "changes the identity",
+	"--client-key":               "changes the identity",
+	"--certificate-authority":    "changes the trusted cluster",
+	"--insecure-skip-tls-verify": "changes the trusted cluster",
+	"--tls-server-name":          "changes the trusted cluster",
+	"--dry-run":                  "is added by k1",
+	"--edit":                     "opens an editor",
+	"--overwrite-all":            "changes many objects",
+}
+
+// bulkFlags make a mutating operation act on many objects at once
+var bulkFlags = map[string]bool{
+	"--all":            true,
+	"-A":               true,
+	"--all-namespaces": true,
+	"-l":               true,
+	"--selector":       true,
+	"--field-selector": true,
+}
+
+// valueFlags take a value, which may be given as the next argument
+var valueFlags = map[string]bool{
+	"-n":               true,
+	"--namespace":      true,
+	"-l":               true,
+	"--selector":       true,
+	"--field-selector": true,
+	"-o":               true,
+	"--output":         true,
+	"-c":               true,
+	"--container":      true,
+	"-p":               true,
+	"--patch":          true,
+	"--type":           true,
+	"--replicas":       true,
+	"--tail":           true,
+	"--since":          true,
+	"--sort-by":        true,
+	"--grace-period":   true,
+	"--timeout":        true,
+	"--image":          true,
+	"--min":            true,
+	"--max":            true,
+	"--cpu-percent":    true,
+	"--to-revision":    true,
+}
+
+// Operation is a kubectl invocation parsed from an LLM answer
+type Operation struct {
+	Args      []string       // kubectl arguments (without "kubectl")
+	Verb      string         // Verb, with subcommand for rollout/auth (e.g. "rollout restart")
+	Targets   []string       // Positional arguments after the verb (resource, names)
+	Namespace string         // -n/--namespace value (empty = kubeconfig default)
+	Class     OperationClass // Read-only, mutating or destructive
+	Bulk      bool           // Targets many objects (selector, --all, all namespaces)
+}
+
+// ParseCommand parses and vets a kubectl command line. It fails for shell
+// syntax, verbs outside the allowlist and flags k1 refuses to pass on.
+func ParseCommand(command string) (*Operation, error) {
+	words, err := splitCommandLine(command)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 0 || words[0] != "kubectl" {
+		return nil, fmt.Errorf("not a kubectl command")
+	}
+
+	op := &Operation{Args: words[1:]}
+
+	// The verb must come first: flags before it could hide which verb
+	// kubectl really runs (e.g. "kubectl -o get exec ...")
+	if len(op.Args) == 0 || strings.HasPrefix(op.Args[0], "-") {
+		return nil, fmt.Errorf("kubectl command must start with a verb")
+	}
+	op.Verb = op.Args[0]
+	rest := op.Args[1:]
+	if verbsWithSubcommands[op.Verb] && len(rest) > 0 && !strings.HasPrefix(rest[0], "-") {
+		op.Verb += " " + rest[0]
+		rest = rest[1:]
+	}
+
+	for i := 0; i < len(rest); i++ {
+		arg := rest[i]
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			op.Targets = append(op.Targets, arg)
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(arg, "=")
+		if !strings.HasPrefix(name, "--") && len(name) > 2 {
+			// Short flag with attached value (-nweb, -lapp=web)
+			name, value, hasValue = name[:2], arg[2:], true
+		}
+		if reason, rejected := rejectedFlags[name]; rejected {
+			return nil, fmt.Errorf("flag %s is not allowed (%s)", name, reason)
+		}
+		if bulkFlags[name] {
+			op.Bulk = true
+		}
+		if valueFlags[name] && !hasValue {
+			if i+1 >= len(rest) {
+				return nil, fmt.Errorf("flag %s needs a value", name)
+			}
+			i++
+			value = rest[i]
+		}
+		if name == "-n" || name == "--namespace" {
+			op.Namespace = value
+		}
+		// Output formats reading a template file (jsonpath-file=PATH)
+		if format, _, _ := strings.Cut(value, "="); (name == "-o" || name == "--output") && strings.HasSuffix(format, "-file") {
+			return nil, fmt.Errorf("output %s is not allowed (reads local files)", format)
+		}
+	}
+
+	class, allowed := allowedVerbs[op.Verb]
+	if !allowed {
+		return nil, fmt.Errorf("kubectl %s is not allowed", op.Verb)
+	}
+	op.Class = class
+	if op.Class == ClassMutating && op.Bulk {
+		op.Class = ClassDestructive
+	}
+	return op, nil
+}
+
+// String returns the command line of the operation
+func (op *Operation) String() string {
+	return "kubectl " + strings.Join(op.Args, " ")
+}
+
+// DryRunArgs returns the arguments of a server-side dry-run of the
+// operation, nil for read-only operations and verbs kubectl cannot dry-run
+func (op *Operation) DryRunArgs() []string {
+	if op.Class == ClassReadOnly || noDryRunVerbs[op.Verb] {
+		return nil
+	}
+	args := append([]string{}, op.Args...)
+	return append(args, "--dry-run=server")
+}
+
+// ConfirmationToken returns what the user must type to run a destructive
+// operation: the object name when it targets exactly one, else the verb
+func (op *Operation) ConfirmationToken() string {
+	names := op.Targets
+	if len(names) > 0 && !strings.Contains(names[0], "/") && !nodeVerbs[op.Verb] {
+		names = names[1:] // Resource type first (e.g. "delete pod nginx")
+	}
+	if len(names) == 1 && !op.Bulk {
+		if _, name, found := strings.Cut(names[0], "/"); found {
+			return name
+		}
+		return names[0]
+	}
+	return op.Verb
+}
+
+// splitCommandLine splits a command line into words, honoring single and
+// double quotes. Unquoted shell syntax (pipes, redirection, substitution,
+// command separators) is rejected since commands never run in a shell.
+func splitCommandLine(line string) ([]string, error) {
+	var words []string
+	var current strings.Builder
+	inWord := false
+	var quote rune
+
+	for _, r := range strings.TrimSpace(line) {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, current.String())
+				current.Reset()
+				inWord = false
+			}
+		case strings.ContainsRune("|;&<>`$()\\\n", r):
+			return nil, fmt.Errorf("shell syntax %q is not supported", string(r))
+		default:
+			current.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if inWord {
+		words = append(words, current.String())
+	}
+	return words, nil
+}
+
+// Analysis is the result of vetting a translated command before it runs
+type Analysis struct {
+	Operation *Operation // nil when rejected
+	Rejection error      // Why the command cannot run
+	DryRun    string     // Server dry-run output (mutating operations)
+}
+
+// Runnable reports whether the analysed command may run
+func (a *Analysis) Runnable() bool {
+	return a != nil && a.Operation != nil && a.Rejection == nil
+}
@@ -0,0 +1,140 @@
+package llm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		command   string
+		verb      string
+		targets   []string
+		namespace string
+		class     OperationClass
+		bulk      bool
+	}{
+		{"get", "kubectl get pods -n web", "get", []string{"pods"}, "web", ClassReadOnly, false},
+		{"jsonpath output", "kubectl get pods -o jsonpath={.items[*].metadata.name}", "get", []string{"pods"}, "", ClassReadOnly, false},
+		{"attached namespace", "kubectl logs nginx -nweb --tail=50", "logs", []string{"nginx"}, "web", ClassReadOnly, false},
+		{"rollout status", "kubectl rollout status deployment/api", "rollout status", []string{"deployment/api"}, "", ClassReadOnly, false},
+		{"scale", "kubectl scale deployment api --replicas=3 --namespace=web", "scale", []string{"deployment", "api"}, "web", ClassMutating, false},
+		{"quoted value", `kubectl annotate pod nginx note="hello world"`, "annotate", []string{"pod", "nginx", "note=hello world"}, "", ClassMutating, false},
+		{"delete", "kubectl delete pod nginx", "delete", []string{"pod", "nginx"}, "", ClassDestructive, false},
+		{"bulk label", "kubectl label pods -l app=web tier=front", "label", []string{"pods", "tier=front"}, "", ClassDestructive, true},
+		{"bulk all namespaces", "kubectl rollout restart deployment -A", "rollout restart", []string{"deployment"}, "", ClassDestructive, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op, err := ParseCommand(tt.command)
+			require.NoError(t, err)
+			assert.Equal(t, tt.verb, op.Verb)
+			assert.Equal(t, tt.targets, op.Targets)
+			assert.Equal(t, tt.namespace, op.Namespace)
+			assert.Equal(t, tt.class, op.Class)
+			assert.Equal(t, tt.bulk, op.Bulk)
+		})
+	}
+}
+
+func TestParseCommand_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		errMsg  string
+	}{
+		{"not kubectl", "helm list", "not a kubectl command"},
+		{"empty", "", "not a kubectl command"},
+		{"verb not first", "kubectl -n web exec nginx", "must start with a verb"},
+		{"exec", "kubectl exec nginx -- sh", "kubectl exec is not allowed"},
+		{"apply", "kubectl apply -k overlay", "flag -k is not allowed"},
+		{"rollout unknown", "kubectl rollout foo deploy/api", "kubectl rollout foo is not allowed"},
+		{"pipe", "kubectl get pods | grep web", "shell syntax"},
+		{"substitution", "kubectl delete pod $(whoami)", "shell syntax"},
+		{"separator", "kubectl get pods; rm -rf /", "shell syntax"},
+		{"unterminated quote", `kubectl get pods -l "app=web`, "unterminated quote"},
+		{"from-file", "kubectl create secret generic x --from-file=/home/me/.ssh/id_rsa", "flag --from-file is not allowed"},
+		{"from-file separate", "kubectl create configmap x --from-file /etc/passwd", "flag --from-file is not allowed"},
+		{"from-env-file", "kubectl create secret generic x --from-env-file=.env", "flag --from-env-file is not allowed"},
+		{"from-env-file separate", "kubectl create configmap x --from-env-file .env", "flag --from-env-file is not allowed"},
+		{"patch-file", "kubectl patch deploy/api --patch-file=patch.yaml", "flag --patch-file is not allowed"},
+		{"patch-file separate", "kubectl patch deploy/api --patch-file patch.yaml", "flag --patch-file is not allowed"},
+		{"template", "kubectl get pods --template=/etc/passwd", "flag --template is not allowed"},
+		{"jsonpath-file", "kubectl get pods -o jsonpath-file=/home/me/.ssh/id_rsa", "output jsonpath-file is not allowed"},
+		{"go-template-file", "kubectl get pods --output=go-template-file=/etc/passwd", "output go-template-file is not allowed"},
+		{"go-template-file attached", "kubectl get pods -ogo-template-file=/etc/passwd", "output go-template-file is not allowed"},
+		{"cache-dir", "kubectl get pods --cache-dir=/tmp/x", "flag --cache-dir is not allowed"},
+		{"client certificate", "kubectl get pods --client-certificate=/tmp/admin.crt", "flag --client-certificate is not allowed"},
+		{"client key", "kubectl get pods --client-key /tmp/admin.key", "flag --client-key is not allowed"},
+		{"username", "kubectl get pods --username=admin", "flag --username is not allowed"},
+		{"password", "kubectl get pods --password=secret", "flag --password is not allowed"},
+		{"certificate authority", "kubectl get pods --certificate-authority=/tmp/ca.crt", "flag --certificate-authority is not allowed"},
+		{"insecure", "kubectl get pods --insecure-skip-tls-verify", "flag --insecure-skip-tls-verify is not allowed"},
+		{"tls server name", "kubectl get pods --tls-server-name=evil", "flag --tls-server-name is not allowed"},
+		{"follow", "kubectl logs nginx -f", "flag -f is not allowed"},
+		{"context", "kubectl get pods --context=prod", "flag --context is not allowed"},
+		{"impersonation", "kubectl delete pod nginx --as=admin", "flag --as is not allowed"},
+		{"dry-run", "kubectl delete pod nginx --dry-run=client", "flag --dry-run is not allowed"},
+		{"missing namespace", "kubectl get pods -n", "needs a value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCommand(tt.command)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestOperation_DryRunArgs(t *testing.T) {
+	op, err := ParseCommand("kubectl scale deployment api --replicas=0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"scale", "deployment", "api", "--replicas=0", "--dry-run=server"}, op.DryRunArgs())
+	assert.Equal(t, []string{"scale", "deployment", "api", "--replicas=0"}, op.Args, "args are not modified")
+
+	op, err = ParseCommand("kubectl get pods")
+	require.NoError(t, err)
+	assert.Nil(t, op.DryRunArgs())
+
+	op, err = ParseCommand("kubectl rollout pause deployment/api")
+	require.NoError(t, err)
+	assert.Nil(t, op.DryRunArgs())
+}
+
+func TestOperation_ConfirmationToken(t *testing.T) {
+	tests := []struct {
+		command string
+		token   string
+	}{
+		{"kubectl delete pod nginx -n web", "nginx"},
+		{"kubectl delete deployment/api", "api"},
+		{"kubectl drain node-1 --ignore-daemonsets", "node-1"},
+		{"kubectl delete pod nginx redis", "delete"},
+		{"kubectl delete pods --all", "delete"},
+		{"kubectl label pods -l app=web tier=front", "label"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			op, err := ParseCommand(tt.command)
+			require.NoError(t, err)
+			assert.Equal(t, tt.token, op.ConfirmationToken())
+		})
+	}
+}
+
+func TestAnalysis_Runnable(t *testing.T) {
+	op, err := ParseCommand("kubectl get pods")
+	require.NoError(t, err)
+
+	var nilAnalysis *Analysis
+	assert.False(t, nilAnalysis.Runnable())
+	assert.True(t, (&Analysis{Operation: op}).Runnable())
+	assert.False(t, (&Analysis{Operation: op, Rejection: assert.AnError}).Runnable())
+	assert.False(t, (&Analysis{Rejection: assert.AnError}).Runnable())
+}
@@ -0,0 +1,214 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/k1/internal/audit"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// AuditScreenID is the screen identifier for the audit log browser
+const AuditScreenID = "audit"
+
+// AuditRow is a table row of the audit screen
+type AuditRow struct {
+	Timestamp      time.Time
+	User           string
+	Context        string
+	Server         string
+	Namespace      string
+	Resource       string // type/name
+	Command        string
+	KubectlCommand string
+	Result         string
+	Message        string
+	Duration       string
+}
+
+// auditFilterFields maps the field:value filter keys of the audit screen
+// to row fields
+var auditFilterFields = map[string]func(AuditRow) string{
+	"user":      func(r AuditRow) string { return r.User },
+	"context":   func(r AuditRow) string { return r.Context },
+	"ctx":       func(r AuditRow) string { return r.Context },
+	"namespace": func(r AuditRow) string { return r.Namespace },
+	"ns":        func(r AuditRow) string { return r.Namespace },
+	"resource":  func(r AuditRow) string { return r.Resource },
+	"result":    func(r AuditRow) string { return r.Result },
+}
+
+// GetAuditScreenConfig returns the config for the audit log screen (a nil
+// log shows an empty list)
+func GetAuditScreenConfig(log *audit.Log) ScreenConfig {
+	return ScreenConfig{
+		ID:           AuditScreenID,
+		Title:        "Audit Log",
+		ResourceType: k8s.ResourceType(AuditScreenID),
+		Columns: []ColumnConfig{
+			{Field: "Timestamp", Title: "Time", Width: 19, Priority: 1, Format: FormatDateTime},
+			{Field: "User", Title: "User", Width: 12, Priority: 3},
+			{Field: "Context", Title: "Context", Width: 20, Priority: 1},
+			{Field: "Namespace", Title: "Namespace", Width: 15, Priority: 2},
+			{Field: "Resource", Title: "Resource", Width: 30, Priority: 1},
+			{Field: "Command", Title: "Command", Width: 0, Priority: 1},
+			{Field: "Result", Title: "Result", Width: 7, Priority: 1, Format: FormatStatus},
+			{Field: "Duration", Title: "Duration", Width: 9, Priority: 3},
+		},
+		SearchFields: []string{"User", "Context", "Namespace", "Resource", "Command", "KubectlCommand", "Message"},
+		Operations: []OperationConfig{
+			{ID: "details", Name: "Details", Description: "Show the full audit entry", Shortcut: "enter"},
+		},
+		NavigationHandler: showAuditEntry(),
+		CustomRefresh: func(s *ConfigScreen) tea.Cmd {
+			return func() tea.Msg {
+				start := time.Now()
+				entries, err := log.Entries()
+				if err != nil {
+					return types.ErrorStatusMsg(fmt.Sprintf("Failed to read audit log: %v", err))
+				}
+				items := make([]interface{}, len(entries))
+				for i, entry := range entries {
+					items[i] = newAuditRow(entry)
+				}
+				s.items = items
+				filterAuditRows(s)
+				return types.RefreshCompleteMsg{Duration: time.Since(start)}
+			}
+		},
+		CustomFilter: func(s *ConfigScreen, _ string) {
+			filterAuditRows(s)
+			if s.filter != "" && len(s.filtered) > 0 {
+				s.table.SetCursor(0)
+			}
+		},
+	}
+}
+
+// newAuditRow builds a table row from an audit entry
+func newAuditRow(entry audit.Entry) AuditRow {
+	resource := entry.ResourceType
+	if entry.ResourceName != "" {
+		resource += "/" + entry.ResourceName
+	}
+	return AuditRow{
+		Timestamp:      entry.Timestamp,
+		User:           entry.User,
+		Context:        entry.Context,
+		Server:         entry.Server,
+		Namespace:      entry.Namespace,
+		Resource:       resource,
+		Command:        entry.Command,
+		KubectlCommand: entry.KubectlCommand,
+		Result:         entry.Result,
+		Message:        entry.Message,
+		Duration:       FormatDuration(time.Duration(entry.DurationMS) * time.Millisecond),
+	}
+}
+
+// filterAuditRows applies the screen filter: field:value terms (user,
+// context/ctx, namespace/ns, resource, result and since:<duration>) must all
+// match, and the remaining words must appear in the searchable fields
+func filterAuditRows(s *ConfigScreen) {
+	var terms []string
+	var since time.Duration
+	fieldTerms := map[string]string{}
+	for _, word := range strings.Fields(strings.ToLower(s.filter)) {
+		key, value, found := strings.Cut(word, ":")
+		if found && key == "since" {
+			if d, err := time.ParseDuration(value); err == nil {
+				since = d
+				continue
+			}
+		}
+		if _, known := auditFilterFields[key]; found && known {
+			fieldTerms[key] = value
+			continue
+		}
+		terms = append(terms, word)
+	}
+
+	s.filtered = make([]interface{}, 0, len(s.items))
+	for _, item := range s.items {
+		row, ok := item.(AuditRow)
+		if !ok {
+			continue
+		}
+		if since > 0 && time.Since(row.Timestamp) > since {
+			continue
+		}
+		if !matchesAuditTerms(s, row, fieldTerms, terms) {
+			continue
+		}
+		s.filtered = append(s.filtered, row)
+	}
+	s.updateTable()
+}
+
+// matchesAuditTerms checks the field terms and free-text words of a row
+func matchesAuditTerms(s *ConfigScreen, row AuditRow, fieldTerms map[string]string, terms []string) bool {
+	for key, value := range fieldTerms {
+		if !strings.Contains(strings.ToLower(auditFilterFields[key](row)), value) {
+			return false
+		}
+	}
+	if len(terms) == 0 {
+		return true
+	}
+
+	fields := make([]string, 0, len(s.config.SearchFields))
+	for _, field := range s.config.SearchFields {
+		fields = append(fields, fmt.Sprint(getFieldValue(row, field)))
+	}
+	text := strings.ToLower(strings.Join(fields, " "))
+	for _, term := range terms {
+		if !strings.Contains(text, term) {
+			return false
+		}
+	}
+	return true
+}
+
+// showAuditEntry opens the selected audit entry in full screen
+func showAuditEntry() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		cursor := s.table.Cursor()
+		if cursor < 0 || cursor >= len(s.filtered) {
+			return nil
+		}
+		row, ok := s.filtered[cursor].(AuditRow)
+		if !ok {
+			return nil
+		}
+		return func() tea.Msg {
+			return types.ShowFullScreenMsg{
+				ViewType:     3, // Data
+				ResourceName: "Audit entry " + row.Timestamp.Format(time.RFC3339),
+				Content:      formatAuditRow(row),
+			}
+		}
+	}
+}
+
+// formatAuditRow renders all fields of an audit entry
+func formatAuditRow(row AuditRow) string {
+	lines := []string{
+		"Time:      " + row.Timestamp.Format(time.RFC3339),
+		"User:      " + row.User,
+		"Context:   " + row.Context,
+		"Server:    " + row.Server,
+		"Namespace: " + row.Namespace,
+		"Resource:  " + row.Resource,
+		"Command:   " + row.Command,
+		"Kubectl:   " + row.KubectlCommand,
+		"Result:    " + row.Result,
+		"Duration:  " + row.Duration,
+		"",
+		row.Message,
+	}
+	return strings.Join(lines, "\n")
+}
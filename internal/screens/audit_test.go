@@ -0,0 +1,91 @@
+package screens
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/audit"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+)
+
+func newTestAuditScreen(t *testing.T) *ConfigScreen {
+	t.Helper()
+	log, err := audit.Open(audit.Config{FilePath: filepath.Join(t.TempDir(), "audit.jsonl")})
+	require.NoError(t, err)
+	t.Cleanup(func() { log.Close() })
+
+	now := time.Now()
+	entries := []audit.Entry{
+		{Timestamp: now.Add(-48 * time.Hour), User: "alice", Context: "prod-eu", Namespace: "web", ResourceType: "deployments", ResourceName: "api", Command: "/scale 3", Result: audit.ResultSuccess, DurationMS: 1500},
+		{Timestamp: now.Add(-time.Hour), User: "bob", Context: "prod-us", Namespace: "web", ResourceType: "pods", ResourceName: "api-1", Command: "/delete", Result: audit.ResultError, Message: "forbidden"},
+		{Timestamp: now.Add(-time.Minute), User: "alice", Context: "staging", ResourceType: "nodes", ResourceName: "node-1", Command: "/cordon", Result: audit.ResultSuccess},
+	}
+	for _, entry := range entries {
+		require.NoError(t, log.Record(entry))
+	}
+
+	return refreshedConfigScreen(t, GetAuditScreenConfig(log), k8s.NewDummyRepository(), nil)
+}
+
+func TestAuditScreen_Refresh(t *testing.T) {
+	screen := newTestAuditScreen(t)
+
+	require.Len(t, screen.filtered, 3)
+	row := screen.filtered[2].(AuditRow)
+	assert.Equal(t, "deployments/api", row.Resource)
+	assert.Equal(t, "1s", row.Duration)
+	assert.Equal(t, "/cordon", screen.filtered[0].(AuditRow).Command, "newest first")
+}
+
+func TestAuditScreen_Filter(t *testing.T) {
+	tests := []struct {
+		filter   string
+		commands []string
+	}{
+		{"user:alice", []string{"/cordon", "/scale 3"}},
+		{"ctx:prod result:error", []string{"/delete"}},
+		{"ns:web since:24h", []string{"/delete"}},
+		{"resource:nodes/", []string{"/cordon"}},
+		{"forbidden", []string{"/delete"}},
+		{"user:alice scale", []string{"/scale 3"}},
+		{"user:carol", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			screen := newTestAuditScreen(t)
+			screen.SetFilter(tt.filter)
+
+			commands := []string{}
+			for _, item := range screen.filtered {
+				commands = append(commands, item.(AuditRow).Command)
+			}
+			assert.Equal(t, tt.commands, commands)
+		})
+	}
+}
+
+func TestAuditScreen_Details(t *testing.T) {
+	screen := newTestAuditScreen(t)
+	screen.table.SetCursor(1)
+
+	cmd := screen.config.NavigationHandler(screen)
+	require.NotNil(t, cmd)
+	fullScreenMsg, ok := cmd().(types.ShowFullScreenMsg)
+	require.True(t, ok)
+	assert.Contains(t, fullScreenMsg.Content, "User:      bob")
+	assert.Contains(t, fullScreenMsg.Content, "forbidden")
+}
+
+func TestAuditScreen_NilLog(t *testing.T) {
+	screen := NewConfigScreen(GetAuditScreenConfig(nil), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	_, ok := screen.Refresh()().(types.RefreshCompleteMsg)
+	require.True(t, ok)
+	assert.Empty(t, screen.filtered)
+}
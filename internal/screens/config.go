@@ -43,6 +43,17 @@ type ColumnConfig struct {
 	Priority int                      // 1=critical, 2=important, 3=optional
 }
 
+// FormatValue returns the column text of an item (as shown in the table)
+func (c ColumnConfig) FormatValue(item interface{}) string {
+	val := getFieldValue(item, c.Field)
+
+	// Apply custom formatter if provided
+	if c.Format != nil {
+		return c.Format(val)
+	}
+	return fmt.Sprint(val)
+}
+
 // OperationConfig defines an operation that can be executed
 type OperationConfig struct {
 	ID          string
@@ -76,6 +87,10 @@ type ScreenConfig struct {
 	CustomFilter  func(*ConfigScreen, string)
 	CustomUpdate  func(*ConfigScreen, tea.Msg) (tea.Model, tea.Cmd)
 	CustomView    func(*ConfigScreen) string
+
+	// Optional reset of screen-held state, called when the screen is left or
+	// its FilterContext changes (e.g. to drop decoded Secret values)
+	CustomReset func(*ConfigScreen)
 }
 
 // ConfigScreen is a generic screen implementation driven by ScreenConfig
@@ -93,6 +108,12 @@ type ConfigScreen struct {
 	// For selection tracking (if enabled)
 	selectedKey string
 
+	// Resource to select on the next refresh (set by SelectResource)
+	pendingSelectKey string
+
+	// Rows changed by the last refreshes (if selection tracking is enabled)
+	changes changeTracker
+
 	// For contextual navigation filtering
 	filterContext *types.FilterContext
 
@@ -214,7 +235,9 @@ func (s *ConfigScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	logging.Debug("ConfigScreen.Update received message", "screen", s.config.Title, "msg_type", fmt.Sprintf("%T", msg))
 
 	// Handle loading message first (before custom update)
-	switch msg.(type) {
+	switch msg := msg.(type) {
+	case types.ExportTableMsg:
+		return s, s.exportTable(msg)
 	case startConfigLoadingMsg:
 		// Show loading message and start refresh
 		return s, tea.Batch(
@@ -223,16 +246,35 @@ func (s *ConfigScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			},
 			s.Refresh(),
 		)
+	case changeHighlightExpiredMsg:
+		if msg.screenID == s.config.ID && s.changes.expire(time.Now()) {
+			s.updateTable()
+		}
+		return s, nil
+	}
+
+	// Highlights of the changes found by a refresh expire after a while
+	var expiryTick tea.Cmd
+	if _, ok := msg.(types.RefreshCompleteMsg); ok && s.changes.fresh {
+		s.changes.fresh = false
+		expiryTick = s.changeExpiryTick()
 	}
 
+	var model tea.Model
+	var cmd tea.Cmd
 	// Check for custom update handler
 	if s.config.CustomUpdate != nil {
 		logging.Debug("Calling CustomUpdate", "screen", s.config.Title)
-		return s.config.CustomUpdate(s, msg)
+		model, cmd = s.config.CustomUpdate(s, msg)
+	} else {
+		logging.Debug("Calling DefaultUpdate", "screen", s.config.Title)
+		model, cmd = s.DefaultUpdate(msg)
 	}
 
-	logging.Debug("Calling DefaultUpdate", "screen", s.config.Title)
-	return s.DefaultUpdate(msg)
+	if expiryTick != nil {
+		cmd = tea.Batch(cmd, expiryTick)
+	}
+	return model, cmd
 }
 
 func (s *ConfigScreen) DefaultUpdate(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -242,6 +284,10 @@ func (s *ConfigScreen) DefaultUpdate(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if s.config.TrackSelection && s.filter == "" {
 			s.restoreCursorPosition()
 		}
+		if s.pendingSelectKey != "" {
+			s.selectResourceByKey(s.pendingSelectKey)
+			s.pendingSelectKey = ""
+		}
 
 		// Workaround for Bubble Tea viewport bug with instant-data screens:
 		// When CustomRefresh returns synchronously (same tick as Init), the viewport
@@ -300,24 +346,21 @@ func (s *ConfigScreen) DefaultUpdate(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return s, nil
 		case "G":
-			// Jump to bottom
+			// Jump to bottom (last resource, before deleted rows)
 			s.table.GotoBottom()
+			s.ensureCursorVisible()
 			if s.config.TrackSelection {
 				s.updateSelectedKey()
 			}
 			return s, nil
 		}
 
-		// Track if this is a page navigation key
-		isPageNav := msg.Type == tea.KeyPgUp || msg.Type == tea.KeyPgDown
-
 		var cmd tea.Cmd
 		s.table, cmd = s.table.Update(msg)
 
-		// After page navigation, ensure cursor is visible
-		if isPageNav {
-			s.ensureCursorVisible()
-		}
+		// After navigation, ensure cursor is on a resource (page navigation
+		// and deleted rows can move it past the last one)
+		s.ensureCursorVisible()
 
 		if s.config.TrackSelection {
 			s.updateSelectedKey()
@@ -345,7 +388,7 @@ func (s *ConfigScreen) View() string {
 		return s.renderEmptyFilteredView()
 	}
 
-	return s.table.View()
+	return s.renderChanges(s.table.View())
 }
 
 // renderEmptyFilteredView shows a helpful message when filter returns no results
@@ -718,6 +761,7 @@ func (s *ConfigScreen) Refresh() tea.Cmd {
 		}
 
 		logging.Debug("Screen refresh complete", "screen", s.config.Title, "items", len(items), "duration", time.Since(start))
+		s.syncContextColumn()
 		s.items = items
 		s.applyFilter()
 		logging.Debug("After filter", "screen", s.config.Title, "filtered_items", len(s.filtered))
@@ -846,9 +890,38 @@ func (s *ConfigScreen) refreshWithFilterContext() ([]interface{}, error) {
 		return items, nil
 	}
 
+	// Pulse tile drill-down (any list screen, keeps the objects the tile counts)
+	if s.filterContext.Field == "pulse" {
+		return s.refreshWithPulseTile()
+	}
+
+	// Handle ServiceAccount → RoleBindings navigation (both binding kinds)
+	if s.config.ResourceType == k8s.ResourceTypeRoleBinding && s.filterContext.Field == "subject" {
+		metadata := s.filterContext.Metadata
+		bindings, err := s.repo.GetBindingsForSubject(metadata["kind"], metadata["namespace"], s.filterContext.Value)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]interface{}, len(bindings))
+		for i, binding := range bindings {
+			items[i] = binding
+		}
+		return items, nil
+	}
+
+	// StorageClass → PVCs and PersistentVolume → VolumeAttachments navigation
+	if field, ok := referenceFilterFields[s.filterContext.Field]; ok && s.config.ResourceType != k8s.ResourceTypePod {
+		return s.refreshWithReference(field)
+	}
+
+	// Single object navigation (HPA → target, binding → role)
+	if s.filterContext.Field == "name" && s.config.ResourceType != k8s.ResourceTypePod {
+		return s.refreshWithName()
+	}
+
 	// All other filtering targets pods
 	if s.config.ResourceType != k8s.ResourceTypePod {
-		return s.repo.GetResources(s.config.ResourceType)
+		return s.filteredResources()
 	}
 
 	var pods []k8s.Pod
@@ -871,7 +944,7 @@ func (s *ConfigScreen) refreshWithFilterContext() ([]interface{}, error) {
 		case "ReplicaSet":
 			pods, err = s.repo.GetPodsForReplicaSet(namespace, s.filterContext.Value)
 		default:
-			return s.repo.GetResources(s.config.ResourceType)
+			return s.filteredResources()
 		}
 	case "node":
 		// Node → Pods
@@ -895,7 +968,7 @@ func (s *ConfigScreen) refreshWithFilterContext() ([]interface{}, error) {
 		// Endpoints → Pods (same as service selector)
 		pods, err = s.repo.GetPodsForService(namespace, s.filterContext.Value)
 	default:
-		return s.repo.GetResources(s.config.ResourceType)
+		return s.filteredResources()
 	}
 
 	if err != nil {
@@ -911,9 +984,105 @@ func (s *ConfigScreen) refreshWithFilterContext() ([]interface{}, error) {
 	return items, nil
 }
 
+// refreshWithPulseTile returns the resources counted by a cluster pulse tile
+// (the tile is recomputed so the list follows the cluster while it is open)
+func (s *ConfigScreen) refreshWithPulseTile() ([]interface{}, error) {
+	pulse, err := s.repo.GetClusterPulse()
+	if err != nil {
+		return nil, err
+	}
+	tile, ok := pulse.Tile(s.filterContext.Value)
+	if !ok {
+		return []interface{}{}, nil
+	}
+
+	keys := make(map[string]bool, len(tile.Keys))
+	for _, key := range tile.Keys {
+		keys[key] = true
+	}
+
+	resources, err := s.filteredResources()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]interface{}, 0, len(tile.Keys))
+	for _, item := range resources {
+		resource, ok := item.(k8s.Resource)
+		if ok && keys[resource.GetNamespace()+"/"+resource.GetName()] {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// referenceFilterFields maps filter context fields to the column holding
+// the name of the object they filter by
+var referenceFilterFields = map[string]string{
+	"storageclass": "StorageClass",
+	"pv":           "PersistentVolume",
+}
+
+// refreshWithReference keeps the objects whose field references the object
+// of the filter context
+func (s *ConfigScreen) refreshWithReference(field string) ([]interface{}, error) {
+	resources, err := s.filteredResources()
+	if err != nil {
+		return nil, err
+	}
+	items := []interface{}{}
+	for _, item := range resources {
+		if value, ok := getFieldValue(item, field).(string); ok && value == s.filterContext.Value {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// refreshWithName keeps the object named by the filter context (any
+// namespace when the context has none)
+func (s *ConfigScreen) refreshWithName() ([]interface{}, error) {
+	resources, err := s.filteredResources()
+	if err != nil {
+		return nil, err
+	}
+	namespace := s.filterContext.Metadata["namespace"]
+	items := []interface{}{}
+	for _, item := range resources {
+		resource, ok := item.(k8s.Resource)
+		if ok && resource.GetName() == s.filterContext.Value && (namespace == "" || resource.GetNamespace() == namespace) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// filteredResources returns the resources filtered lists are built from.
+// Drill-downs open from rows of the active context, so aggregated pools are
+// read from the active context only.
+func (s *ConfigScreen) filteredResources() ([]interface{}, error) {
+	repo := s.repo
+	if pool, ok := s.repo.(interface{ GetActiveRepository() k8s.Repository }); ok {
+		if repo = pool.GetActiveRepository(); repo == nil {
+			return nil, fmt.Errorf("no active repository")
+		}
+	}
+	return repo.GetResources(s.config.ResourceType)
+}
+
 // ApplyFilterContext sets the filter context for this screen
 func (s *ConfigScreen) ApplyFilterContext(ctx *types.FilterContext) {
+	if s.config.CustomReset != nil && !reflect.DeepEqual(s.filterContext, ctx) {
+		s.config.CustomReset(s)
+	}
 	s.filterContext = ctx
+	s.resetChanges()
+}
+
+// Leave is called when the app switches to another screen
+func (s *ConfigScreen) Leave() {
+	if s.config.CustomReset != nil {
+		s.config.CustomReset(s)
+	}
 }
 
 // GetFilterContext returns the current filter context
@@ -926,6 +1095,17 @@ func (s *ConfigScreen) GetRefreshInterval() time.Duration {
 	return s.config.RefreshInterval
 }
 
+// GetColumns returns the columns of the screen (including the Context column
+// in aggregated mode)
+func (s *ConfigScreen) GetColumns() []ColumnConfig {
+	return s.config.Columns
+}
+
+// GetFilteredItems returns the items shown after filtering
+func (s *ConfigScreen) GetFilteredItems() []interface{} {
+	return s.filtered
+}
+
 // GetItemCount returns the number of filtered items currently displayed
 func (s *ConfigScreen) GetItemCount() int {
 	return len(s.filtered)
@@ -949,9 +1129,15 @@ func (s *ConfigScreen) SetFilter(filter string) {
 	}
 }
 
-// applyFilter filters items based on fuzzy search
+// applyFilter filters items based on column comparisons (restarts>3) or
+// fuzzy search
 func (s *ConfigScreen) applyFilter() {
-	if s.filter == "" {
+	s.trackChanges()
+
+	if predicates, ok := parseFieldFilter(s.filter, s.config.Columns); ok {
+		// Column comparisons keep the original order from repository
+		s.filtered = filterByFields(s.items, predicates)
+	} else if s.filter == "" {
 		s.filtered = s.items
 		// Unfiltered list: keep original order from repository (already sorted by age)
 	} else {
@@ -1050,21 +1236,28 @@ func (s *ConfigScreen) applyFilter() {
 	s.updateTable()
 }
 
-// updateTable rebuilds table rows from filtered items
+// updateTable rebuilds table rows from filtered items (changed rows marked
+// and deleted rows appended, see highlight.go)
 func (s *ConfigScreen) updateTable() {
-	rows := make([]table.Row, len(s.filtered))
+	now := time.Now()
+	items := s.filtered
+	if s.filter == "" {
+		if deleted := s.changes.deletedRows(now); len(deleted) > 0 {
+			items = append(append(make([]interface{}, 0, len(s.filtered)+len(deleted)), s.filtered...), deleted...)
+		}
+	}
+	columns := s.table.Columns()
+
+	rows := make([]table.Row, len(items))
+	for i, item := range items {
+		change := s.changes.activeChange(getResourceKey(item), now)
 
-	for i, item := range s.filtered {
 		// Use visibleColumns instead of s.config.Columns
 		row := make(table.Row, len(s.visibleColumns))
 		for j, col := range s.visibleColumns {
-			val := getFieldValue(item, col.Field)
-
-			// Apply custom formatter if provided
-			if col.Format != nil {
-				row[j] = col.Format(val)
-			} else {
-				row[j] = fmt.Sprint(val)
+			row[j] = col.FormatValue(item)
+			if change != nil && j < len(columns) {
+				row[j] = markCell(row[j], columns[j].Width, change, col.Field)
 			}
 		}
 		rows[i] = row
@@ -1148,11 +1341,82 @@ func (s *ConfigScreen) GetSelectedResource() map[string]interface{} {
 func (s *ConfigScreen) handleEnterKey() tea.Cmd {
 	// Delegate to configured navigation handler
 	if s.config.NavigationHandler != nil {
-		return s.config.NavigationHandler(s)
+		return s.navigate(s.config.NavigationHandler)
 	}
 	return nil
 }
 
+// navigate runs a navigation handler on the selected row
+func (s *ConfigScreen) navigate(handler NavigationFunc) tea.Cmd {
+	// Drill-down screens read the active context only
+	if contextName := s.selectedContext(); contextName != "" && contextName != s.repo.GetContext() {
+		return func() tea.Msg {
+			return types.ErrorStatusMsg(fmt.Sprintf("Selected resource is in context %s (switch with /context %s)", contextName, contextName))
+		}
+	}
+	return handler(s)
+}
+
+// contextColumnField is the field of the column added to list screens in
+// aggregated (all contexts) mode
+const contextColumnField = "Context"
+
+// isAggregated returns true if the screen merges all loaded contexts
+// (the repository is a pool in aggregated mode and the list is unfiltered)
+func (s *ConfigScreen) isAggregated() bool {
+	pool, ok := s.repo.(interface{ IsAggregated() bool })
+	return ok && pool.IsAggregated() && s.filterContext == nil
+}
+
+// selectedContext returns the context of the selected row ("" outside
+// aggregated mode)
+func (s *ConfigScreen) selectedContext() string {
+	cursor := s.table.Cursor()
+	if cursor < 0 || cursor >= len(s.filtered) {
+		return ""
+	}
+	contextName, _ := getFieldValue(s.filtered[cursor], contextColumnField).(string)
+	return contextName
+}
+
+// syncContextColumn adds the Context column (and makes it searchable) in
+// aggregated mode and removes it otherwise
+func (s *ConfigScreen) syncContextColumn() {
+	aggregated := s.isAggregated()
+	hasColumn := len(s.config.Columns) > 0 && s.config.Columns[0].Field == contextColumnField
+	if aggregated == hasColumn {
+		return
+	}
+	// Row keys change with the Context column
+	s.resetChanges()
+
+	if aggregated {
+		column := ColumnConfig{Field: contextColumnField, Title: "Context", Width: 16, Priority: 1}
+		for _, col := range s.config.Columns {
+			if col.Weight > 0 {
+				column = ColumnConfig{Field: contextColumnField, Title: "Context", MinWidth: 12, MaxWidth: 24, Weight: 1, Priority: 1}
+				break
+			}
+		}
+		s.config.Columns = append([]ColumnConfig{column}, s.config.Columns...)
+		s.config.SearchFields = append(append([]string{}, s.config.SearchFields...), contextColumnField)
+	} else {
+		s.config.Columns = s.config.Columns[1:]
+		searchFields := make([]string, 0, len(s.config.SearchFields))
+		for _, field := range s.config.SearchFields {
+			if field != contextColumnField {
+				searchFields = append(searchFields, field)
+			}
+		}
+		s.config.SearchFields = searchFields
+	}
+
+	// Rebuild visible columns and widths
+	if s.width > 0 {
+		s.SetSize(s.width, s.height)
+	}
+}
+
 // Helper functions
 
 // getFieldValue extracts a field value from an interface{} using reflection
@@ -1233,10 +1497,40 @@ func (s *ConfigScreen) restoreCursorPosition() {
 	}
 }
 
-// getResourceKey generates a unique key for a resource (namespace/name)
+// SelectResource moves the cursor to the resource with the given key
+// (namespace/name), now if it is already listed and again after the next
+// refresh (the screen may still be loading)
+func (s *ConfigScreen) SelectResource(key string) {
+	s.pendingSelectKey = key
+	s.selectResourceByKey(key)
+}
+
+// selectResourceByKey moves the cursor to the resource with the given key
+// (a namespace/name key matches the resource of the active context in
+// aggregated lists)
+func (s *ConfigScreen) selectResourceByKey(key string) {
+	if s.isAggregated() && !strings.Contains(key, "@") {
+		key = s.repo.GetContext() + "@" + key
+	}
+	for i, item := range s.filtered {
+		if getResourceKey(item) == key {
+			s.table.SetCursor(i)
+			if s.config.TrackSelection {
+				s.selectedKey = key
+			}
+			return
+		}
+	}
+}
+
+// getResourceKey generates a unique key for a resource (namespace/name,
+// prefixed with context@ in aggregated lists)
 func getResourceKey(item interface{}) string {
 	namespace := fmt.Sprint(getFieldValue(item, "Namespace"))
 	name := fmt.Sprint(getFieldValue(item, "Name"))
+	if contextName, _ := getFieldValue(item, contextColumnField).(string); contextName != "" {
+		return fmt.Sprintf("%s@%s/%s", contextName, namespace, name)
+	}
 	return fmt.Sprintf("%s/%s", namespace, name)
 }
 
@@ -1294,6 +1588,18 @@ func FormatTime(val interface{}) string {
 	return t.Format("15:04:05")
 }
 
+// FormatDateTime formats a timestamp as YYYY-MM-DD HH:MM:SS (local time)
+func FormatDateTime(val interface{}) string {
+	t, ok := val.(time.Time)
+	if !ok {
+		return fmt.Sprint(val)
+	}
+	if t.IsZero() {
+		return "<none>"
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
+
 // FormatStatus converts status string to icon
 func FormatStatus(val interface{}) string {
 	status, ok := val.(string)
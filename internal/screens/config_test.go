@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/renato0307/k1/internal/k8s"
 	"github.com/renato0307/k1/internal/types"
 	"github.com/renato0307/k1/internal/ui"
@@ -11,6 +12,21 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// refreshedConfigScreen builds a screen, applies the filter context (if any)
+// and runs a first refresh, which must complete
+func refreshedConfigScreen(t *testing.T, cfg ScreenConfig, repo k8s.Repository, filterCtx *types.FilterContext) *ConfigScreen {
+	t.Helper()
+	screen := NewConfigScreen(cfg, repo, ui.GetTheme("charm"))
+	if filterCtx != nil {
+		screen.ApplyFilterContext(filterCtx)
+	}
+
+	msg := screen.Refresh()()
+	_, ok := msg.(types.RefreshCompleteMsg)
+	require.True(t, ok, "refresh should complete, got %T", msg)
+	return screen
+}
+
 func TestNewConfigScreen(t *testing.T) {
 	cfg := ScreenConfig{
 		ID:           "test",
@@ -1037,6 +1053,53 @@ func TestConfigScreen_NavigateToPodsUsingConfigMap(t *testing.T) {
 	assert.Equal(t, "ConfigMap", switchMsg.FilterContext.Metadata["kind"])
 }
 
+func TestConfigScreen_NavigateToConfigMapData(t *testing.T) {
+	screen := NewConfigScreen(GetConfigMapsScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.items = []interface{}{
+		k8s.ConfigMap{ResourceMetadata: k8s.ResourceMetadata{Namespace: "default", Name: "app-config"}},
+	}
+	screen.applyFilter()
+	screen.table.SetCursor(0)
+
+	cmd := screen.config.NavigationHandler(screen)
+	require.NotNil(t, cmd)
+
+	switchMsg, ok := cmd().(types.ScreenSwitchMsg)
+	require.True(t, ok, "expected ScreenSwitchMsg")
+
+	assert.Equal(t, ConfigMapDataScreenID, switchMsg.ScreenID)
+	require.NotNil(t, switchMsg.FilterContext)
+	assert.Equal(t, "data", switchMsg.FilterContext.Field)
+	assert.Equal(t, "app-config", switchMsg.FilterContext.Value)
+	assert.Equal(t, "default", switchMsg.FilterContext.Metadata["namespace"])
+	assert.Equal(t, "ConfigMap", switchMsg.FilterContext.Metadata["kind"])
+}
+
+func TestConfigScreen_SelectResource(t *testing.T) {
+	screen := NewConfigScreen(GetConfigMapsScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.items = []interface{}{
+		k8s.ConfigMap{ResourceMetadata: k8s.ResourceMetadata{Namespace: "default", Name: "app-config"}},
+		k8s.ConfigMap{ResourceMetadata: k8s.ResourceMetadata{Namespace: "default", Name: "db-config"}},
+	}
+	screen.applyFilter()
+
+	// Already listed: selected immediately
+	screen.SelectResource("default/db-config")
+	assert.Equal(t, 1, screen.table.Cursor())
+
+	// Not listed yet: selected after the next refresh, then forgotten
+	screen.table.SetCursor(0)
+	screen.SelectResource("web/web-config")
+	assert.Equal(t, 0, screen.table.Cursor())
+
+	screen.items = append(screen.items,
+		k8s.ConfigMap{ResourceMetadata: k8s.ResourceMetadata{Namespace: "web", Name: "web-config"}})
+	screen.applyFilter()
+	screen.Update(types.RefreshCompleteMsg{})
+	assert.Equal(t, 2, screen.table.Cursor())
+	assert.Empty(t, screen.pendingSelectKey)
+}
+
 func TestConfigScreen_NavigateToPodsUsingSecret(t *testing.T) {
 	cfg := ScreenConfig{
 		ID:           "secrets",
@@ -1395,3 +1458,140 @@ func TestConfigScreen_PeriodicRefreshContinuesAfterTick(t *testing.T) {
 	// The cmd should be a batch of Refresh + next tick
 	// We can't easily test the batch contents, but verify cmd exists
 }
+
+func TestConfigScreen_AggregatedContexts(t *testing.T) {
+	pool, err := k8s.NewRepositoryPoolFromRepos(map[string]k8s.Repository{
+		"alpha": k8s.NewDummyRepository(),
+		"zulu":  k8s.NewDummyRepository(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, pool.SetActive("alpha"))
+	require.NoError(t, pool.SetAggregated(true, nil))
+
+	screen := NewConfigScreen(GetPodsScreenConfig(), pool, ui.GetTheme("charm"))
+	screen.SetSize(200, 40)
+	msg := screen.Refresh()()
+	_, ok := msg.(types.RefreshCompleteMsg)
+	require.True(t, ok, "expected RefreshCompleteMsg, got %T", msg)
+
+	// Context column comes first and rows carry their context
+	require.Len(t, screen.filtered, 8)
+	assert.Equal(t, "Context", screen.visibleColumns[0].Title)
+	assert.Equal(t, "alpha", screen.table.Rows()[0][0])
+	assert.Equal(t, "zulu", screen.table.Rows()[1][0])
+	assert.Equal(t, "zulu@"+getResourceKey(screen.filtered[0])[len("alpha@"):], getResourceKey(screen.filtered[1]))
+
+	// Context is searchable
+	screen.SetFilter("zulu")
+	for _, item := range screen.filtered {
+		assert.Equal(t, "zulu", item.(k8s.Pod).Context)
+	}
+	screen.SetFilter("")
+
+	// Drill-down is limited to the active context
+	screen.table.SetCursor(1)
+	cmd := screen.handleEnterKey()
+	require.NotNil(t, cmd)
+	statusMsg, ok := cmd().(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg")
+	assert.Equal(t, types.MessageTypeError, statusMsg.Type)
+
+	screen.table.SetCursor(0)
+	cmd = screen.handleEnterKey()
+	require.NotNil(t, cmd)
+	_, ok = cmd().(types.ScreenSwitchMsg)
+	assert.True(t, ok, "expected ScreenSwitchMsg")
+
+	// Select keys without context match the active context
+	screen.SelectResource("default/nginx-deployment-7d64f8d9c8-abc12")
+	assert.Equal(t, "alpha", screen.selectedContext())
+
+	// Leaving aggregated mode removes the column
+	require.NoError(t, pool.SetAggregated(false, nil))
+	screen.Refresh()()
+	require.Len(t, screen.filtered, 4)
+	assert.NotEqual(t, "Context", screen.visibleColumns[0].Title)
+	assert.NotContains(t, screen.config.SearchFields, "Context")
+}
+
+func TestConfigScreen_AggregatedDrillDownReadsActiveContext(t *testing.T) {
+	pool, err := k8s.NewRepositoryPoolFromRepos(map[string]k8s.Repository{
+		"alpha": &storageRepository{DummyRepository: k8s.NewDummyRepository()},
+		"zulu":  &storageRepository{DummyRepository: k8s.NewDummyRepository()},
+	})
+	require.NoError(t, err)
+	require.NoError(t, pool.SetActive("alpha"))
+	require.NoError(t, pool.SetAggregated(true, nil))
+	theme := ui.GetTheme("charm")
+
+	// Objects with the same name in other contexts are not listed
+	screen := NewConfigScreen(GetDeploymentsScreenConfig(), pool, theme)
+	screen.ApplyFilterContext(&types.FilterContext{Field: "name", Value: "coredns", Metadata: map[string]string{"kind": "Deployment", "namespace": "kube-system"}})
+	screen.SetSize(200, 40)
+	msg := screen.Refresh()()
+	_, ok := msg.(types.RefreshCompleteMsg)
+	require.True(t, ok, "expected RefreshCompleteMsg, got %T", msg)
+	require.Len(t, screen.filtered, 1)
+	assert.NotEqual(t, "Context", screen.visibleColumns[0].Title)
+
+	screen = NewConfigScreen(GetPVCsScreenConfig(), pool, theme)
+	screen.ApplyFilterContext(&types.FilterContext{Field: "storageclass", Value: "gp3", Metadata: map[string]string{"kind": "StorageClass"}})
+	items, err := screen.refreshWithFilterContext()
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+
+	// alt+enter is limited to the active context like enter
+	screen = NewConfigScreen(GetPVCsScreenConfig(), pool, theme)
+	screen.items = []interface{}{
+		k8s.PersistentVolumeClaim{ResourceMetadata: k8s.ResourceMetadata{Namespace: "web", Name: "data", Context: "zulu"}, Volume: "pv-1"},
+	}
+	screen.applyFilter()
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true})
+	require.NotNil(t, cmd)
+	statusMsg, ok := cmd().(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg")
+	assert.Contains(t, statusMsg.Message, "context zulu")
+}
+
+// storageRepository lists PVCs and volume attachments
+type storageRepository struct {
+	*k8s.DummyRepository
+}
+
+func (r *storageRepository) GetResources(resourceType k8s.ResourceType) ([]any, error) {
+	switch resourceType {
+	case k8s.ResourceTypePersistentVolumeClaim:
+		return []any{
+			k8s.PersistentVolumeClaim{ResourceMetadata: k8s.ResourceMetadata{Namespace: "web", Name: "data"}, StorageClass: "gp3"},
+			k8s.PersistentVolumeClaim{ResourceMetadata: k8s.ResourceMetadata{Namespace: "web", Name: "cache"}, StorageClass: "standard"},
+		}, nil
+	case k8s.ResourceTypeVolumeAttachment:
+		return []any{
+			k8s.VolumeAttachment{ResourceMetadata: k8s.ResourceMetadata{Name: "csi-1"}, PersistentVolume: "pv-1", Node: "node-1"},
+			k8s.VolumeAttachment{ResourceMetadata: k8s.ResourceMetadata{Name: "csi-2"}, PersistentVolume: "pv-2", Node: "node-1"},
+		}, nil
+	}
+	return r.DummyRepository.GetResources(resourceType)
+}
+
+func TestConfigScreen_RefreshWithReference(t *testing.T) {
+	repo := &storageRepository{DummyRepository: k8s.NewDummyRepository()}
+	theme := ui.GetTheme("charm")
+
+	// StorageClass → PVCs
+	screen := NewConfigScreen(GetPVCsScreenConfig(), repo, theme)
+	screen.ApplyFilterContext(&types.FilterContext{Field: "storageclass", Value: "gp3", Metadata: map[string]string{"kind": "StorageClass"}})
+	items, err := screen.refreshWithFilterContext()
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "data", items[0].(k8s.PersistentVolumeClaim).Name)
+	assert.Equal(t, "filtered by storageclass: gp3", screen.GetFilterContext().Description())
+
+	// PersistentVolume → VolumeAttachments
+	screen = NewConfigScreen(GetVolumeAttachmentsScreenConfig(), repo, theme)
+	screen.ApplyFilterContext(&types.FilterContext{Field: "pv", Value: "pv-2", Metadata: map[string]string{"kind": "PersistentVolume"}})
+	items, err = screen.refreshWithFilterContext()
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "csi-2", items[0].(k8s.VolumeAttachment).Name)
+}
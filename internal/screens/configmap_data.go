@@ -0,0 +1,252 @@
+package screens
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/commands"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// ConfigMapDataScreenID is the screen identifier for the ConfigMap key browser
+const ConfigMapDataScreenID = "configmap-data"
+
+// ConfigMapDataEntry is a row of the ConfigMap key browser (one per data key)
+type ConfigMapDataEntry struct {
+	Key       string
+	Format    string
+	Size      string
+	Value     string // Single-line preview
+	Namespace string // Owning ConfigMap (not shown, used by /export-key)
+	ConfigMap string
+	Context   string // Context of the ConfigMap row (policies of /edit-key)
+}
+
+// configMapDataState holds the payload of the ConfigMap currently shown.
+// Payloads live only here (never in the informer cache).
+type configMapDataState struct {
+	namespace string
+	name      string
+	context   string
+	data      map[string][]byte
+	binary    map[string]bool // Keys of binaryData
+}
+
+// configMapKeyEditedMsg is sent when the editor opened on a key exits
+type configMapKeyEditedMsg struct {
+	namespace string
+	name      string
+	key       string
+	binary    bool // The key is in binaryData
+	original  []byte
+	path      string
+	err       error
+}
+
+// GetConfigMapDataScreenConfig returns the config for the ConfigMap key
+// browser. The ConfigMap to show is passed as a FilterContext (Field "data").
+func GetConfigMapDataScreenConfig() ScreenConfig {
+	state := &configMapDataState{}
+
+	return ScreenConfig{
+		ID:           ConfigMapDataScreenID,
+		Title:        "ConfigMap Data",
+		ResourceType: k8s.ResourceType(ConfigMapDataScreenID),
+		Columns: []ColumnConfig{
+			{Field: "Key", Title: "Key", MinWidth: 15, MaxWidth: 40, Weight: 1.0, Priority: 1},
+			{Field: "Format", Title: "Format", MinWidth: 10, MaxWidth: 20, Weight: 0.5, Priority: 2},
+			{Field: "Size", Title: "Size", MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 3},
+			{Field: "Value", Title: "Value", MinWidth: 20, MaxWidth: 120, Weight: 3.0, Priority: 1},
+		},
+		SearchFields: []string{"Key", "Format"},
+		Operations: []OperationConfig{
+			{ID: "view", Name: "View", Description: "View selected value", Shortcut: "enter"},
+			{ID: "edit", Name: "Edit", Description: "Edit selected value in $EDITOR", Shortcut: "e"},
+		},
+		NavigationHandler: viewConfigMapValue(state),
+		CustomRefresh: func(s *ConfigScreen) tea.Cmd {
+			return func() tea.Msg {
+				start := time.Now()
+				if s.filterContext == nil || s.repo == nil {
+					s.items = []interface{}{}
+					s.applyFilter()
+					return types.RefreshCompleteMsg{Duration: 0}
+				}
+
+				namespace := s.filterContext.Metadata["namespace"]
+				name := s.filterContext.Value
+				data, err := s.repo.GetConfigMapData(namespace, name)
+				if err != nil {
+					return types.ErrorStatusMsg(fmt.Sprintf("Failed to fetch configmap: %v", err))
+				}
+
+				state.namespace = namespace
+				state.name = name
+				state.context = s.filterContext.Metadata["context"]
+				state.data = data.Values
+				state.binary = data.Binary
+
+				s.items = state.entries()
+				s.applyFilter()
+				return types.RefreshCompleteMsg{Duration: time.Since(start)}
+			}
+		},
+		CustomUpdate: func(s *ConfigScreen, msg tea.Msg) (tea.Model, tea.Cmd) {
+			switch msg := msg.(type) {
+			case types.EditConfigMapKeyMsg:
+				return s, editConfigMapValue(state, msg.Key)
+			case configMapKeyEditedMsg:
+				return s, applyConfigMapEdit(s, msg)
+			}
+			return s.DefaultUpdate(msg)
+		},
+	}
+}
+
+// entries builds table rows from the payload (sorted by key)
+func (st *configMapDataState) entries() []interface{} {
+	keys := make([]string, 0, len(st.data))
+	for key := range st.data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	items := make([]interface{}, len(keys))
+	for i, key := range keys {
+		value := st.data[key]
+		items[i] = ConfigMapDataEntry{
+			Key:       key,
+			Format:    summarizeData(key, value),
+			Size:      formatDataSize(len(value)),
+			Value:     previewData(key, value),
+			Namespace: st.namespace,
+			ConfigMap: st.name,
+			Context:   st.context,
+		}
+	}
+	return items
+}
+
+// viewConfigMapValue opens the selected value in the full-screen viewer,
+// labelled with its detected syntax (YAML values are highlighted)
+func viewConfigMapValue(st *configMapDataState) NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		key := selectedDataKey(s)
+		value, ok := st.data[key]
+		if key == "" || !ok {
+			return nil
+		}
+
+		format := detectDataFormat(key, value)
+		viewType := 3 // Data
+		if format == dataFormatYAML {
+			viewType = 0 // YAML (syntax highlighted)
+		}
+		displayName := strings.Join([]string{st.namespace, st.name, key}, "/")
+		if syntax, ok := dataFormatNames[format]; ok {
+			displayName += " (" + syntax + ")"
+		}
+
+		content := prettyPrintData(key, value)
+		return func() tea.Msg {
+			return types.ShowFullScreenMsg{
+				ViewType:     viewType,
+				ResourceName: displayName,
+				Content:      content,
+			}
+		}
+	}
+}
+
+// editorCommand returns the user's editor ($KUBE_EDITOR, then $EDITOR,
+// falling back to vi like kubectl edit)
+func editorCommand(path string) *exec.Cmd {
+	editor := os.Getenv("KUBE_EDITOR")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	fields := strings.Fields(editor)
+	if len(fields) == 0 {
+		fields = []string{"vi"}
+	}
+	return exec.Command(fields[0], append(fields[1:], path)...)
+}
+
+// editConfigMapValue writes the value of a key to a temporary file and opens
+// it in the user's editor (the TUI is suspended until the editor exits)
+func editConfigMapValue(st *configMapDataState, key string) tea.Cmd {
+	value, ok := st.data[key]
+	if key == "" || !ok {
+		return nil
+	}
+	if detectDataFormat(key, value) == dataFormatBinary {
+		return func() tea.Msg {
+			return types.ErrorStatusMsg(fmt.Sprintf("Key %s holds binary data and cannot be edited", key))
+		}
+	}
+
+	// Keep the key's extension so editors pick the right syntax
+	file, err := os.CreateTemp("", "k1-"+st.name+"-*-"+filepath.Base(key))
+	if err != nil {
+		return func() tea.Msg {
+			return types.ErrorStatusMsg(fmt.Sprintf("Failed to create temp file: %v", err))
+		}
+	}
+	path := file.Name()
+	_, writeErr := file.Write(value)
+	closeErr := file.Close()
+	if writeErr != nil || closeErr != nil {
+		_ = os.Remove(path)
+		return func() tea.Msg {
+			return types.ErrorStatusMsg(fmt.Sprintf("Failed to write temp file %s", path))
+		}
+	}
+
+	edited := configMapKeyEditedMsg{
+		namespace: st.namespace,
+		name:      st.name,
+		key:       key,
+		binary:    st.binary[key],
+		original:  value,
+		path:      path,
+	}
+	return tea.ExecProcess(editorCommand(path), func(err error) tea.Msg {
+		edited.err = err
+		return edited
+	})
+}
+
+// applyConfigMapEdit patches the edited key (only when its value changed)
+// and refreshes the key list
+func applyConfigMapEdit(s *ConfigScreen, msg configMapKeyEditedMsg) tea.Cmd {
+	defer os.Remove(msg.path)
+
+	if msg.err != nil {
+		return func() tea.Msg {
+			return types.ErrorStatusMsg(fmt.Sprintf("Editor failed: %v", msg.err))
+		}
+	}
+	updated, err := os.ReadFile(msg.path)
+	if err != nil {
+		return func() tea.Msg {
+			return types.ErrorStatusMsg(fmt.Sprintf("Failed to read edited value: %v", err))
+		}
+	}
+	if string(updated) == string(msg.original) {
+		return func() tea.Msg {
+			return types.InfoMsg(fmt.Sprintf("No changes to %s", msg.key))
+		}
+	}
+
+	return tea.Sequence(
+		commands.PatchConfigMapKeyCmd(s.repo, msg.namespace, msg.name, msg.key, updated, msg.binary),
+		s.Refresh(),
+	)
+}
@@ -0,0 +1,147 @@
+package screens
+
+import (
+	"os"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestConfigMapDataScreen opens the key browser on a dummy configmap
+func newTestConfigMapDataScreen(t *testing.T) *ConfigScreen {
+	t.Helper()
+	return refreshedConfigScreen(t, GetConfigMapDataScreenConfig(), k8s.NewDummyRepository(), &types.FilterContext{
+		Field:    "data",
+		Value:    "app-config",
+		Metadata: map[string]string{"namespace": "default", "kind": "ConfigMap"},
+	})
+}
+
+// findConfigMapEntry returns the row for a key
+func findConfigMapEntry(t *testing.T, screen *ConfigScreen, key string) (int, ConfigMapDataEntry) {
+	t.Helper()
+	for i, item := range screen.filtered {
+		if entry := item.(ConfigMapDataEntry); entry.Key == key {
+			return i, entry
+		}
+	}
+	t.Fatalf("key %s not found", key)
+	return -1, ConfigMapDataEntry{}
+}
+
+func TestConfigMapDataScreen_DetectsSyntax(t *testing.T) {
+	screen := newTestConfigMapDataScreen(t)
+	require.Len(t, screen.filtered, 5)
+
+	expected := map[string]string{
+		"app.yaml":       "YAML",
+		"app.properties": "properties",
+		"entrypoint.sh":  "shell",
+		"settings.json":  "JSON",
+		"LOG_LEVEL":      "text",
+	}
+	for key, format := range expected {
+		_, entry := findConfigMapEntry(t, screen, key)
+		assert.Equal(t, format, entry.Format, key)
+		assert.Equal(t, "app-config", entry.ConfigMap)
+		assert.Equal(t, "default", entry.Namespace)
+	}
+
+	_, entry := findConfigMapEntry(t, screen, "app.yaml")
+	assert.Equal(t, "server:⏎  port: 8080⏎  debug: false", entry.Value)
+}
+
+func TestConfigMapDataScreen_EnterOpensViewer(t *testing.T) {
+	screen := newTestConfigMapDataScreen(t)
+
+	tests := []struct {
+		key          string
+		viewType     int
+		resourceName string
+		content      string
+	}{
+		{"app.yaml", 0, "default/app-config/app.yaml (YAML)", "port: 8080"},
+		{"settings.json", 3, "default/app-config/settings.json (JSON)", "\"replicas\": 3"},
+		{"LOG_LEVEL", 3, "default/app-config/LOG_LEVEL", "info"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			index, _ := findConfigMapEntry(t, screen, tt.key)
+			screen.table.SetCursor(index)
+
+			_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+			require.NotNil(t, cmd)
+
+			msg, ok := cmd().(types.ShowFullScreenMsg)
+			require.True(t, ok)
+			assert.Equal(t, tt.viewType, msg.ViewType)
+			assert.Equal(t, tt.resourceName, msg.ResourceName)
+			assert.Contains(t, msg.Content, tt.content)
+		})
+	}
+}
+
+func TestEditorCommand(t *testing.T) {
+	t.Setenv("KUBE_EDITOR", "")
+	t.Setenv("EDITOR", "code --wait")
+	cmd := editorCommand("/tmp/value.yaml")
+	assert.Equal(t, []string{"code", "--wait", "/tmp/value.yaml"}, cmd.Args)
+
+	t.Setenv("KUBE_EDITOR", "nano")
+	assert.Equal(t, []string{"nano", "/tmp/value.yaml"}, editorCommand("/tmp/value.yaml").Args)
+
+	t.Setenv("KUBE_EDITOR", "")
+	t.Setenv("EDITOR", "")
+	assert.Equal(t, []string{"vi", "/tmp/value.yaml"}, editorCommand("/tmp/value.yaml").Args)
+}
+
+func TestApplyConfigMapEdit_Unchanged(t *testing.T) {
+	screen := newTestConfigMapDataScreen(t)
+	path := t.TempDir() + "/LOG_LEVEL"
+	require.NoError(t, os.WriteFile(path, []byte("info"), 0o600))
+
+	cmd := applyConfigMapEdit(screen, configMapKeyEditedMsg{
+		namespace: "default",
+		name:      "app-config",
+		key:       "LOG_LEVEL",
+		original:  []byte("info"),
+		path:      path,
+	})
+	require.NotNil(t, cmd)
+
+	msg, ok := cmd().(types.StatusMsg)
+	require.True(t, ok)
+	assert.Equal(t, "No changes to LOG_LEVEL", msg.Message)
+	assert.NoFileExists(t, path, "temp file should be removed")
+}
+
+func TestConfigMapDataScreen_EditKeyMsg(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	screen := newTestConfigMapDataScreen(t)
+
+	_, cmd := screen.Update(types.EditConfigMapKeyMsg{Key: "LOG_LEVEL"})
+	assert.NotNil(t, cmd, "editor should open")
+
+	_, cmd = screen.Update(types.EditConfigMapKeyMsg{Key: "missing"})
+	assert.Nil(t, cmd)
+}
+
+func TestConfigMapDataScreen_RowsKeepContext(t *testing.T) {
+	screen := NewConfigScreen(GetConfigMapDataScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.ApplyFilterContext(&types.FilterContext{
+		Field:    "data",
+		Value:    "app-config",
+		Metadata: map[string]string{"namespace": "default", "kind": "ConfigMap", "context": "prod-eu"},
+	})
+	screen.Refresh()()
+
+	// /edit-key applies the policy of the ConfigMap's context
+	require.NotEmpty(t, screen.filtered)
+	assert.Equal(t, "prod-eu", screen.GetSelectedResource()["context"])
+}
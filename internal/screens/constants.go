@@ -13,6 +13,11 @@ const (
 	// 30 seconds is sufficient since contexts don't change often.
 	ContextsRefreshInterval = 30 * time.Second
 
+	// ChangeHighlightDuration is how long changed, new and deleted rows stay
+	// highlighted after a refresh. Half the refresh interval: long enough to
+	// notice, gone before the next refresh brings other changes.
+	ChangeHighlightDuration = 5 * time.Second
+
 	// ScreenPaddingLines is the additional padding added to screen layouts
 	// for visual breathing room and consistent spacing.
 	ScreenPaddingLines = 15
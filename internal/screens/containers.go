@@ -0,0 +1,71 @@
+package screens
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// ContainersScreenID is the screen identifier for the containers of a pod
+const ContainersScreenID = string(k8s.ResourceTypeContainer)
+
+// GetContainersScreenConfig returns the config for the containers of a pod
+// (init, sidecar, regular and ephemeral). The pod is passed as a
+// FilterContext (Field "pod"). Pod commands (logs, shell, port-forward,
+// logs-previous) apply to the selected container.
+func GetContainersScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           ContainersScreenID,
+		Title:        "Containers",
+		ResourceType: k8s.ResourceTypeContainer,
+		Columns: []ColumnConfig{
+			{Field: "Name", Title: "Name", Width: 24, Priority: 1},
+			{Field: "Type", Title: "Type", Width: 9, Priority: 1},
+			{Field: "Image", Title: "Image", Width: 0, Priority: 1},
+			{Field: "Ready", Title: "Ready", Width: 6, Priority: 1},
+			{Field: "State", Title: "State", Width: 28, Priority: 1},
+			{Field: "Restarts", Title: "Restarts", Width: 8, Priority: 1},
+			{Field: "LastTermination", Title: "Last Termination", Width: 20, Priority: 2},
+			{Field: "Ports", Title: "Ports", Width: 16, Priority: 3},
+			{Field: "Requests", Title: "Req (CPU/Mem)", Width: 14, Priority: 3},
+			{Field: "Limits", Title: "Lim (CPU/Mem)", Width: 14, Priority: 3},
+		},
+		SearchFields: []string{"Name", "Type", "Image", "State", "LastTermination"},
+		Operations: []OperationConfig{
+			{ID: "logs", Name: "View Logs", Description: "View logs for selected container", Shortcut: "l"},
+			{ID: "logs-previous", Name: "Previous Logs", Description: "View logs of previous instance of selected container", Shortcut: "/logs-previous"},
+			{ID: "shell", Name: "Shell", Description: "Open shell in selected container", Shortcut: "/shell"},
+		},
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomRefresh: func(s *ConfigScreen) tea.Cmd {
+			return func() tea.Msg {
+				start := time.Now()
+				if s.filterContext == nil || s.repo == nil {
+					s.items = []interface{}{}
+					s.applyFilter()
+					return types.RefreshCompleteMsg{Duration: 0}
+				}
+
+				namespace := s.filterContext.Metadata["namespace"]
+				containers, err := s.repo.GetContainersForPod(namespace, s.filterContext.Value)
+				if err != nil {
+					return types.ErrorStatusMsg(fmt.Sprintf("Failed to get containers: %v", err))
+				}
+
+				items := make([]interface{}, len(containers))
+				for i, container := range containers {
+					items[i] = container
+				}
+				s.items = items
+				s.applyFilter()
+				return types.RefreshCompleteMsg{Duration: time.Since(start)}
+			}
+		},
+		CustomUpdate: getPeriodicRefreshUpdate(),
+	}
+}
@@ -0,0 +1,42 @@
+package screens
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+)
+
+func TestContainersScreen_Refresh(t *testing.T) {
+	screen := NewConfigScreen(GetContainersScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.ApplyFilterContext(&types.FilterContext{
+		Field:    "pod",
+		Value:    "web-0",
+		Metadata: map[string]string{"namespace": "shop", "kind": "Pod"},
+	})
+
+	msg := screen.Refresh()()
+	_, ok := msg.(types.RefreshCompleteMsg)
+	require.True(t, ok, "expected RefreshCompleteMsg, got %T", msg)
+	require.Len(t, screen.items, 3)
+
+	screen.table.SetCursor(1)
+	selected := screen.GetSelectedResource()
+	assert.Equal(t, "app", selected["name"])
+	assert.Equal(t, "web-0", selected["pod"])
+	assert.Equal(t, "shop", selected["namespace"])
+	assert.Equal(t, "Waiting: CrashLoopBackOff", selected["state"])
+}
+
+func TestContainersScreen_NoFilterContext(t *testing.T) {
+	screen := NewConfigScreen(GetContainersScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+
+	msg := screen.Refresh()()
+	_, ok := msg.(types.RefreshCompleteMsg)
+	require.True(t, ok)
+	assert.Empty(t, screen.items)
+}
@@ -0,0 +1,333 @@
+package screens
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"sigs.k8s.io/yaml"
+)
+
+// dataFormat identifies the detected format of a Secret/ConfigMap value
+type dataFormat int
+
+const (
+	dataFormatText dataFormat = iota
+	dataFormatJSON
+	dataFormatPEM
+	dataFormatDockerConfig
+	dataFormatBinary
+	dataFormatYAML
+	dataFormatProperties
+	dataFormatShell
+)
+
+// dataFormatNames are the display names of formats detected by syntax
+// (shown in the Format column and the full-screen viewer title)
+var dataFormatNames = map[dataFormat]string{
+	dataFormatJSON:       "JSON",
+	dataFormatYAML:       "YAML",
+	dataFormatProperties: "properties",
+	dataFormatShell:      "shell",
+}
+
+// dataFormatExtensions maps key file extensions to formats (ConfigMap keys
+// are usually file names mounted into pods)
+var dataFormatExtensions = map[string]dataFormat{
+	".yaml":       dataFormatYAML,
+	".yml":        dataFormatYAML,
+	".properties": dataFormatProperties,
+	".env":        dataFormatProperties,
+	".sh":         dataFormatShell,
+	".bash":       dataFormatShell,
+}
+
+// propertiesLine matches a key=value line of a properties or env file
+var propertiesLine = regexp.MustCompile(`^[A-Za-z0-9_.\-]+\s*=`)
+
+// dockerConfigKeys are the keys kubernetes.io/dockerconfigjson and
+// kubernetes.io/dockercfg secrets store their registry credentials under
+var dockerConfigKeys = map[string]bool{
+	".dockerconfigjson": true,
+	".dockercfg":        true,
+}
+
+// detectDataFormat guesses the format of a value from its key and content
+func detectDataFormat(key string, value []byte) dataFormat {
+	trimmed := bytes.TrimSpace(value)
+
+	if dockerConfigKeys[key] && json.Valid(trimmed) {
+		return dataFormatDockerConfig
+	}
+	if bytes.HasPrefix(trimmed, []byte("-----BEGIN ")) {
+		if block, _ := pem.Decode(trimmed); block != nil {
+			return dataFormatPEM
+		}
+	}
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		return dataFormatJSON
+	}
+	if !utf8.Valid(value) {
+		return dataFormatBinary
+	}
+	if format, ok := dataFormatExtensions[strings.ToLower(path.Ext(key))]; ok {
+		return format
+	}
+	return detectTextSyntax(trimmed)
+}
+
+// detectTextSyntax guesses the syntax of a multi-line text value from its
+// content (single-line values are left as plain text)
+func detectTextSyntax(trimmed []byte) dataFormat {
+	if bytes.HasPrefix(trimmed, []byte("#!")) {
+		return dataFormatShell
+	}
+	if !bytes.Contains(trimmed, []byte("\n")) {
+		return dataFormatText
+	}
+
+	properties := true
+	for _, line := range strings.Split(string(trimmed), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		if !propertiesLine.MatchString(line) {
+			properties = false
+			break
+		}
+	}
+	if properties {
+		return dataFormatProperties
+	}
+
+	var document map[string]interface{}
+	if err := yaml.Unmarshal(trimmed, &document); err == nil && len(document) > 0 {
+		return dataFormatYAML
+	}
+	return dataFormatText
+}
+
+// summarizeData returns a short, non-sensitive description of a value
+// (safe to show while the value itself is masked)
+func summarizeData(key string, value []byte) string {
+	format := detectDataFormat(key, value)
+	switch format {
+	case dataFormatDockerConfig:
+		registries := dockerConfigRegistries(value)
+		if len(registries) == 0 {
+			return "dockerconfig"
+		}
+		return "dockerconfig (" + strings.Join(registries, ", ") + ")"
+	case dataFormatPEM:
+		return summarizePEM(value)
+	case dataFormatJSON, dataFormatYAML, dataFormatProperties, dataFormatShell:
+		return dataFormatNames[format]
+	case dataFormatBinary:
+		return "binary"
+	default:
+		if bytes.Contains(value, []byte("\n")) {
+			return "text (multi-line)"
+		}
+		return "text"
+	}
+}
+
+// prettyPrintData formats a value for the full-screen viewer
+func prettyPrintData(key string, value []byte) string {
+	switch detectDataFormat(key, value) {
+	case dataFormatDockerConfig:
+		return prettyPrintDockerConfig(value)
+	case dataFormatPEM:
+		return prettyPrintPEM(value)
+	case dataFormatJSON:
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, bytes.TrimSpace(value), "", "  "); err != nil {
+			return string(value)
+		}
+		return buf.String()
+	case dataFormatBinary:
+		return fmt.Sprintf("<binary data, %d bytes>\n\nBase64:\n%s", len(value), base64.StdEncoding.EncodeToString(value))
+	default:
+		return string(value)
+	}
+}
+
+// previewData returns a single-line preview of a value for table cells
+func previewData(key string, value []byte) string {
+	if detectDataFormat(key, value) == dataFormatBinary {
+		return fmt.Sprintf("<binary, %d bytes>", len(value))
+	}
+	return strings.ReplaceAll(strings.TrimSpace(string(value)), "\n", "⏎")
+}
+
+// formatDataSize formats a byte count as a human-readable size
+func formatDataSize(size int) string {
+	switch {
+	case size < 1024:
+		return fmt.Sprintf("%dB", size)
+	case size < 1024*1024:
+		return fmt.Sprintf("%.1fKiB", float64(size)/1024)
+	default:
+		return fmt.Sprintf("%.1fMiB", float64(size)/(1024*1024))
+	}
+}
+
+// summarizePEM describes the PEM blocks in a value (certificate subject and
+// expiry for certificates, block type otherwise)
+func summarizePEM(value []byte) string {
+	var parts []string
+	rest := bytes.TrimSpace(value)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			parts = append(parts, strings.ToLower(block.Type))
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			parts = append(parts, "certificate (unparseable)")
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("cert %s, %s", certificateName(cert), certificateExpiry(cert)))
+	}
+	if len(parts) == 0 {
+		return "PEM"
+	}
+	if len(parts) > 2 {
+		return fmt.Sprintf("PEM: %s (+%d more)", parts[0], len(parts)-1)
+	}
+	return "PEM: " + strings.Join(parts, "; ")
+}
+
+// prettyPrintPEM shows certificate details followed by the raw PEM blocks
+func prettyPrintPEM(value []byte) string {
+	var buf strings.Builder
+	rest := bytes.TrimSpace(value)
+	index := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		index++
+		buf.WriteString(fmt.Sprintf("# Block %d: %s\n", index, block.Type))
+		if block.Type == "CERTIFICATE" {
+			if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+				buf.WriteString(fmt.Sprintf("#   Subject:    %s\n", cert.Subject.String()))
+				buf.WriteString(fmt.Sprintf("#   Issuer:     %s\n", cert.Issuer.String()))
+				buf.WriteString(fmt.Sprintf("#   Not Before: %s\n", cert.NotBefore.UTC().Format(time.RFC3339)))
+				buf.WriteString(fmt.Sprintf("#   Not After:  %s (%s)\n", cert.NotAfter.UTC().Format(time.RFC3339), certificateExpiry(cert)))
+				if len(cert.DNSNames) > 0 {
+					buf.WriteString(fmt.Sprintf("#   DNS Names:  %s\n", strings.Join(cert.DNSNames, ", ")))
+				}
+				buf.WriteString(fmt.Sprintf("#   CA:         %t\n", cert.IsCA))
+			}
+		}
+	}
+	buf.WriteString("\n")
+	buf.WriteString(string(value))
+	return buf.String()
+}
+
+// certificateName returns the most useful display name for a certificate
+func certificateName(cert *x509.Certificate) string {
+	if cert.Subject.CommonName != "" {
+		return "CN=" + cert.Subject.CommonName
+	}
+	if len(cert.DNSNames) > 0 {
+		return cert.DNSNames[0]
+	}
+	return cert.Subject.String()
+}
+
+// certificateExpiry describes when a certificate expires relative to now
+func certificateExpiry(cert *x509.Certificate) string {
+	remaining := time.Until(cert.NotAfter)
+	if remaining <= 0 {
+		return "EXPIRED " + FormatDuration(-remaining) + " ago"
+	}
+	return "expires in " + FormatDuration(remaining)
+}
+
+// dockerConfigAuth is a single registry entry of a dockerconfigjson value
+type dockerConfigAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// parseDockerConfig parses both .dockerconfigjson ({"auths": {...}}) and the
+// legacy .dockercfg (registry map at top level) layouts
+func parseDockerConfig(value []byte) map[string]dockerConfigAuth {
+	var wrapped struct {
+		Auths map[string]dockerConfigAuth `json:"auths"`
+	}
+	if err := json.Unmarshal(value, &wrapped); err == nil && len(wrapped.Auths) > 0 {
+		return wrapped.Auths
+	}
+	var legacy map[string]dockerConfigAuth
+	if err := json.Unmarshal(value, &legacy); err == nil {
+		return legacy
+	}
+	return nil
+}
+
+// dockerConfigRegistries returns the sorted registry hosts of a dockerconfig
+func dockerConfigRegistries(value []byte) []string {
+	auths := parseDockerConfig(value)
+	registries := make([]string, 0, len(auths))
+	for registry := range auths {
+		registries = append(registries, registry)
+	}
+	sort.Strings(registries)
+	return registries
+}
+
+// prettyPrintDockerConfig lists registry credentials with the auth field decoded
+func prettyPrintDockerConfig(value []byte) string {
+	auths := parseDockerConfig(value)
+	if auths == nil {
+		return string(value)
+	}
+
+	var buf strings.Builder
+	for _, registry := range dockerConfigRegistries(value) {
+		auth := auths[registry]
+		username, password := auth.Username, auth.Password
+		if auth.Auth != "" {
+			if decoded, err := base64.StdEncoding.DecodeString(auth.Auth); err == nil {
+				if user, pass, ok := strings.Cut(string(decoded), ":"); ok {
+					if username == "" {
+						username = user
+					}
+					if password == "" {
+						password = pass
+					}
+				}
+			}
+		}
+		buf.WriteString(registry + ":\n")
+		buf.WriteString("  username: " + username + "\n")
+		buf.WriteString("  password: " + password + "\n")
+		if auth.Email != "" {
+			buf.WriteString("  email: " + auth.Email + "\n")
+		}
+	}
+	return buf.String()
+}
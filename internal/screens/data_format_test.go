@@ -0,0 +1,111 @@
+package screens
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCertificatePEM generates a self-signed certificate for format tests
+func newTestCertificatePEM(t *testing.T, commonName string, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     []string{commonName},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestDetectDataFormat(t *testing.T) {
+	cert := newTestCertificatePEM(t, "example.com", time.Now().Add(48*time.Hour))
+
+	tests := []struct {
+		name     string
+		key      string
+		value    []byte
+		expected dataFormat
+	}{
+		{"plain text", "username", []byte("admin"), dataFormatText},
+		{"json object", "config.json", []byte(`{"a": 1}`), dataFormatJSON},
+		{"json array", "list", []byte(`[1, 2]`), dataFormatJSON},
+		{"invalid json", "broken", []byte(`{"a": `), dataFormatText},
+		{"pem certificate", "tls.crt", cert, dataFormatPEM},
+		{"dockerconfigjson", ".dockerconfigjson", []byte(`{"auths":{}}`), dataFormatDockerConfig},
+		{"binary", "keystore", []byte{0xff, 0xfe, 0x00, 0x01}, dataFormatBinary},
+		{"yaml by extension", "app.yml", []byte("port: 8080"), dataFormatYAML},
+		{"yaml by content", "config", []byte("server:\n  port: 8080\n"), dataFormatYAML},
+		{"properties by extension", "app.properties", []byte("a=b"), dataFormatProperties},
+		{"properties by content", "settings", []byte("# defaults\nserver.port=8080\nlog.level = info\n"), dataFormatProperties},
+		{"shell by extension", "run.sh", []byte("echo hi"), dataFormatShell},
+		{"shell by shebang", "entrypoint", []byte("#!/bin/bash\nexec app\n"), dataFormatShell},
+		{"multi-line prose", "notes", []byte("first line\nsecond line\n"), dataFormatText},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, detectDataFormat(tt.key, tt.value))
+		})
+	}
+}
+
+func TestSummarizeData_Certificate(t *testing.T) {
+	valid := newTestCertificatePEM(t, "api.example.com", time.Now().Add(72*time.Hour))
+	summary := summarizeData("tls.crt", valid)
+	assert.Contains(t, summary, "CN=api.example.com")
+	assert.Contains(t, summary, "expires in")
+
+	expired := newTestCertificatePEM(t, "old.example.com", time.Now().Add(-24*time.Hour))
+	assert.Contains(t, summarizeData("tls.crt", expired), "EXPIRED")
+}
+
+func TestPrettyPrintData_JSON(t *testing.T) {
+	result := prettyPrintData("config", []byte(`{"debug":true}`))
+	assert.Equal(t, "{\n  \"debug\": true\n}", result)
+}
+
+func TestPrettyPrintData_Certificate(t *testing.T) {
+	cert := newTestCertificatePEM(t, "api.example.com", time.Now().Add(72*time.Hour))
+	result := prettyPrintData("tls.crt", cert)
+	assert.Contains(t, result, "Subject:    CN=api.example.com")
+	assert.Contains(t, result, "DNS Names:  api.example.com")
+	assert.Contains(t, result, "-----BEGIN CERTIFICATE-----")
+}
+
+func TestDockerConfig(t *testing.T) {
+	// auth is base64("robot:hunter2")
+	value := []byte(`{"auths":{"ghcr.io":{"auth":"cm9ib3Q6aHVudGVyMg=="},"docker.io":{"username":"me","password":"pw"}}}`)
+
+	assert.Equal(t, "dockerconfig (docker.io, ghcr.io)", summarizeData(".dockerconfigjson", value))
+
+	result := prettyPrintData(".dockerconfigjson", value)
+	assert.Contains(t, result, "ghcr.io:\n  username: robot\n  password: hunter2")
+	assert.Contains(t, result, "docker.io:\n  username: me\n  password: pw")
+}
+
+func TestPreviewData(t *testing.T) {
+	assert.Equal(t, "line1⏎line2", previewData("script", []byte("line1\nline2\n")))
+	assert.Equal(t, "<binary, 2 bytes>", previewData("bin", []byte{0xff, 0xfe}))
+}
+
+func TestFormatDataSize(t *testing.T) {
+	assert.Equal(t, "512B", formatDataSize(512))
+	assert.Equal(t, "1.5KiB", formatDataSize(1536))
+	assert.Equal(t, "2.0MiB", formatDataSize(2*1024*1024))
+}
@@ -156,6 +156,7 @@ func (s *DynamicScreen) Refresh() tea.Cmd {
 		}
 
 		// Update items directly (following ConfigScreen pattern)
+		s.syncContextColumn()
 		s.items = resources
 		s.applyFilter()
 
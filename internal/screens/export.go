@@ -0,0 +1,170 @@
+package screens
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/commands"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/messages"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// exportTable handles the export command: it renders the filtered and
+// sorted rows with all configured columns (hidden ones included) and writes
+// them to a file or the clipboard
+func (s *ConfigScreen) exportTable(msg types.ExportTableMsg) tea.Cmd {
+	if len(s.filtered) == 0 {
+		return messages.ErrorCmd("Nothing to export")
+	}
+
+	data, err := renderExport(s.config.Columns, s.filtered, msg.Format, msg.Formatted)
+	if err != nil {
+		return messages.ErrorCmd("Export failed: %v", err)
+	}
+	rows := len(s.filtered)
+
+	return func() tea.Msg {
+		if msg.Path == "" {
+			if err := commands.WriteClipboard(string(data)); err != nil {
+				return messages.ErrorCmd("Export failed: %v", err)()
+			}
+			return messages.SuccessCmd("Exported %d rows to clipboard", rows)()
+		}
+
+		written, err := commands.WriteNewFile(msg.Path, data)
+		if err != nil {
+			return messages.ErrorCmd("Export failed: %v", err)()
+		}
+		return messages.SuccessCmd("Exported %d rows to %s", rows, written)()
+	}
+}
+
+// renderExport renders items as csv, json or md (markdown table)
+func renderExport(columns []ColumnConfig, items []interface{}, format string, formatted bool) ([]byte, error) {
+	switch format {
+	case "csv":
+		return exportCSV(columns, items, formatted)
+	case "json":
+		return exportJSON(columns, items, formatted)
+	case "md":
+		return exportMarkdown(columns, items, formatted), nil
+	default:
+		return nil, fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+func exportCSV(columns []ColumnConfig, items []interface{}, formatted bool) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(columnTitles(columns)); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if err := w.Write(exportRow(columns, item, formatted)); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// exportJSON renders a list of objects keyed by column title; raw numbers
+// and booleans keep their JSON type
+func exportJSON(columns []ColumnConfig, items []interface{}, formatted bool) ([]byte, error) {
+	rows := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		row := make(map[string]interface{}, len(columns))
+		for _, col := range columns {
+			if formatted {
+				row[col.Title] = col.FormatValue(item)
+			} else {
+				row[col.Title] = rawExportValue(col, item)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	data, err := json.MarshalIndent(rows, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func exportMarkdown(columns []ColumnConfig, items []interface{}, formatted bool) []byte {
+	var b strings.Builder
+
+	writeRow := func(cells []string) {
+		b.WriteString("|")
+		for _, cell := range cells {
+			b.WriteString(" " + markdownEscape(cell) + " |")
+		}
+		b.WriteString("\n")
+	}
+
+	writeRow(columnTitles(columns))
+	b.WriteString("|")
+	for range columns {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+	for _, item := range items {
+		writeRow(exportRow(columns, item, formatted))
+	}
+	return []byte(b.String())
+}
+
+func columnTitles(columns []ColumnConfig) []string {
+	titles := make([]string, len(columns))
+	for i, col := range columns {
+		titles[i] = col.Title
+	}
+	return titles
+}
+
+// exportRow returns the text of each column (display strings or raw values)
+func exportRow(columns []ColumnConfig, item interface{}, formatted bool) []string {
+	row := make([]string, len(columns))
+	for i, col := range columns {
+		if formatted {
+			row[i] = col.FormatValue(item)
+		} else {
+			row[i] = fmt.Sprint(rawExportValue(col, item))
+		}
+	}
+	return row
+}
+
+// rawExportValue returns the unformatted value of a column: timestamps as
+// RFC3339, ages as the creation timestamp and other durations as seconds
+func rawExportValue(col ColumnConfig, item interface{}) interface{} {
+	switch value := getFieldValue(item, col.Field).(type) {
+	case time.Time:
+		if value.IsZero() {
+			return ""
+		}
+		return value.UTC().Format(time.RFC3339)
+	case time.Duration:
+		if resource, ok := item.(k8s.Resource); ok && col.Field == "Age" && !resource.GetCreatedAt().IsZero() {
+			return resource.GetCreatedAt().UTC().Format(time.RFC3339)
+		}
+		return int64(value.Seconds())
+	case nil:
+		return ""
+	default:
+		return value
+	}
+}
+
+// markdownEscape keeps cell text on one line and escapes column separators
+func markdownEscape(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	return strings.ReplaceAll(text, "\n", " ")
+}
@@ -0,0 +1,112 @@
+package screens
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+)
+
+func exportTestPods() []interface{} {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return []interface{}{
+		k8s.Pod{
+			ResourceMetadata: k8s.ResourceMetadata{Namespace: "default", Name: "web|1", Age: 2 * time.Hour, CreatedAt: created},
+			Ready:            "1/1",
+			Status:           "Running",
+			Restarts:         3,
+			Node:             "node-1",
+		},
+	}
+}
+
+func TestRenderExport_CSV(t *testing.T) {
+	columns := GetPodsScreenConfig().Columns
+
+	data, err := renderExport(columns, exportTestPods(), "csv", false)
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, columnTitles(columns), records[0], "all columns are exported")
+
+	row := map[string]string{}
+	for i, title := range records[0] {
+		row[title] = records[1][i]
+	}
+	assert.Equal(t, "3", row["Restarts"])
+	assert.Equal(t, "2026-01-02T03:04:05Z", row["Age"], "age exports the creation timestamp")
+}
+
+func TestRenderExport_JSON(t *testing.T) {
+	columns := GetPodsScreenConfig().Columns
+
+	data, err := renderExport(columns, exportTestPods(), "json", false)
+	require.NoError(t, err)
+
+	var rows []map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &rows))
+	require.Len(t, rows, 1)
+	assert.Equal(t, float64(3), rows[0]["Restarts"], "raw numbers keep their type")
+	assert.Equal(t, "web|1", rows[0]["Name"])
+
+	data, err = renderExport(columns, exportTestPods(), "json", true)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &rows))
+	assert.Equal(t, "3", rows[0]["Restarts"])
+	assert.Equal(t, "2h", rows[0]["Age"], "formatted mode uses display strings")
+}
+
+func TestRenderExport_Markdown(t *testing.T) {
+	columns := []ColumnConfig{
+		{Field: "Name", Title: "Name"},
+		{Field: "Restarts", Title: "Restarts"},
+	}
+
+	data, err := renderExport(columns, exportTestPods(), "md", false)
+	require.NoError(t, err)
+	assert.Equal(t, "| Name | Restarts |\n| --- | --- |\n| web\\|1 | 3 |\n", string(data))
+}
+
+func TestRenderExport_UnknownFormat(t *testing.T) {
+	_, err := renderExport(GetPodsScreenConfig().Columns, exportTestPods(), "xml", false)
+	assert.Error(t, err)
+}
+
+func TestConfigScreen_ExportTable_UsesFilteredRows(t *testing.T) {
+	screen := NewConfigScreen(GetPodsScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.Refresh()()
+	screen.SetFilter("restarts>3")
+	require.Len(t, screen.GetFilteredItems(), 1)
+
+	path := filepath.Join(t.TempDir(), "pods.csv")
+	_, cmd := screen.Update(types.ExportTableMsg{Format: "csv", Path: path})
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	status, ok := msg.(types.StatusMsg)
+	require.True(t, ok)
+	assert.Equal(t, types.MessageTypeSuccess, status.Type, status.Message)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[1], "api-server-6b9f8c7d5e-qwert")
+
+	// Existing files are never overwritten
+	_, cmd = screen.Update(types.ExportTableMsg{Format: "csv", Path: path})
+	status = cmd().(types.StatusMsg)
+	assert.Equal(t, types.MessageTypeError, status.Type)
+}
@@ -0,0 +1,140 @@
+package screens
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fieldTermPattern matches a column comparison, e.g. restarts>3 or
+// status!=Running
+var fieldTermPattern = regexp.MustCompile(`^([A-Za-z][\w.-]*)(>=|<=|!=|=|>|<)(\S+)$`)
+
+// fieldPredicate is a column comparison of a filter
+type fieldPredicate struct {
+	column ColumnConfig
+	op     string
+	value  string
+}
+
+// parseFieldFilter parses a filter made of column comparisons separated by
+// spaces (restarts>3 status!=Running). Returns false when a term is not a
+// comparison on a column of the screen: the filter is then a fuzzy search.
+func parseFieldFilter(filter string, columns []ColumnConfig) ([]fieldPredicate, bool) {
+	terms := strings.Fields(filter)
+	if len(terms) == 0 {
+		return nil, false
+	}
+
+	predicates := make([]fieldPredicate, 0, len(terms))
+	for _, term := range terms {
+		match := fieldTermPattern.FindStringSubmatch(term)
+		if match == nil {
+			return nil, false
+		}
+		column, ok := findColumn(columns, match[1])
+		if !ok {
+			return nil, false
+		}
+		predicates = append(predicates, fieldPredicate{column: column, op: match[2], value: match[3]})
+	}
+	return predicates, true
+}
+
+// findColumn returns the column with a title or field matching name (case,
+// spaces and dashes ignored, e.g. "lastupdate" matches "Last Update")
+func findColumn(columns []ColumnConfig, name string) (ColumnConfig, bool) {
+	name = normalizeColumnName(name)
+	for _, col := range columns {
+		if normalizeColumnName(col.Title) == name || normalizeColumnName(col.Field) == name {
+			return col, true
+		}
+	}
+	return ColumnConfig{}, false
+}
+
+func normalizeColumnName(name string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(name))
+}
+
+// filterByFields returns the items matching all predicates (in their
+// original order)
+func filterByFields(items []interface{}, predicates []fieldPredicate) []interface{} {
+	result := make([]interface{}, 0)
+	for _, item := range items {
+		matches := true
+		for _, predicate := range predicates {
+			if !predicate.matches(item) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// matches compares the column value of an item: ages and timestamps by
+// duration (age>1h), numbers numerically, anything else by its displayed text (=
+// and != only, case insensitive)
+func (p fieldPredicate) matches(item interface{}) bool {
+	raw := getFieldValue(item, p.column.Field)
+
+	switch value := raw.(type) {
+	case time.Duration:
+		limit, err := parseAge(p.value)
+		return err == nil && compare(float64(value), float64(limit), p.op)
+	case time.Time:
+		limit, err := parseAge(p.value)
+		return err == nil && !value.IsZero() && compare(float64(time.Since(value)), float64(limit), p.op)
+	}
+
+	left, leftErr := strconv.ParseFloat(fmt.Sprint(raw), 64)
+	right, rightErr := strconv.ParseFloat(p.value, 64)
+	if leftErr == nil && rightErr == nil {
+		return compare(left, right, p.op)
+	}
+
+	equal := strings.EqualFold(p.column.FormatValue(item), p.value)
+	switch p.op {
+	case "=":
+		return equal
+	case "!=":
+		return !equal
+	}
+	return false
+}
+
+// parseAge parses a duration, with d for days (age>7d)
+func parseAge(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
+
+func compare(left, right float64, op string) bool {
+	switch op {
+	case "=":
+		return left == right
+	case "!=":
+		return left != right
+	case ">":
+		return left > right
+	case ">=":
+		return left >= right
+	case "<":
+		return left < right
+	case "<=":
+		return left <= right
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package screens
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/ui"
+)
+
+func TestParseFieldFilter(t *testing.T) {
+	columns := GetPodsScreenConfig().Columns
+
+	tests := []struct {
+		filter string
+		ok     bool
+	}{
+		{"restarts>3", true},
+		{"Restarts>=3 status!=Running", true},
+		{"age<1h", true},
+		{"nginx", false},
+		{"restarts>", false},
+		{"unknown=3", false},
+		{"restarts>3 nginx", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			_, ok := parseFieldFilter(tt.filter, columns)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestConfigScreen_SetFilter_FieldComparison(t *testing.T) {
+	tests := []struct {
+		filter string
+		names  []string
+	}{
+		{"restarts>3", []string{"api-server-6b9f8c7d5e-qwert"}},
+		{"restarts>=2 status=running", []string{"nginx-deployment-7d64f8d9c8-def34"}},
+		{"status!=Running", []string{"api-server-6b9f8c7d5e-qwert"}},
+		{"age>3d", []string{"coredns-5d78c9869d-xyz89"}},
+		{"namespace=default restarts=0", []string{"nginx-deployment-7d64f8d9c8-abc12"}},
+		{"ready>0", []string{}}, // 1/1 is not a number
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			screen := NewConfigScreen(GetPodsScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+			screen.Refresh()()
+			screen.SetFilter(tt.filter)
+
+			names := []string{}
+			for _, item := range screen.GetFilteredItems() {
+				names = append(names, item.(k8s.Pod).Name)
+			}
+			assert.ElementsMatch(t, tt.names, names)
+		})
+	}
+}
@@ -35,6 +35,8 @@ func getHelpEntries() []HelpEntry {
 		{"Resources", "d", "Describe selected resource"},
 		{"Resources", "e", "Edit resource (clipboard)"},
 		{"Resources", "l", "View logs (pods only)"},
+		{"Resources", "/logs-previous", "View logs of previous (crashed) container (pods only)"},
+		{"Resources", "/diagnose", "Explain why pod is unhealthy (pods only)"},
 		{"Resources", "y", "View YAML"},
 		{"Resources", "ctrl+x", "Delete resource"},
 		{"Resources", "n", "Filter by namespace"},
@@ -42,6 +44,60 @@ func getHelpEntries() []HelpEntry {
 		// Context
 		{"Context", "[", "Previous Kubernetes context"},
 		{"Context", "]", "Next Kubernetes context"},
+		{"Context", "/all-contexts", "Merge loaded contexts into lists (toggle)"},
+
+		// Secret data (/decode on a secret)
+		{"Secret data", "space", "Reveal/mask selected value"},
+		{"Secret data", "enter", "View value (pretty-printed)"},
+		{"Secret data", "c", "Copy value to clipboard"},
+
+		// ConfigMap data (enter or /data on a configmap)
+		{"ConfigMap data", "enter", "View value (syntax detected)"},
+		{"ConfigMap data", "e", "Edit value in $EDITOR (patches this key only)"},
+		{"ConfigMap data", "/export-key", "Export value to a local file"},
+
+		// Ownership tree (/tree on any resource)
+		{"Ownership tree", "/tree", "Show objects owned by selected resource"},
+		{"Ownership tree", "enter", "Go to selected object"},
+		{"Ownership tree", "/jump-owner", "Go to top-level owner of selected resource"},
+
+		// Containers (enter on a pod)
+		{"Containers", "enter", "Show containers of selected pod (pods)"},
+		{"Containers", "l", "View logs of selected container"},
+		{"Containers", "/shell", "Open shell in selected container"},
+		{"Containers", "/logs-previous", "View logs of previous instance of selected container"},
+
+		// Node detail (alt+enter on a node or /show-node)
+		{"Node detail", "alt+enter", "Show node detail and allocation (nodes)"},
+		{"Node detail", "/show-node", "Show node detail of selected node or pod"},
+		{"Node detail", "enter", "Go to selected pod"},
+
+		// Storage chain (:pvcs, :pvs, :sc, :volumeattachments)
+		{"Storage", "alt+enter", "Show volume of PVC, or StorageClass of volume"},
+		{"Storage", "enter", "Follow PV → attachments → node, StorageClass → PVCs"},
+
+		// AI (/ai <request>, needs llm.endpoint in config)
+		{"AI", "/ai <request>", "Translate request to kubectl command (vetted, dry-run first)"},
+
+		// Diff (/mark then /diff, or /diff <context>)
+		{"Diff", "/mark", "Mark selected resource for diff"},
+		{"Diff", "/diff", "Diff marked resource with selected (or /diff <context>)"},
+		{"Diff", "s", "Toggle spec only (in diff view)"},
+		{"Diff", "v", "Toggle unified/side-by-side (in diff view)"},
+
+		// Cluster pulse (:pulse)
+		{"Cluster pulse", ":pulse", "Show cluster health summary"},
+		{"Cluster pulse", "enter", "Show resources of selected check"},
+
+		// RBAC explorer (:serviceaccounts, :roles, :rolebindings...)
+		{"RBAC", "enter", "Follow ServiceAccount → bindings → role → rules"},
+		{"RBAC", "/who-can <verb> <resource>", "List subjects allowed to do something"},
+		{"RBAC", "/what-can [subject]", "List permissions of selected ServiceAccount or subject"},
+
+		// Audit log (:audit)
+		{"Audit", ":audit", "Browse audit log of mutating operations"},
+		{"Audit", "enter", "Show full audit entry"},
+		{"Audit", "/", "Filter (user:, ctx:, ns:, resource:, result:, since:24h)"},
 
 		// Global
 		{"Global", ":q", "Quit application"},
@@ -53,6 +109,7 @@ func getHelpEntries() []HelpEntry {
 		{"Palette", "↑/↓", "Navigate suggestions"},
 		{"Palette", "enter", "Execute command"},
 		{"Palette", "tab", "Auto-complete"},
+		{"Palette", "ctrl+r", "Search command history (again for older, tab to edit)"},
 		{"Palette", "esc", "Cancel"},
 	}
 }
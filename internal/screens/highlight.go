@@ -0,0 +1,280 @@
+package screens
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// Live change highlighting
+//
+// Refreshes replace every row at once, so a status flip or a restart during
+// a rollout is easy to miss. Screens that track selection already identify
+// rows by key (getResourceKey); the change tracker extends that to all rows
+// and compares each refresh with the previous one. For
+// ChangeHighlightDuration, changed cells are highlighted, new rows are
+// marked and deleted rows stay at the bottom of the table struck through.
+//
+// The table truncates cells by rune width, which breaks escape sequences
+// inside cells. Highlighted values are instead wrapped in zero-width markers
+// (after truncating them to the column width, so the closing marker is
+// kept) that View replaces with the styles once the table is rendered.
+
+// Zero-width markers around highlighted cell values
+const (
+	markerChanged = "\u200b\u200c"
+	markerAdded   = "\u200b\u200e"
+	markerDeleted = "\u200b\ufeff"
+	markerEnd     = "\u200b\u200b"
+)
+
+// markerStripper removes the markers (selected row, no colors)
+var markerStripper = strings.NewReplacer(markerChanged, "", markerAdded, "", markerDeleted, "", markerEnd, "")
+
+// rowChangeKind is how a row changed since the previous refresh
+type rowChangeKind int
+
+const (
+	rowChanged rowChangeKind = iota
+	rowAdded
+	rowDeleted
+)
+
+// rowChange is a row change still highlighted
+type rowChange struct {
+	kind   rowChangeKind
+	fields map[string]bool // Changed column fields (rowChanged only)
+	item   interface{}     // Last version of the row (rowDeleted only)
+	until  time.Time
+}
+
+// trackedRow is a row as of the last refresh
+type trackedRow struct {
+	item   interface{}
+	values map[string]interface{} // Column field → raw value
+}
+
+// changeTracker compares the rows of successive refreshes
+type changeTracker struct {
+	items   []interface{}         // Items last compared (refreshes replace the slice)
+	rows    map[string]trackedRow // Row key → row, nil before the first refresh
+	changes map[string]*rowChange // Row key → change
+	fresh   bool                  // Changes detected that need an expiry tick
+}
+
+// changeHighlightExpiredMsg clears the highlights that expired
+type changeHighlightExpiredMsg struct {
+	screenID string
+}
+
+// resetChanges forgets the tracked rows (the rows shown are not comparable
+// with the previous ones, e.g. another filter context)
+func (s *ConfigScreen) resetChanges() {
+	s.changes = changeTracker{}
+}
+
+// trackChanges compares the items with the previous refresh and records
+// the changes to highlight
+func (s *ConfigScreen) trackChanges() {
+	if !s.config.TrackSelection || sameItems(s.changes.items, s.items) {
+		return
+	}
+
+	rows := make(map[string]trackedRow, len(s.items))
+	for _, item := range s.items {
+		key := getResourceKey(item)
+		if _, duplicate := rows[key]; duplicate {
+			// Rows without identity (e.g. no Name field) cannot be tracked
+			s.resetChanges()
+			return
+		}
+		rows[key] = trackedRow{item: item, values: rowValues(item, s.config.Columns)}
+	}
+
+	previous := s.changes.rows
+	s.changes.items = s.items
+	s.changes.rows = rows
+	if previous == nil {
+		return // First load: nothing changed
+	}
+	if s.changes.changes == nil {
+		s.changes.changes = make(map[string]*rowChange)
+	}
+
+	until := time.Now().Add(ChangeHighlightDuration)
+	for key, row := range rows {
+		old, existed := previous[key]
+		if !existed {
+			s.changes.changes[key] = &rowChange{kind: rowAdded, until: until}
+			s.changes.fresh = true
+			continue
+		}
+
+		fields := changedFields(old.values, row.values)
+		if len(fields) == 0 {
+			continue
+		}
+		change, ok := s.changes.changes[key]
+		if !ok || change.kind != rowChanged {
+			change = &rowChange{kind: rowChanged, fields: make(map[string]bool)}
+			s.changes.changes[key] = change
+		}
+		for _, field := range fields {
+			change.fields[field] = true
+		}
+		change.until = until
+		s.changes.fresh = true
+	}
+
+	for key, old := range previous {
+		if _, exists := rows[key]; !exists {
+			s.changes.changes[key] = &rowChange{kind: rowDeleted, item: old.item, until: until}
+			s.changes.fresh = true
+		}
+	}
+}
+
+// sameItems reports whether two item slices are the same slice (filtering
+// and resizing re-render the items of the last refresh)
+func sameItems(a, b []interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	return len(a) == 0 || &a[0] == &b[0]
+}
+
+// rowValues returns the raw column values of an item. Durations (ages) are
+// relative to the refresh and change every time, so they are left out.
+func rowValues(item interface{}, columns []ColumnConfig) map[string]interface{} {
+	values := make(map[string]interface{}, len(columns))
+	for _, col := range columns {
+		value := getFieldValue(item, col.Field)
+		if _, relative := value.(time.Duration); relative {
+			continue
+		}
+		values[col.Field] = value
+	}
+	return values
+}
+
+// changedFields returns the column fields whose values differ
+func changedFields(old, current map[string]interface{}) []string {
+	var fields []string
+	for field, value := range current {
+		if !reflect.DeepEqual(old[field], value) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// activeChange returns the change of a row if still highlighted
+func (t *changeTracker) activeChange(key string, now time.Time) *rowChange {
+	change, ok := t.changes[key]
+	if !ok || !now.Before(change.until) {
+		return nil
+	}
+	return change
+}
+
+// deletedRows returns the deleted rows still shown (sorted by key)
+func (t *changeTracker) deletedRows(now time.Time) []interface{} {
+	var keys []string
+	for key, change := range t.changes {
+		if change.kind == rowDeleted && now.Before(change.until) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	items := make([]interface{}, len(keys))
+	for i, key := range keys {
+		items[i] = t.changes[key].item
+	}
+	return items
+}
+
+// expire drops the expired changes and reports whether any was dropped
+func (t *changeTracker) expire(now time.Time) bool {
+	expired := false
+	for key, change := range t.changes {
+		if !now.Before(change.until) {
+			delete(t.changes, key)
+			expired = true
+		}
+	}
+	return expired
+}
+
+// changeExpiryTick schedules the removal of the highlights just recorded
+func (s *ConfigScreen) changeExpiryTick() tea.Cmd {
+	screenID := s.config.ID
+	return tea.Tick(ChangeHighlightDuration, func(time.Time) tea.Msg {
+		return changeHighlightExpiredMsg{screenID: screenID}
+	})
+}
+
+// markCell wraps a cell value in the marker of the change (truncated to the
+// column width like the table does, so the closing marker is kept)
+func markCell(value string, width int, change *rowChange, field string) string {
+	var marker string
+	switch change.kind {
+	case rowAdded:
+		marker = markerAdded
+	case rowDeleted:
+		marker = markerDeleted
+	default:
+		if !change.fields[field] {
+			return value
+		}
+		marker = markerChanged
+	}
+	if width > 0 {
+		value = runewidth.Truncate(value, width, "…")
+	}
+	return marker + value + markerEnd
+}
+
+// renderChanges replaces the markers of the rendered table with the styles.
+// The selected row keeps the selection style only.
+func (s *ConfigScreen) renderChanges(view string) string {
+	if !strings.Contains(view, markerEnd) {
+		return view
+	}
+
+	selectedPrefix, _ := styleSequences(s.theme.Table.SelectedRow)
+	changedPrefix, reset := styleSequences(s.theme.Table.StatusWarning)
+	addedPrefix, _ := styleSequences(s.theme.Table.StatusRunning)
+	deletedPrefix, _ := styleSequences(lipgloss.NewStyle().Foreground(s.theme.Muted).Strikethrough(true))
+	styler := strings.NewReplacer(markerChanged, changedPrefix, markerAdded, addedPrefix, markerDeleted, deletedPrefix, markerEnd, reset)
+
+	lines := strings.Split(view, "\n")
+	for i, line := range lines {
+		if !strings.Contains(line, markerEnd) {
+			continue
+		}
+		if selectedPrefix == "" || strings.HasPrefix(line, selectedPrefix) {
+			lines[i] = markerStripper.Replace(line)
+			continue
+		}
+		lines[i] = styler.Replace(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// styleSequences returns the escape sequences a style wraps text with (empty
+// without color support)
+func styleSequences(style lipgloss.Style) (string, string) {
+	const sample = "x"
+	rendered := style.Render(sample)
+	i := strings.Index(rendered, sample)
+	if i < 0 {
+		return "", ""
+	}
+	return rendered[:i], rendered[i+len(sample):]
+}
@@ -0,0 +1,169 @@
+package screens
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+)
+
+func highlightTestPod(name, status string, restarts int32, age time.Duration) k8s.Pod {
+	return k8s.Pod{
+		ResourceMetadata: k8s.ResourceMetadata{Namespace: "web", Name: name, Age: age},
+		Ready:            "1/1",
+		Status:           status,
+		Restarts:         restarts,
+	}
+}
+
+// refreshItems replaces the items like a refresh does
+func refreshItems(s *ConfigScreen, items ...interface{}) {
+	s.items = items
+	s.applyFilter()
+}
+
+func newHighlightTestScreen(t *testing.T) *ConfigScreen {
+	t.Helper()
+	screen := NewConfigScreen(GetPodsScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.SetSize(160, 20)
+	refreshItems(screen,
+		highlightTestPod("api", "Running", 0, time.Hour),
+		highlightTestPod("worker", "Running", 0, time.Hour),
+	)
+	return screen
+}
+
+func TestTrackChanges(t *testing.T) {
+	screen := newHighlightTestScreen(t)
+	assert.Empty(t, screen.changes.changes, "the first load has nothing to compare with")
+
+	// Ages change on every refresh and are not changes
+	refreshItems(screen,
+		highlightTestPod("api", "Running", 0, time.Hour+10*time.Second),
+		highlightTestPod("worker", "Running", 0, time.Hour+10*time.Second),
+	)
+	assert.Empty(t, screen.changes.changes)
+	assert.False(t, screen.changes.fresh)
+
+	refreshItems(screen,
+		highlightTestPod("api", "CrashLoopBackOff", 1, time.Hour),
+		highlightTestPod("cache", "Pending", 0, time.Second),
+	)
+	assert.True(t, screen.changes.fresh)
+	require.Len(t, screen.changes.changes, 3)
+
+	changed := screen.changes.changes["web/api"]
+	assert.Equal(t, rowChanged, changed.kind)
+	assert.Equal(t, map[string]bool{"Status": true, "Restarts": true}, changed.fields)
+	assert.Equal(t, rowAdded, screen.changes.changes["web/cache"].kind)
+	deleted := screen.changes.changes["web/worker"]
+	assert.Equal(t, rowDeleted, deleted.kind)
+	assert.Equal(t, "worker", deleted.item.(k8s.Pod).Name, "deleted rows keep their last version")
+
+	// Changed fields accumulate while highlighted
+	refreshItems(screen,
+		highlightTestPod("api", "CrashLoopBackOff", 2, time.Hour),
+		highlightTestPod("cache", "Pending", 0, time.Second),
+	)
+	assert.Equal(t, map[string]bool{"Status": true, "Restarts": true}, screen.changes.changes["web/api"].fields)
+
+	// Filtering re-renders the same items
+	screen.changes.changes = nil
+	screen.SetFilter("api")
+	assert.Empty(t, screen.changes.changes)
+}
+
+func TestTrackChanges_Disabled(t *testing.T) {
+	// Screens without selection tracking
+	cfg := GetPodsScreenConfig()
+	cfg.TrackSelection = false
+	screen := NewConfigScreen(cfg, k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	refreshItems(screen, highlightTestPod("api", "Running", 0, time.Hour))
+	refreshItems(screen, highlightTestPod("api", "Failed", 0, time.Hour))
+	assert.Empty(t, screen.changes.changes)
+
+	// Rows without identity
+	screen = newHighlightTestScreen(t)
+	refreshItems(screen, highlightTestPod("api", "Running", 0, time.Hour), highlightTestPod("api", "Failed", 0, time.Hour))
+	refreshItems(screen, highlightTestPod("api", "Failed", 0, time.Hour), highlightTestPod("api", "Failed", 0, time.Hour))
+	assert.Empty(t, screen.changes.changes)
+	assert.Nil(t, screen.changes.rows)
+
+	// Another filter context lists other resources
+	screen = newHighlightTestScreen(t)
+	screen.ApplyFilterContext(&types.FilterContext{Field: "node", Value: "node-1"})
+	refreshItems(screen, highlightTestPod("db", "Running", 0, time.Hour))
+	assert.Empty(t, screen.changes.changes)
+}
+
+func TestConfigScreen_UpdateTable_Highlights(t *testing.T) {
+	screen := newHighlightTestScreen(t)
+	refreshItems(screen,
+		highlightTestPod("api", "CrashLoopBackOff", 1, time.Hour),
+		highlightTestPod("cache", "Pending", 0, time.Second),
+	)
+
+	rows := screen.table.Rows()
+	require.Len(t, rows, 3, "deleted rows are shown after the others")
+	assert.Len(t, screen.filtered, 2)
+
+	// Changed cells only
+	assert.Equal(t, "web", rows[0][0])
+	assert.Equal(t, markerChanged+"CrashLoopBa…"+markerEnd, rows[0][3], "values are truncated to the column width")
+	assert.Equal(t, markerChanged+"1"+markerEnd, rows[0][4])
+	// All cells of new and deleted rows
+	assert.Equal(t, markerAdded+"cache"+markerEnd, rows[1][1])
+	assert.Equal(t, markerDeleted+"worker"+markerEnd, rows[2][1])
+
+	// Deleted rows cannot be selected
+	screen.table.SetCursor(2)
+	assert.Nil(t, screen.GetSelectedResource())
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("G")})
+	assert.Equal(t, 1, screen.table.Cursor())
+
+	// Markers never reach the terminal
+	view := screen.View()
+	assert.NotContains(t, view, "\u200b")
+	assert.Contains(t, view, "CrashLoopBa…")
+	assert.Contains(t, view, "worker")
+
+	// Filtered lists leave deleted rows out
+	screen.SetFilter("crash")
+	assert.Len(t, screen.table.Rows(), 1)
+}
+
+func TestConfigScreen_ChangeHighlightExpiry(t *testing.T) {
+	screen := newHighlightTestScreen(t)
+	refreshItems(screen, highlightTestPod("api", "Failed", 0, time.Hour))
+
+	// The refresh that found changes schedules their expiry
+	_, cmd := screen.Update(types.RefreshCompleteMsg{})
+	assert.NotNil(t, cmd)
+	assert.False(t, screen.changes.fresh)
+
+	// Expiry messages for other screens are ignored
+	for _, change := range screen.changes.changes {
+		change.until = time.Now().Add(-time.Second)
+	}
+	screen.Update(changeHighlightExpiredMsg{screenID: "deployments"})
+	assert.Len(t, screen.changes.changes, 2)
+
+	screen.Update(changeHighlightExpiredMsg{screenID: "pods"})
+	assert.Empty(t, screen.changes.changes)
+	rows := screen.table.Rows()
+	require.Len(t, rows, 1)
+	assert.Equal(t, "Failed", rows[0][3])
+}
+
+func TestMarkCell(t *testing.T) {
+	changed := &rowChange{kind: rowChanged, fields: map[string]bool{"Status": true}}
+	assert.Equal(t, "web", markCell("web", 10, changed, "Namespace"))
+	assert.Equal(t, markerChanged+"Running"+markerEnd, markCell("Running", 10, changed, "Status"))
+	assert.Equal(t, markerAdded+"Runn…"+markerEnd, markCell("Running", 5, &rowChange{kind: rowAdded}, "Status"))
+}
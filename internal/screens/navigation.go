@@ -99,6 +99,64 @@ func navigateToPodsForNode() NavigationFunc {
 	}
 }
 
+// navigateToContainersForPod creates a navigation handler for Pod → Containers
+func navigateToContainersForPod() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		namespace, _ := resource["namespace"].(string)
+		name, _ := resource["name"].(string)
+		if name == "" {
+			return nil
+		}
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: ContainersScreenID,
+				FilterContext: &types.FilterContext{
+					Field: "pod",
+					Value: name,
+					Metadata: map[string]string{
+						"namespace": namespace,
+						"kind":      "Pod",
+					},
+				},
+			}
+		}
+	}
+}
+
+// navigateToNodeDetail creates a navigation handler for Node → node detail
+func navigateToNodeDetail() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		name, _ := resource["name"].(string)
+		if name == "" {
+			return nil
+		}
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: NodeDetailScreenID,
+				FilterContext: &types.FilterContext{
+					Field: "detail",
+					Value: name,
+					Metadata: map[string]string{
+						"kind": "Node",
+					},
+				},
+			}
+		}
+	}
+}
+
 // navigateToPodsForService creates a navigation handler for Service → Pods
 func navigateToPodsForService() NavigationFunc {
 	return func(s *ConfigScreen) tea.Cmd {
@@ -193,6 +251,38 @@ func navigateToPodsForVolumeSource(kind string) NavigationFunc {
 	}
 }
 
+// navigateToConfigMapData creates a navigation handler for ConfigMap → key browser
+func navigateToConfigMapData() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		namespace, _ := resource["namespace"].(string)
+		name, _ := resource["name"].(string)
+		if namespace == "" || name == "" {
+			return nil
+		}
+		context, _ := resource["context"].(string) // Set in aggregated lists
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: ConfigMapDataScreenID,
+				FilterContext: &types.FilterContext{
+					Field: "data",
+					Value: name,
+					Metadata: map[string]string{
+						"namespace": namespace,
+						"kind":      "ConfigMap",
+						"context":   context,
+					},
+				},
+			}
+		}
+	}
+}
+
 // navigateToReplicaSetsForDeployment creates handler for Deployment → ReplicaSets
 func navigateToReplicaSetsForDeployment() NavigationFunc {
 	return func(s *ConfigScreen) tea.Cmd {
@@ -355,6 +445,219 @@ func navigateToTargetForHPA() NavigationFunc {
 	}
 }
 
+// navigateToBindingsForServiceAccount creates handler for ServiceAccount →
+// RoleBindings and ClusterRoleBindings
+func navigateToBindingsForServiceAccount() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		namespace, _ := resource["namespace"].(string)
+		name, _ := resource["name"].(string)
+		if namespace == "" || name == "" {
+			return nil
+		}
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: "rolebindings",
+				FilterContext: &types.FilterContext{
+					Field: "subject",
+					Value: name,
+					Metadata: map[string]string{
+						"namespace": namespace,
+						"kind":      k8s.SubjectServiceAccount,
+					},
+				},
+			}
+		}
+	}
+}
+
+// navigateToRoleForBinding creates handler for RoleBinding/ClusterRoleBinding
+// → Role/ClusterRole
+func navigateToRoleForBinding() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		namespace, _ := resource["namespace"].(string)
+		role, _ := resource["role"].(string)
+		return navigateToRole(role, namespace)
+	}
+}
+
+// navigateToRole switches to the screen of a role ("ClusterRole/view")
+// filtered to it. Roles are in the namespace of their binding.
+func navigateToRole(role, namespace string) tea.Cmd {
+	kind, name, ok := strings.Cut(role, "/")
+	if !ok || name == "" {
+		return nil
+	}
+	if kind == "Role" {
+		return navigateToObject("roles", kind, namespace, name)
+	}
+	return navigateToObject("clusterroles", kind, "", name)
+}
+
+// navigateToObject switches to a list screen filtered to one object
+// (namespace "" for cluster-scoped objects)
+func navigateToObject(screenID, kind, namespace, name string) tea.Cmd {
+	if name == "" {
+		return nil
+	}
+
+	return func() tea.Msg {
+		return types.ScreenSwitchMsg{
+			ScreenID: screenID,
+			FilterContext: &types.FilterContext{
+				Field: "name",
+				Value: name,
+				Metadata: map[string]string{
+					"namespace": namespace,
+					"kind":      kind,
+				},
+			},
+		}
+	}
+}
+
+// navigateToRulesForRole creates handler for Role/ClusterRole → Rules
+func navigateToRulesForRole(kind string) NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		namespace, _ := resource["namespace"].(string)
+		name, _ := resource["name"].(string)
+		return navigateToRules(kind, namespace, name)
+	}
+}
+
+// navigateToRules switches to the rules of a role
+func navigateToRules(kind, namespace, name string) tea.Cmd {
+	if name == "" {
+		return nil
+	}
+
+	return func() tea.Msg {
+		return types.ScreenSwitchMsg{
+			ScreenID: PolicyRulesScreenID,
+			FilterContext: &types.FilterContext{
+				Field: "role",
+				Value: name,
+				Metadata: map[string]string{
+					"namespace": namespace,
+					"kind":      kind,
+				},
+			},
+		}
+	}
+}
+
+// navigateToPVForPVC creates handler for PVC → PersistentVolume
+func navigateToPVForPVC() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		volume, _ := resource["volume"].(string)
+		return navigateToObject("persistentvolumes", "PersistentVolume", "", volume)
+	}
+}
+
+// navigateToStorageClassForPV creates handler for PersistentVolume →
+// StorageClass
+func navigateToStorageClassForPV() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		storageClass, _ := resource["storageclass"].(string)
+		return navigateToObject("storageclasses", "StorageClass", "", storageClass)
+	}
+}
+
+// navigateToAttachmentsForPV creates handler for PersistentVolume →
+// VolumeAttachments
+func navigateToAttachmentsForPV() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		name, _ := resource["name"].(string)
+		if name == "" {
+			return nil
+		}
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: "volumeattachments",
+				FilterContext: &types.FilterContext{
+					Field: "pv",
+					Value: name,
+					Metadata: map[string]string{
+						"kind": "PersistentVolume",
+					},
+				},
+			}
+		}
+	}
+}
+
+// navigateToNodeForAttachment creates handler for VolumeAttachment → Node
+func navigateToNodeForAttachment() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		node, _ := resource["node"].(string)
+		return navigateToObject("nodes", "Node", "", node)
+	}
+}
+
+// navigateToPVCsForStorageClass creates handler for StorageClass → PVCs
+func navigateToPVCsForStorageClass() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		name, _ := resource["name"].(string)
+		if name == "" {
+			return nil
+		}
+
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: "persistentvolumeclaims",
+				FilterContext: &types.FilterContext{
+					Field: "storageclass",
+					Value: name,
+					Metadata: map[string]string{
+						"kind": "StorageClass",
+					},
+				},
+			}
+		}
+	}
+}
+
 // navigateToContextSwitch creates handler for context switching
 func navigateToContextSwitch() NavigationFunc {
 	return func(s *ConfigScreen) tea.Cmd {
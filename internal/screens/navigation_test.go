@@ -7,6 +7,7 @@ import (
 	"github.com/renato0307/k1/internal/types"
 	"github.com/renato0307/k1/internal/ui"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNavigateToCRInstances(t *testing.T) {
@@ -189,6 +190,53 @@ func TestNavigateToPodsForNode(t *testing.T) {
 	assert.Equal(t, "node-1", switchMsg.FilterContext.Value)
 }
 
+func TestNavigateToContainersForPod(t *testing.T) {
+	screen := &ConfigScreen{
+		filtered: []interface{}{
+			k8s.Pod{
+				ResourceMetadata: k8s.ResourceMetadata{
+					Namespace: "shop",
+					Name:      "web-0",
+				},
+			},
+		},
+	}
+
+	cmd := navigateToContainersForPod()(screen)
+	assert.NotNil(t, cmd)
+
+	msg := cmd()
+	switchMsg, ok := msg.(types.ScreenSwitchMsg)
+	assert.True(t, ok)
+	assert.Equal(t, ContainersScreenID, switchMsg.ScreenID)
+	assert.Equal(t, "pod", switchMsg.FilterContext.Field)
+	assert.Equal(t, "web-0", switchMsg.FilterContext.Value)
+	assert.Equal(t, "shop", switchMsg.FilterContext.Metadata["namespace"])
+}
+
+func TestNavigateToNodeDetail(t *testing.T) {
+	screen := &ConfigScreen{
+		filtered: []interface{}{
+			k8s.Node{
+				ResourceMetadata: k8s.ResourceMetadata{
+					Name: "node-1",
+				},
+			},
+		},
+	}
+
+	cmd := navigateToNodeDetail()(screen)
+	assert.NotNil(t, cmd)
+
+	msg := cmd()
+	switchMsg, ok := msg.(types.ScreenSwitchMsg)
+	assert.True(t, ok)
+	assert.Equal(t, NodeDetailScreenID, switchMsg.ScreenID)
+	assert.Equal(t, "detail", switchMsg.FilterContext.Field)
+	assert.Equal(t, "node-1", switchMsg.FilterContext.Value)
+	assert.Equal(t, "Node", switchMsg.FilterContext.Metadata["kind"])
+}
+
 func TestNavigateToPodsForService(t *testing.T) {
 	screen := &ConfigScreen{
 		filtered: []interface{}{
@@ -255,6 +303,62 @@ func TestNavigateToContextSwitch(t *testing.T) {
 	}
 }
 
+func TestNavigateStorageChain(t *testing.T) {
+	pvc := k8s.PersistentVolumeClaim{ResourceMetadata: k8s.ResourceMetadata{Namespace: "web", Name: "data"}, Volume: "pv-1"}
+	pv := k8s.PersistentVolume{ResourceMetadata: k8s.ResourceMetadata{Name: "pv-1"}, StorageClass: "gp3"}
+
+	tests := []struct {
+		name          string
+		item          interface{}
+		handler       NavigationFunc
+		expectedID    string
+		expectedField string
+		expectedValue string
+		expectedKind  string
+	}{
+		{"PVC to PV", pvc, navigateToPVForPVC(), "persistentvolumes", "name", "pv-1", "PersistentVolume"},
+		{"PV to StorageClass", pv, navigateToStorageClassForPV(), "storageclasses", "name", "gp3", "StorageClass"},
+		{"PV to VolumeAttachments", pv, navigateToAttachmentsForPV(), "volumeattachments", "pv", "pv-1", "PersistentVolume"},
+		{
+			"VolumeAttachment to Node",
+			k8s.VolumeAttachment{ResourceMetadata: k8s.ResourceMetadata{Name: "csi-123"}, Node: "node-1"},
+			navigateToNodeForAttachment(), "nodes", "name", "node-1", "Node",
+		},
+		{
+			"StorageClass to PVCs",
+			k8s.StorageClass{ResourceMetadata: k8s.ResourceMetadata{Name: "gp3"}},
+			navigateToPVCsForStorageClass(), "persistentvolumeclaims", "storageclass", "gp3", "StorageClass",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			screen := &ConfigScreen{filtered: []interface{}{tt.item}}
+
+			cmd := tt.handler(screen)
+			require.NotNil(t, cmd)
+
+			switchMsg, ok := cmd().(types.ScreenSwitchMsg)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedID, switchMsg.ScreenID)
+			assert.Equal(t, tt.expectedField, switchMsg.FilterContext.Field)
+			assert.Equal(t, tt.expectedValue, switchMsg.FilterContext.Value)
+			assert.Equal(t, tt.expectedKind, switchMsg.FilterContext.Metadata["kind"])
+			assert.Empty(t, switchMsg.FilterContext.Metadata["namespace"], "storage chain objects are cluster-scoped")
+		})
+	}
+}
+
+func TestNavigateToPVForPVC_Unbound(t *testing.T) {
+	screen := &ConfigScreen{
+		filtered: []interface{}{
+			k8s.PersistentVolumeClaim{ResourceMetadata: k8s.ResourceMetadata{Namespace: "web", Name: "data"}, Status: "Pending"},
+		},
+	}
+
+	assert.Nil(t, navigateToPVForPVC()(screen))
+}
+
 func TestNavigationFactories_ReturnFunctions(t *testing.T) {
 	// Test that all navigation factory functions return non-nil functions
 	repo := &k8s.DummyRepository{}
@@ -269,6 +373,7 @@ func TestNavigationFactories_ReturnFunctions(t *testing.T) {
 		{"navigateToPodsForService", navigateToPodsForService()},
 		{"navigateToPodsForNamespace", navigateToPodsForNamespace()},
 		{"navigateToPodsForVolumeSource", navigateToPodsForVolumeSource("ConfigMap")},
+		{"navigateToConfigMapData", navigateToConfigMapData()},
 		{"navigateToJobsForCronJob", navigateToJobsForCronJob()},
 		{"navigateToReplicaSetsForDeployment", navigateToReplicaSetsForDeployment()},
 		{"navigateToPodsForPVC", navigateToPodsForPVC()},
@@ -277,6 +382,11 @@ func TestNavigationFactories_ReturnFunctions(t *testing.T) {
 		{"navigateToTargetForHPA", navigateToTargetForHPA()},
 		{"navigateToContextSwitch", navigateToContextSwitch()},
 		{"navigateToCRInstances", navigateToCRInstances()},
+		{"navigateToPVForPVC", navigateToPVForPVC()},
+		{"navigateToStorageClassForPV", navigateToStorageClassForPV()},
+		{"navigateToAttachmentsForPV", navigateToAttachmentsForPV()},
+		{"navigateToNodeForAttachment", navigateToNodeForAttachment()},
+		{"navigateToPVCsForStorageClass", navigateToPVCsForStorageClass()},
 	}
 
 	for _, tt := range factories {
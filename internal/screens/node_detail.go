@@ -0,0 +1,329 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/renato0307/k1/internal/commands"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+)
+
+const (
+	// NodeDetailScreenID is the screen identifier for the node detail view
+	NodeDetailScreenID = "node-detail"
+
+	// allocationBarWidth is the width of the requests/limits bars
+	allocationBarWidth = 20
+)
+
+// nodeDetailPodColumns are the fixed-width columns of the pods table (the
+// Name column takes the remaining width)
+var nodeDetailPodColumns = []table.Column{
+	{Title: "Namespace", Width: 20},
+	{Title: "Name", Width: 0},
+	{Title: "Status", Width: 10},
+	{Title: "CPU Req", Width: 8},
+	{Title: "CPU Lim", Width: 8},
+	{Title: "Mem Req", Width: 8},
+	{Title: "Mem Lim", Width: 8},
+	{Title: "Age", Width: 6},
+}
+
+// NodeDetailScreen shows a node's conditions, taints, addresses and the
+// allocation of its pods (requests/limits vs allocatable) above the list of
+// those pods. The node to show is passed as a FilterContext (Field "detail").
+type NodeDetailScreen struct {
+	repo          k8s.Repository
+	theme         *ui.Theme
+	table         table.Model
+	width         int
+	height        int
+	filterContext *types.FilterContext
+	detail        *k8s.NodeDetail
+}
+
+// NewNodeDetailScreen creates a new node detail screen
+func NewNodeDetailScreen(repo k8s.Repository, theme *ui.Theme) *NodeDetailScreen {
+	t := table.New(
+		table.WithColumns(nodeDetailPodColumns),
+		table.WithFocused(true),
+		table.WithHeight(10),
+	)
+	t.SetStyles(theme.ToTableStyles())
+
+	return &NodeDetailScreen{
+		repo:  repo,
+		theme: theme,
+		table: t,
+	}
+}
+
+func (s *NodeDetailScreen) Init() tea.Cmd {
+	return tea.Batch(
+		s.refresh(),
+		tea.Tick(RefreshInterval, func(t time.Time) tea.Msg {
+			return tickMsg{screenID: s.ID(), time: t}
+		}),
+	)
+}
+
+func (s *NodeDetailScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tickMsg:
+		// Ignore ticks from other screens (prevents multiple concurrent ticks)
+		if msg.screenID != s.ID() {
+			return s, nil
+		}
+		return s, tea.Batch(
+			s.refresh(),
+			tea.Tick(RefreshInterval, func(t time.Time) tea.Msg {
+				return tickMsg{screenID: s.ID(), time: t}
+			}),
+		)
+
+	case types.RefreshCompleteMsg:
+		// Summary height depends on the node (taints, conditions)
+		s.resizeTable()
+		return s, nil
+
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyEnter {
+			return s, s.navigateToPod()
+		}
+		switch msg.String() {
+		case "g":
+			s.table.GotoTop()
+			return s, nil
+		case "G":
+			s.table.GotoBottom()
+			return s, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	s.table, cmd = s.table.Update(msg)
+	return s, cmd
+}
+
+// refresh reloads the node detail from the repository (all data is cached)
+func (s *NodeDetailScreen) refresh() tea.Cmd {
+	return func() tea.Msg {
+		start := time.Now()
+		if s.filterContext == nil || s.repo == nil {
+			s.detail = nil
+			s.table.SetRows([]table.Row{})
+			return types.RefreshCompleteMsg{Duration: 0}
+		}
+
+		detail, err := s.repo.GetNodeDetail(s.filterContext.Value)
+		if err != nil {
+			return types.ErrorStatusMsg(fmt.Sprintf("Failed to get node detail: %v", err))
+		}
+
+		s.detail = detail
+		rows := make([]table.Row, len(detail.Pods))
+		for i, pod := range detail.Pods {
+			rows[i] = table.Row{
+				pod.Namespace,
+				pod.Name,
+				pod.Status,
+				formatQuantity(pod.CPURequests),
+				formatQuantity(pod.CPULimits),
+				formatQuantity(pod.MemoryRequests),
+				formatQuantity(pod.MemoryLimits),
+				FormatDuration(pod.Age),
+			}
+		}
+		s.table.SetRows(rows)
+		if s.table.Cursor() >= len(rows) {
+			s.table.SetCursor(max(0, len(rows)-1))
+		}
+
+		return types.RefreshCompleteMsg{Duration: time.Since(start)}
+	}
+}
+
+// navigateToPod switches to the Pods screen with the selected pod selected
+func (s *NodeDetailScreen) navigateToPod() tea.Cmd {
+	if s.detail == nil {
+		return nil
+	}
+	cursor := s.table.Cursor()
+	if cursor < 0 || cursor >= len(s.detail.Pods) {
+		return nil
+	}
+
+	pod := s.detail.Pods[cursor]
+	podGVR := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	return commands.NavigateToResourceCmd(s.repo, podGVR, "Pod", pod.Namespace, pod.Name)
+}
+
+func (s *NodeDetailScreen) View() string {
+	return lipgloss.JoinVertical(lipgloss.Left, s.summaryView(), s.table.View())
+}
+
+// summaryView renders status, addresses, taints, conditions and allocation
+func (s *NodeDetailScreen) summaryView() string {
+	if s.detail == nil {
+		return ""
+	}
+	d := s.detail
+
+	labelStyle := lipgloss.NewStyle().Foreground(s.theme.Primary).Bold(true)
+	mutedStyle := lipgloss.NewStyle().Foreground(s.theme.Muted)
+
+	status := d.Status
+	if d.Unschedulable {
+		status += ",SchedulingDisabled"
+	}
+	taints := "<none>"
+	if len(d.Taints) > 0 {
+		taints = strings.Join(d.Taints, ", ")
+	}
+
+	lines := []string{
+		labelStyle.Render("Status: ") + s.statusStyle(d.Status).Render(status),
+		labelStyle.Render("Addresses: ") + strings.Join(d.Addresses, ", "),
+		labelStyle.Render("Taints: ") + taints,
+		labelStyle.Render("Conditions:"),
+	}
+	for _, c := range d.Conditions {
+		line := fmt.Sprintf("  %-22s %-8s %s", c.Type, c.Status, c.Reason)
+		if conditionIsProblem(c) {
+			line = lipgloss.NewStyle().Foreground(s.theme.Error).Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	lines = append(lines,
+		labelStyle.Render("Allocation")+mutedStyle.Render(" (requests/limits of allocatable)"),
+		mutedStyle.Render(fmt.Sprintf("  %-18s %10s %12s  %-*s  %s",
+			"Resource", "Capacity", "Allocatable", allocationBarWidth+16, "Requests", "Limits")),
+	)
+	for _, r := range d.Resources {
+		requests := fmt.Sprintf("%8s %5.0f%% %s", formatQuantity(r.Requests), r.RequestsPercent(), s.allocationBar(r.RequestsPercent()))
+		limits := ""
+		if r.Name != "pods" {
+			limits = fmt.Sprintf("%8s %5.0f%% %s", formatQuantity(r.Limits), r.LimitsPercent(), s.allocationBar(r.LimitsPercent()))
+		}
+		lines = append(lines, fmt.Sprintf("  %-18s %10s %12s  %s  %s",
+			r.Name, formatQuantity(r.Capacity), formatQuantity(r.Allocatable), requests, limits))
+	}
+
+	lines = append(lines, labelStyle.Render(fmt.Sprintf("Pods (%d):", len(d.Pods))))
+	return strings.Join(lines, "\n")
+}
+
+// allocationBar renders a percentage as a bar coloured by pressure
+// (limits can be overcommitted, the bar is capped at full)
+func (s *NodeDetailScreen) allocationBar(percent float64) string {
+	filled := int(percent / 100 * allocationBarWidth)
+	filled = max(0, min(filled, allocationBarWidth))
+
+	color := s.theme.Success
+	switch {
+	case percent >= 90:
+		color = s.theme.Error
+	case percent >= 70:
+		color = s.theme.Warning
+	}
+
+	bar := lipgloss.NewStyle().Foreground(color).Render(strings.Repeat("█", filled))
+	empty := lipgloss.NewStyle().Foreground(s.theme.Muted).Render(strings.Repeat("░", allocationBarWidth-filled))
+	return bar + empty
+}
+
+// statusStyle colours the node status
+func (s *NodeDetailScreen) statusStyle(status string) lipgloss.Style {
+	if status == "Ready" {
+		return lipgloss.NewStyle().Foreground(s.theme.Success)
+	}
+	return lipgloss.NewStyle().Foreground(s.theme.Error)
+}
+
+// conditionIsProblem reports whether a condition indicates a problem (Ready
+// should be True, pressure/unavailable conditions should be False)
+func conditionIsProblem(c k8s.NodeCondition) bool {
+	if c.Type == "Ready" {
+		return c.Status != "True"
+	}
+	return c.Status == "True"
+}
+
+// formatQuantity formats a quantity for display ("-" when unset)
+func formatQuantity(q resource.Quantity) string {
+	if q.IsZero() {
+		return "-"
+	}
+	return q.String()
+}
+
+// resizeTable fits the pods table below the summary and gives the Name
+// column the remaining width
+func (s *NodeDetailScreen) resizeTable() {
+	if s.width == 0 {
+		return
+	}
+
+	summaryHeight := lipgloss.Height(s.summaryView())
+	s.table.SetHeight(max(3, s.height-summaryHeight))
+
+	fixed := 0
+	for _, col := range nodeDetailPodColumns {
+		fixed += col.Width + 2 // Cell padding
+	}
+	columns := make([]table.Column, len(nodeDetailPodColumns))
+	copy(columns, nodeDetailPodColumns)
+	columns[1].Width = max(20, s.width-fixed-2)
+
+	// Clear rows before setting columns (SetColumns renders existing rows)
+	rows := s.table.Rows()
+	s.table.SetRows([]table.Row{})
+	s.table.SetColumns(columns)
+	s.table.SetRows(rows)
+}
+
+func (s *NodeDetailScreen) ID() string {
+	return NodeDetailScreenID
+}
+
+func (s *NodeDetailScreen) Title() string {
+	return "Node Detail"
+}
+
+func (s *NodeDetailScreen) HelpText() string {
+	return "↑/↓: Navigate pods | enter: Go to pod | esc: Back"
+}
+
+func (s *NodeDetailScreen) Operations() []types.Operation {
+	return []types.Operation{}
+}
+
+func (s *NodeDetailScreen) SetSize(width, height int) {
+	s.width = width
+	s.height = height
+	s.resizeTable()
+}
+
+func (s *NodeDetailScreen) ApplyFilterContext(ctx *types.FilterContext) {
+	// Switching to another node starts from the top of its pod list
+	if s.filterContext == nil || ctx == nil || s.filterContext.Value != ctx.Value {
+		s.detail = nil
+		s.table.SetRows([]table.Row{})
+		s.table.SetCursor(0)
+	}
+	s.filterContext = ctx
+}
+
+func (s *NodeDetailScreen) GetFilterContext() *types.FilterContext {
+	return s.filterContext
+}
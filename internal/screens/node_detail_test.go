@@ -0,0 +1,110 @@
+package screens
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+)
+
+func newTestNodeDetailScreen(t *testing.T) *NodeDetailScreen {
+	screen := NewNodeDetailScreen(k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.SetSize(160, 40)
+	screen.ApplyFilterContext(&types.FilterContext{
+		Field:    "detail",
+		Value:    "node-1",
+		Metadata: map[string]string{"kind": "Node"},
+	})
+	return screen
+}
+
+func TestNodeDetailScreen_Refresh(t *testing.T) {
+	screen := newTestNodeDetailScreen(t)
+
+	msg := screen.refresh()()
+	_, ok := msg.(types.RefreshCompleteMsg)
+	require.True(t, ok, "expected RefreshCompleteMsg, got %T", msg)
+
+	rows := screen.table.Rows()
+	require.Len(t, rows, 2)
+	assert.Equal(t, "default", rows[0][0])
+	assert.Equal(t, "nginx-abc123-xyz789", rows[0][1])
+	assert.Equal(t, "960m", rows[0][3])
+
+	view := screen.View()
+	assert.Contains(t, view, "dedicated=gpu:NoSchedule")
+	assert.Contains(t, view, "InternalIP: 10.0.1.10")
+	assert.Contains(t, view, "MemoryPressure")
+	assert.Contains(t, view, "50%") // cpu requests 1960m of 3920m
+	assert.Contains(t, view, "█")
+	assert.Contains(t, view, "Pods (2):")
+}
+
+func TestNodeDetailScreen_NoFilterContext(t *testing.T) {
+	screen := NewNodeDetailScreen(k8s.NewDummyRepository(), ui.GetTheme("charm"))
+
+	msg := screen.refresh()()
+	_, ok := msg.(types.RefreshCompleteMsg)
+	require.True(t, ok)
+	assert.Empty(t, screen.table.Rows())
+	assert.Empty(t, screen.summaryView())
+}
+
+func TestNodeDetailScreen_EnterNavigatesToPod(t *testing.T) {
+	screen := newTestNodeDetailScreen(t)
+	screen.refresh()()
+	screen.table.SetCursor(1)
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+
+	switchMsg, ok := cmd().(types.ScreenSwitchMsg)
+	require.True(t, ok, "expected ScreenSwitchMsg")
+	assert.Equal(t, "pods", switchMsg.ScreenID)
+	assert.Equal(t, "kube-system/coredns-5d78c9869d-abcde", switchMsg.SelectKey)
+	assert.True(t, switchMsg.PushHistory)
+}
+
+func TestNodeDetailScreen_ApplyFilterContext(t *testing.T) {
+	screen := newTestNodeDetailScreen(t)
+	screen.refresh()()
+	screen.table.SetCursor(1)
+
+	// Same node keeps the data and cursor
+	screen.ApplyFilterContext(&types.FilterContext{Field: "detail", Value: "node-1"})
+	assert.NotNil(t, screen.detail)
+	assert.Equal(t, 1, screen.table.Cursor())
+
+	// Another node starts over
+	screen.ApplyFilterContext(&types.FilterContext{Field: "detail", Value: "node-2"})
+	assert.Nil(t, screen.detail)
+	assert.Empty(t, screen.table.Rows())
+	assert.Equal(t, "node-2", screen.GetFilterContext().Value)
+}
+
+func TestConditionIsProblem(t *testing.T) {
+	assert.False(t, conditionIsProblem(k8s.NodeCondition{Type: "Ready", Status: "True"}))
+	assert.True(t, conditionIsProblem(k8s.NodeCondition{Type: "Ready", Status: "Unknown"}))
+	assert.False(t, conditionIsProblem(k8s.NodeCondition{Type: "MemoryPressure", Status: "False"}))
+	assert.True(t, conditionIsProblem(k8s.NodeCondition{Type: "DiskPressure", Status: "True"}))
+}
+
+func TestNodesScreen_AltEnterOpensNodeDetail(t *testing.T) {
+	screen := NewConfigScreen(GetNodesScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.filtered = []interface{}{
+		k8s.Node{ResourceMetadata: k8s.ResourceMetadata{Name: "node-1"}},
+	}
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true})
+	require.NotNil(t, cmd)
+
+	switchMsg, ok := cmd().(types.ScreenSwitchMsg)
+	require.True(t, ok, "expected ScreenSwitchMsg")
+	assert.Equal(t, NodeDetailScreenID, switchMsg.ScreenID)
+	assert.Equal(t, "node-1", switchMsg.FilterContext.Value)
+}
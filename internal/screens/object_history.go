@@ -0,0 +1,253 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ObjectHistoryScreenID is the screen identifier for the object history
+const ObjectHistoryScreenID = "object-history"
+
+// ObjectHistoryEntry is a row of the object history (one per version,
+// newest first)
+type ObjectHistoryEntry struct {
+	Mark            string // "*" on the version marked for diffing
+	Version         int    // 1 = oldest version kept
+	ObservedAt      time.Time
+	Age             time.Time
+	ResourceVersion string
+	Changes         string
+
+	// Not shown, keeps the selection on the same version across refreshes
+	// (numbers shift when the oldest versions are dropped)
+	Name string
+}
+
+// objectHistoryState holds the versions shown and the version marked with
+// "m" (diffed against the selected one on enter)
+type objectHistoryState struct {
+	name     string
+	versions []k8s.ObjectVersion
+	marked   string // Resource version of the marked version
+}
+
+// GetObjectHistoryScreenConfig returns the config for the object history.
+// The object is passed as a FilterContext (Field "history", GVR in Metadata).
+func GetObjectHistoryScreenConfig() ScreenConfig {
+	state := &objectHistoryState{}
+
+	return ScreenConfig{
+		ID:           ObjectHistoryScreenID,
+		Title:        "Object History",
+		ResourceType: k8s.ResourceType(ObjectHistoryScreenID),
+		Columns: []ColumnConfig{
+			{Field: "Mark", Title: "", Width: 1, Priority: 1},
+			{Field: "Version", Title: "#", Width: 4, Priority: 1},
+			{Field: "ObservedAt", Title: "Observed", Width: 8, Format: formatObservedAt, Priority: 1},
+			{Field: "Age", Title: "Age", Width: 8, Format: FormatDate, Priority: 2},
+			{Field: "ResourceVersion", Title: "Resource Version", MinWidth: 10, MaxWidth: 20, Weight: 0.5, Priority: 3},
+			{Field: "Changes", Title: "Changes", MinWidth: 30, MaxWidth: 300, Weight: 4.0, Priority: 1},
+		},
+		SearchFields: []string{"Changes", "ResourceVersion"},
+		Operations: []OperationConfig{
+			{ID: "diff", Name: "Diff", Description: "Diff selected version with the previous (or marked) one", Shortcut: "enter"},
+			{ID: "mark", Name: "Mark", Description: "Mark selected version to diff with another one", Shortcut: "m"},
+		},
+		NavigationHandler:     diffObjectVersions(state),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomRefresh: func(s *ConfigScreen) tea.Cmd {
+			return func() tea.Msg {
+				start := time.Now()
+				if s.filterContext == nil || s.repo == nil {
+					s.items = []interface{}{}
+					s.applyFilter()
+					return types.RefreshCompleteMsg{Duration: 0}
+				}
+
+				metadata := s.filterContext.Metadata
+				gvr := schema.GroupVersionResource{
+					Group:    metadata["group"],
+					Version:  metadata["version"],
+					Resource: metadata["resource"],
+				}
+				history, err := s.repo.GetObjectHistory(gvr, metadata["namespace"], s.filterContext.Value)
+				if err != nil {
+					return types.ErrorStatusMsg(fmt.Sprintf("Failed to get object history: %v", err))
+				}
+
+				if state.name != s.filterContext.Value {
+					state.marked = ""
+				}
+				state.name = s.filterContext.Value
+				state.versions = history.Versions
+
+				s.items = state.entries()
+				s.applyFilter()
+				return types.RefreshCompleteMsg{Duration: time.Since(start)}
+			}
+		},
+		CustomUpdate: func(s *ConfigScreen, msg tea.Msg) (tea.Model, tea.Cmd) {
+			if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "m" {
+				return s, markObjectVersion(s, state)
+			}
+			return getPeriodicRefreshUpdate()(s, msg)
+		},
+	}
+}
+
+// entries builds table rows from the versions (newest first)
+func (st *objectHistoryState) entries() []interface{} {
+	items := make([]interface{}, 0, len(st.versions))
+	for i := len(st.versions) - 1; i >= 0; i-- {
+		version := st.versions[i]
+		entry := ObjectHistoryEntry{
+			Version:         i + 1,
+			ObservedAt:      version.ObservedAt,
+			Age:             version.ObservedAt,
+			ResourceVersion: version.ResourceVersion,
+			Changes:         summarizeVersionChanges(version, i == 0),
+			Name:            version.ResourceVersion,
+		}
+		if st.marked != "" && st.marked == version.ResourceVersion {
+			entry.Mark = "*"
+		}
+		items = append(items, entry)
+	}
+	return items
+}
+
+// summarizeVersionChanges describes a version in the Changes column
+func summarizeVersionChanges(version k8s.ObjectVersion, baseline bool) string {
+	switch {
+	case version.Deleted:
+		return "(deleted)"
+	case baseline && len(version.Changes) == 0:
+		return "(baseline, before the first change)"
+	}
+	return fmt.Sprintf("%d: %s", len(version.Changes), strings.Join(version.Changes, ", "))
+}
+
+// formatObservedAt formats the time a version was observed (the baseline
+// was seen before history recorded anything)
+func formatObservedAt(val interface{}) string {
+	if t, ok := val.(time.Time); ok && t.IsZero() {
+		return "earlier"
+	}
+	return FormatTime(val)
+}
+
+// selectedVersion returns the version number of the selected row (0 = none)
+func selectedVersion(s *ConfigScreen) int {
+	resource := s.GetSelectedResource()
+	if resource == nil {
+		return 0
+	}
+	version, _ := resource["version"].(int)
+	return version
+}
+
+// markedVersion returns the version number of the marked version (0 = none
+// or no longer kept)
+func (st *objectHistoryState) markedVersion() int {
+	if st.marked == "" {
+		return 0
+	}
+	for i, version := range st.versions {
+		if version.ResourceVersion == st.marked {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// markObjectVersion toggles the mark on the selected version
+func markObjectVersion(s *ConfigScreen, st *objectHistoryState) tea.Cmd {
+	version := selectedVersion(s)
+	if version == 0 || version > len(st.versions) {
+		return nil
+	}
+
+	message := fmt.Sprintf("Marked version %d: select another version and press enter to diff", version)
+	if st.markedVersion() == version {
+		st.marked = ""
+		message = fmt.Sprintf("Unmarked version %d", version)
+	} else {
+		st.marked = st.versions[version-1].ResourceVersion
+	}
+	s.items = st.entries()
+	s.applyFilter()
+	return func() tea.Msg {
+		return types.InfoMsg(message)
+	}
+}
+
+// diffObjectVersions creates a navigation handler for version → diff with
+// the marked version, or with the previous version when none is marked
+func diffObjectVersions(st *objectHistoryState) NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		selected := selectedVersion(s)
+		if selected == 0 || selected > len(st.versions) {
+			return nil
+		}
+
+		other := selected - 1
+		if marked := st.markedVersion(); marked != 0 && marked != selected {
+			other = marked
+		}
+		if other == 0 {
+			return func() tea.Msg {
+				return types.InfoMsg("Oldest version kept: mark another version with m to diff")
+			}
+		}
+
+		// Older version on the left
+		left, right := min(selected, other), max(selected, other)
+		diff, err := versionDiff(st.name, left, st.versions[left-1], right, st.versions[right-1])
+		if err != nil {
+			return func() tea.Msg {
+				return types.ErrorStatusMsg(fmt.Sprintf("Failed to diff versions: %v", err))
+			}
+		}
+		return func() tea.Msg {
+			return types.ShowFullScreenMsg{
+				ViewType:     5, // Diff
+				ResourceName: diff.LeftTitle + " ↔ " + diff.RightTitle,
+				Diff:         diff,
+			}
+		}
+	}
+}
+
+// versionDiff builds the diff of two versions of an object (status
+// included in the full view: controllers mostly write status)
+func versionDiff(name string, leftNumber int, left k8s.ObjectVersion, rightNumber int, right k8s.ObjectVersion) (*types.DiffContent, error) {
+	leftSpec, err := k8s.CleanManifestYAML(left.Manifest, true)
+	if err != nil {
+		return nil, err
+	}
+	rightSpec, err := k8s.CleanManifestYAML(right.Manifest, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DiffContent{
+		LeftTitle:  versionTitle(name, leftNumber, left),
+		RightTitle: versionTitle(name, rightNumber, right),
+		Left:       left.Manifest,
+		Right:      right.Manifest,
+		LeftSpec:   leftSpec,
+		RightSpec:  rightSpec,
+	}, nil
+}
+
+// versionTitle labels a version in the diff view (e.g. "nginx #3 (14:02:11)")
+func versionTitle(name string, number int, version k8s.ObjectVersion) string {
+	return fmt.Sprintf("%s #%d (%s)", name, number, formatObservedAt(version.ObservedAt))
+}
@@ -0,0 +1,91 @@
+package screens
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// newTestObjectHistoryScreen opens the history of a dummy deployment
+// (baseline, scale up, image change)
+func newTestObjectHistoryScreen(t *testing.T) *ConfigScreen {
+	t.Helper()
+	return refreshedConfigScreen(t, GetObjectHistoryScreenConfig(), k8s.NewDummyRepository(), &types.FilterContext{
+		Field: "history",
+		Value: "nginx",
+		Metadata: map[string]string{
+			"namespace": "default",
+			"group":     "apps",
+			"version":   "v1",
+			"resource":  "deployments",
+		},
+	})
+}
+
+func TestObjectHistoryScreen_ListsVersionsNewestFirst(t *testing.T) {
+	screen := newTestObjectHistoryScreen(t)
+	require.Len(t, screen.filtered, 3)
+
+	newest := screen.filtered[0].(ObjectHistoryEntry)
+	assert.Equal(t, 3, newest.Version)
+	assert.Equal(t, "102", newest.ResourceVersion)
+	assert.Equal(t, "1: spec.template.spec.containers[0].image", newest.Changes)
+
+	baseline := screen.filtered[2].(ObjectHistoryEntry)
+	assert.Equal(t, 1, baseline.Version)
+	assert.Equal(t, "(baseline, before the first change)", baseline.Changes)
+	assert.Equal(t, "earlier", formatObservedAt(baseline.ObservedAt))
+}
+
+func TestObjectHistoryScreen_DiffsWithPreviousVersion(t *testing.T) {
+	screen := newTestObjectHistoryScreen(t)
+	screen.table.SetCursor(0)
+
+	cmd := screen.handleEnterKey()
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(types.ShowFullScreenMsg)
+	require.True(t, ok, "expected ShowFullScreenMsg")
+	assert.Equal(t, 5, msg.ViewType)
+	require.NotNil(t, msg.Diff)
+	assert.Contains(t, msg.Diff.LeftTitle, "nginx #2")
+	assert.Contains(t, msg.Diff.RightTitle, "nginx #3")
+	assert.Contains(t, msg.Diff.Left, "nginx:1.25")
+	assert.Contains(t, msg.Diff.Right, "nginx:1.26")
+	assert.Contains(t, msg.Diff.Right, "status:", "status changes are part of the history")
+	assert.NotContains(t, msg.Diff.RightSpec, "status:")
+
+	// The baseline has nothing to diff with
+	screen.table.SetCursor(2)
+	msg2 := screen.handleEnterKey()()
+	status, ok := msg2.(types.StatusMsg)
+	require.True(t, ok, "expected StatusMsg, got %T", msg2)
+	assert.Equal(t, types.MessageTypeInfo, status.Type)
+}
+
+func TestObjectHistoryScreen_DiffsWithMarkedVersion(t *testing.T) {
+	screen := newTestObjectHistoryScreen(t)
+
+	// Mark the baseline, diff the newest version against it
+	screen.table.SetCursor(2)
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	require.NotNil(t, cmd)
+	assert.Equal(t, "*", screen.filtered[2].(ObjectHistoryEntry).Mark)
+
+	screen.table.SetCursor(0)
+	msg, ok := screen.handleEnterKey()().(types.ShowFullScreenMsg)
+	require.True(t, ok, "expected ShowFullScreenMsg")
+	assert.Contains(t, msg.Diff.LeftTitle, "nginx #1")
+	assert.Contains(t, msg.Diff.RightTitle, "nginx #3")
+
+	// The mark survives refreshes and toggles off
+	screen.Refresh()()
+	assert.Equal(t, "*", screen.filtered[2].(ObjectHistoryEntry).Mark)
+	screen.table.SetCursor(2)
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	assert.Empty(t, screen.filtered[2].(ObjectHistoryEntry).Mark)
+}
@@ -0,0 +1,143 @@
+package screens
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/commands"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// OwnershipTreeScreenID is the screen identifier for the ownership tree
+const OwnershipTreeScreenID = "ownership-tree"
+
+// OwnershipTreeEntry is a row of the ownership tree (one per object, in
+// depth-first order)
+type OwnershipTreeEntry struct {
+	Object    string // Kind/name with tree branches (e.g. "└─ Pod/nginx-abc")
+	Namespace string
+	Status    string
+	Age       time.Duration
+
+	// Not shown, used to navigate into the object
+	Kind     string
+	Name     string
+	Group    string
+	Version  string
+	Resource string
+}
+
+// GetOwnershipTreeScreenConfig returns the config for the ownership tree.
+// The root object is passed as a FilterContext (Field "tree", GVR in Metadata).
+func GetOwnershipTreeScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           OwnershipTreeScreenID,
+		Title:        "Ownership Tree",
+		ResourceType: k8s.ResourceType(OwnershipTreeScreenID),
+		Columns: []ColumnConfig{
+			{Field: "Object", Title: "Object", MinWidth: 30, MaxWidth: 100, Weight: 3.0, Priority: 1},
+			{Field: "Namespace", Title: "Namespace", MinWidth: 10, MaxWidth: 30, Weight: 1.0, Priority: 2},
+			{Field: "Status", Title: "Status", MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 1},
+			{Field: "Age", Title: "Age", Width: 6, Format: FormatDuration, Priority: 1},
+		},
+		SearchFields: []string{"Object", "Namespace", "Status"},
+		Operations: []OperationConfig{
+			{ID: "open", Name: "Open", Description: "Go to selected object", Shortcut: "enter"},
+		},
+		NavigationHandler:     navigateToTreeNode(),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomRefresh: func(s *ConfigScreen) tea.Cmd {
+			return func() tea.Msg {
+				start := time.Now()
+				if s.filterContext == nil || s.repo == nil {
+					s.items = []interface{}{}
+					s.applyFilter()
+					return types.RefreshCompleteMsg{Duration: 0}
+				}
+
+				metadata := s.filterContext.Metadata
+				gvr := schema.GroupVersionResource{
+					Group:    metadata["group"],
+					Version:  metadata["version"],
+					Resource: metadata["resource"],
+				}
+				root, err := s.repo.GetOwnershipTree(gvr, metadata["namespace"], s.filterContext.Value)
+				if err != nil {
+					return types.ErrorStatusMsg(fmt.Sprintf("Failed to build ownership tree: %v", err))
+				}
+
+				s.items = flattenOwnershipTree(root)
+				s.applyFilter()
+				return types.RefreshCompleteMsg{Duration: time.Since(start)}
+			}
+		},
+		CustomUpdate: getPeriodicRefreshUpdate(),
+	}
+}
+
+// flattenOwnershipTree converts a tree to rows in depth-first order, drawing
+// branches in front of each object like kubectl tree
+func flattenOwnershipTree(root *k8s.OwnershipNode) []interface{} {
+	items := []interface{}{}
+	if root == nil {
+		return items
+	}
+
+	var walk func(node *k8s.OwnershipNode, branch, indent string)
+	walk = func(node *k8s.OwnershipNode, branch, indent string) {
+		var age time.Duration
+		if !node.CreatedAt.IsZero() {
+			age = time.Since(node.CreatedAt)
+		}
+		items = append(items, OwnershipTreeEntry{
+			Object:    branch + node.Kind + "/" + node.Name,
+			Namespace: node.Namespace,
+			Status:    node.Status,
+			Age:       age,
+			Kind:      node.Kind,
+			Name:      node.Name,
+			Group:     node.GVR.Group,
+			Version:   node.GVR.Version,
+			Resource:  node.GVR.Resource,
+		})
+
+		for i, child := range node.Children {
+			if i == len(node.Children)-1 {
+				walk(child, indent+"└─ ", indent+"   ")
+			} else {
+				walk(child, indent+"├─ ", indent+"│  ")
+			}
+		}
+	}
+	walk(root, "", "")
+	return items
+}
+
+// navigateToTreeNode creates a navigation handler for tree node → the node's
+// list screen with the object selected
+func navigateToTreeNode() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		name, _ := resource["name"].(string)
+		if name == "" {
+			return nil
+		}
+		namespace, _ := resource["namespace"].(string)
+		kind, _ := resource["kind"].(string)
+		group, _ := resource["group"].(string)
+		version, _ := resource["version"].(string)
+		plural, _ := resource["resource"].(string)
+
+		gvr := schema.GroupVersionResource{Group: group, Version: version, Resource: plural}
+		return commands.NavigateToResourceCmd(s.repo, gvr, kind, namespace, name)
+	}
+}
@@ -0,0 +1,72 @@
+package screens
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+)
+
+// newTestOwnershipTreeScreen opens the tree of a dummy deployment
+func newTestOwnershipTreeScreen(t *testing.T) *ConfigScreen {
+	t.Helper()
+	return refreshedConfigScreen(t, GetOwnershipTreeScreenConfig(), k8s.NewDummyRepository(), &types.FilterContext{
+		Field: "tree",
+		Value: "nginx",
+		Metadata: map[string]string{
+			"namespace": "default",
+			"group":     "apps",
+			"version":   "v1",
+			"resource":  "deployments",
+		},
+	})
+}
+
+func TestOwnershipTreeScreen_DrawsTree(t *testing.T) {
+	screen := newTestOwnershipTreeScreen(t)
+	require.Len(t, screen.filtered, 4)
+
+	expected := []string{
+		"Deployment/nginx",
+		"└─ ReplicaSet/nginx-abc123",
+		"   ├─ Pod/nginx-abc123-xyz789",
+		"   └─ Pod/nginx-abc123-uvw456",
+	}
+	for i, object := range expected {
+		entry := screen.filtered[i].(OwnershipTreeEntry)
+		assert.Equal(t, object, entry.Object)
+		assert.Equal(t, "default", entry.Namespace)
+	}
+	assert.Equal(t, "Running 1/1", screen.filtered[3].(OwnershipTreeEntry).Status)
+}
+
+func TestOwnershipTreeScreen_NavigatesIntoNode(t *testing.T) {
+	screen := newTestOwnershipTreeScreen(t)
+	screen.table.SetCursor(1)
+
+	cmd := screen.handleEnterKey()
+	require.NotNil(t, cmd)
+
+	switchMsg, ok := cmd().(types.ScreenSwitchMsg)
+	require.True(t, ok, "expected ScreenSwitchMsg")
+	assert.Equal(t, "replicasets", switchMsg.ScreenID)
+	assert.Equal(t, "default/nginx-abc123", switchMsg.SelectKey)
+	assert.True(t, switchMsg.PushHistory)
+}
+
+func TestOwnershipTreeScreen_NoFilterContext(t *testing.T) {
+	screen := NewConfigScreen(GetOwnershipTreeScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+
+	msg := screen.Refresh()()
+	_, ok := msg.(types.RefreshCompleteMsg)
+	require.True(t, ok)
+	assert.Empty(t, screen.filtered)
+}
+
+func TestFlattenOwnershipTree_Nil(t *testing.T) {
+	assert.Empty(t, flattenOwnershipTree(nil))
+}
@@ -0,0 +1,169 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// PulseScreenID is the screen identifier for the cluster pulse dashboard
+const PulseScreenID = "pulse"
+
+// PulseEntry is a row of the cluster pulse (one per tile)
+type PulseEntry struct {
+	Group        string
+	Check        string
+	Count        string // "-" while the informer behind the tile is loading
+	Status       string
+	TileID       string // Not shown, used for drill-down
+	ResourceType string
+}
+
+// pulseState holds the last computed pulse (for the Warning events drill-down)
+type pulseState struct {
+	pulse *k8s.ClusterPulse
+}
+
+// GetPulseScreenConfig returns the config for the cluster pulse dashboard.
+// Every tile drills down (enter) into the matching list screen filtered to
+// the objects the tile counts; the Warning events tile opens the events.
+func GetPulseScreenConfig() ScreenConfig {
+	state := &pulseState{}
+
+	return ScreenConfig{
+		ID:           PulseScreenID,
+		Title:        "Cluster Pulse",
+		ResourceType: k8s.ResourceType(PulseScreenID),
+		Columns: []ColumnConfig{
+			{Field: "Group", Title: "Group", Width: 12, Priority: 1},
+			{Field: "Check", Title: "Check", Width: 0, Priority: 1},
+			{Field: "Count", Title: "Count", Width: 7, Priority: 1},
+			{Field: "Status", Title: "Status", Width: 22, Priority: 1},
+		},
+		SearchFields: []string{"Group", "Check", "Status"},
+		Operations: []OperationConfig{
+			{ID: "drill-down", Name: "Drill Down", Description: "Show the resources of selected check", Shortcut: "enter"},
+		},
+		NavigationHandler:     drillDownPulseTile(state),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomRefresh: func(s *ConfigScreen) tea.Cmd {
+			return func() tea.Msg {
+				start := time.Now()
+				if s.repo == nil {
+					s.items = []interface{}{}
+					s.applyFilter()
+					return types.RefreshCompleteMsg{Duration: 0}
+				}
+
+				pulse, err := s.repo.GetClusterPulse()
+				if err != nil {
+					return types.ErrorStatusMsg(fmt.Sprintf("Failed to compute cluster pulse: %v", err))
+				}
+
+				state.pulse = pulse
+				s.items = pulseEntries(pulse)
+				s.applyFilter()
+				return types.RefreshCompleteMsg{Duration: time.Since(start)}
+			}
+		},
+		CustomUpdate: getPeriodicRefreshUpdate(),
+	}
+}
+
+// pulseEntries builds table rows from the pulse tiles
+func pulseEntries(pulse *k8s.ClusterPulse) []interface{} {
+	items := make([]interface{}, len(pulse.Tiles))
+	for i, tile := range pulse.Tiles {
+		entry := PulseEntry{
+			Group:        tile.Group,
+			Check:        tile.Title,
+			Count:        fmt.Sprintf("%d", tile.Count),
+			Status:       tile.Severity.String(),
+			TileID:       tile.ID,
+			ResourceType: string(tile.ResourceType),
+		}
+		if tile.ID == k8s.PulseTileWarningEvents {
+			rate := float64(tile.Count) / k8s.PulseEventWindow.Minutes()
+			entry.Status = fmt.Sprintf("%s (%.1f/min)", entry.Status, rate)
+		}
+		if !tile.Loaded {
+			entry.Count = "-"
+			entry.Status = "Loading"
+		}
+		items[i] = entry
+	}
+	return items
+}
+
+// drillDownPulseTile switches to the list screen of the selected tile,
+// filtered to the objects it counts
+func drillDownPulseTile(st *pulseState) NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		cursor := s.table.Cursor()
+		if cursor < 0 || cursor >= len(s.filtered) {
+			return nil
+		}
+		entry, ok := s.filtered[cursor].(PulseEntry)
+		if !ok {
+			return nil
+		}
+
+		if entry.TileID == k8s.PulseTileWarningEvents {
+			if st.pulse == nil {
+				return nil
+			}
+			content := formatPulseEvents(st.pulse.WarningEvents, time.Now())
+			return func() tea.Msg {
+				return types.ShowFullScreenMsg{
+					ViewType:     3, // Data
+					ResourceName: "Warning events (last " + FormatDuration(k8s.PulseEventWindow) + ")",
+					Content:      content,
+				}
+			}
+		}
+
+		if entry.ResourceType == "" {
+			return nil
+		}
+		return func() tea.Msg {
+			return types.ScreenSwitchMsg{
+				ScreenID: entry.ResourceType,
+				FilterContext: &types.FilterContext{
+					Field: "pulse",
+					Value: entry.TileID,
+					Metadata: map[string]string{
+						"title": entry.Group + " " + strings.ToLower(entry.Check),
+					},
+				},
+			}
+		}
+	}
+}
+
+// formatPulseEvents renders Warning events one per line, newest first
+func formatPulseEvents(events []k8s.PulseEvent, now time.Time) string {
+	if len(events) == 0 {
+		return "No Warning events in the last " + FormatDuration(k8s.PulseEventWindow)
+	}
+
+	var b strings.Builder
+	for _, event := range events {
+		object := event.Kind + "/" + event.Name
+		if event.Namespace != "" {
+			object = event.Namespace + "/" + object
+		}
+		count := ""
+		if event.Count > 1 {
+			count = fmt.Sprintf(" (x%d)", event.Count)
+		}
+		fmt.Fprintf(&b, "%-6s %-50s %s%s\n", FormatDuration(now.Sub(event.LastSeen)), object, event.Reason, count)
+		fmt.Fprintf(&b, "       %s\n", event.Message)
+	}
+	return b.String()
+}
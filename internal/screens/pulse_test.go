@@ -0,0 +1,98 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+)
+
+func newTestPulseScreen(t *testing.T) *ConfigScreen {
+	t.Helper()
+	return refreshedConfigScreen(t, GetPulseScreenConfig(), k8s.NewDummyRepository(), nil)
+}
+
+func TestPulseScreen_Refresh(t *testing.T) {
+	screen := newTestPulseScreen(t)
+
+	require.Len(t, screen.filtered, 6)
+	entry := screen.filtered[2].(PulseEntry)
+	assert.Equal(t, "Pods", entry.Group)
+	assert.Equal(t, "CrashLoopBackOff", entry.Check)
+	assert.Equal(t, "1", entry.Count)
+	assert.Equal(t, "CRITICAL", entry.Status)
+
+	events := screen.filtered[5].(PulseEntry)
+	assert.Equal(t, "OK (0.0/min)", events.Status)
+}
+
+func TestPulseEntries_NotLoaded(t *testing.T) {
+	entries := pulseEntries(&k8s.ClusterPulse{Tiles: []k8s.PulseTile{
+		{ID: "hpas-at-max", Group: "Autoscaling", Title: "HPAs at max replicas", ResourceType: k8s.ResourceTypeHPA},
+	}})
+
+	require.Len(t, entries, 1)
+	entry := entries[0].(PulseEntry)
+	assert.Equal(t, "-", entry.Count)
+	assert.Equal(t, "Loading", entry.Status)
+}
+
+func TestPulseScreen_DrillDown(t *testing.T) {
+	screen := newTestPulseScreen(t)
+	screen.table.SetCursor(2) // Pods CrashLoopBackOff
+
+	cmd := screen.config.NavigationHandler(screen)
+	require.NotNil(t, cmd)
+
+	switchMsg, ok := cmd().(types.ScreenSwitchMsg)
+	require.True(t, ok, "expected ScreenSwitchMsg")
+	assert.Equal(t, "pods", switchMsg.ScreenID)
+	require.NotNil(t, switchMsg.FilterContext)
+	assert.Equal(t, "pulse", switchMsg.FilterContext.Field)
+	assert.Equal(t, "pods-reason-CrashLoopBackOff", switchMsg.FilterContext.Value)
+	assert.Equal(t, "pulse: Pods crashloopbackoff", switchMsg.FilterContext.Description())
+
+	// The pods screen keeps only the pods the tile counts
+	pods := NewConfigScreen(GetPodsScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	pods.ApplyFilterContext(switchMsg.FilterContext)
+	items, err := pods.refreshWithFilterContext()
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "api-server-6b9f8c7d5e-qwert", items[0].(k8s.Pod).Name)
+}
+
+func TestPulseScreen_DrillDownWarningEvents(t *testing.T) {
+	screen := newTestPulseScreen(t)
+	screen.table.SetCursor(5)
+
+	cmd := screen.config.NavigationHandler(screen)
+	require.NotNil(t, cmd)
+
+	fullScreenMsg, ok := cmd().(types.ShowFullScreenMsg)
+	require.True(t, ok, "expected ShowFullScreenMsg")
+	assert.Equal(t, "Warning events (last 10m)", fullScreenMsg.ResourceName)
+	assert.Equal(t, "No Warning events in the last 10m", fullScreenMsg.Content)
+}
+
+func TestFormatPulseEvents(t *testing.T) {
+	now := time.Now()
+	content := formatPulseEvents([]k8s.PulseEvent{
+		{Namespace: "web", Kind: "Pod", Name: "crash", Reason: "BackOff", Message: "Back-off restarting failed container", Count: 4, LastSeen: now.Add(-2 * time.Minute)},
+		{Kind: "Node", Name: "node-2", Reason: "NodeNotReady", Message: "Node is not ready", Count: 1, LastSeen: now.Add(-5 * time.Minute)},
+	}, now)
+
+	lines := strings.Split(strings.TrimSpace(content), "\n")
+	require.Len(t, lines, 4)
+	assert.Contains(t, lines[0], "2m")
+	assert.Contains(t, lines[0], "web/Pod/crash")
+	assert.Contains(t, lines[0], "BackOff (x4)")
+	assert.Contains(t, lines[1], "Back-off restarting failed container")
+	assert.Contains(t, lines[2], "Node/node-2")
+	assert.NotContains(t, lines[2], "(x1)")
+}
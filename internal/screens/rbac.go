@@ -0,0 +1,164 @@
+package screens
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+)
+
+// RBAC explorer screen identifiers
+const (
+	PolicyRulesScreenID        = "policy-rules"
+	WhoCanScreenID             = "who-can"
+	SubjectPermissionsScreenID = "subject-permissions"
+)
+
+// GetPolicyRulesScreenConfig returns the config for the rules of a role.
+// The role is passed as a FilterContext (Field "role", kind and namespace
+// in Metadata).
+func GetPolicyRulesScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           PolicyRulesScreenID,
+		Title:        "Rules",
+		ResourceType: k8s.ResourceType(PolicyRulesScreenID),
+		Columns: []ColumnConfig{
+			{Field: "Verbs", Title: "Verbs", MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 1},
+			{Field: "Resources", Title: "Resources", MinWidth: 20, MaxWidth: 120, Weight: 3.0, Priority: 1},
+			{Field: "ResourceNames", Title: "Resource Names", MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 2},
+			{Field: "NonResourceURLs", Title: "Non-Resource URLs", MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 3},
+		},
+		SearchFields:          []string{"Verbs", "Resources", "ResourceNames", "NonResourceURLs"},
+		Operations:            []OperationConfig{},
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomRefresh: refreshRBAC("rules", func(repo k8s.Repository, ctx *types.FilterContext) ([]interface{}, error) {
+			rules, err := repo.GetRulesForRole(ctx.Metadata["kind"], ctx.Metadata["namespace"], ctx.Value)
+			return toItems(rules), err
+		}),
+		CustomUpdate: getPeriodicRefreshUpdate(),
+	}
+}
+
+// GetWhoCanScreenConfig returns the config for the subjects allowed to do
+// something. The request is passed as a FilterContext (Field "who-can",
+// resource as Value, verb and namespace in Metadata).
+func GetWhoCanScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           WhoCanScreenID,
+		Title:        "Who Can",
+		ResourceType: k8s.ResourceType(WhoCanScreenID),
+		Columns: []ColumnConfig{
+			{Field: "Subject", Title: "Subject", MinWidth: 20, MaxWidth: 60, Weight: 2.0, Priority: 1},
+			{Field: "Scope", Title: "Namespace", MinWidth: 10, MaxWidth: 30, Weight: 1.0, Priority: 1},
+			{Field: "Binding", Title: "Binding", MinWidth: 20, MaxWidth: 60, Weight: 2.0, Priority: 2},
+			{Field: "Role", Title: "Role", MinWidth: 15, MaxWidth: 50, Weight: 1.5, Priority: 1},
+			{Field: "ResourceNames", Title: "Resource Names", MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 3},
+		},
+		SearchFields: []string{"Subject", "Scope", "Binding", "Role"},
+		Operations: []OperationConfig{
+			{ID: "rules", Name: "Rules", Description: "View the rules of the role of the selected grant", Shortcut: "enter"},
+		},
+		NavigationHandler:     navigateToRulesForGrant(),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomRefresh: refreshRBAC("who can", func(repo k8s.Repository, ctx *types.FilterContext) ([]interface{}, error) {
+			grants, err := repo.GetSubjectsWhoCan(ctx.Metadata["verb"], ctx.Value, ctx.Metadata["namespace"])
+			return toItems(grants), err
+		}),
+		CustomUpdate: getPeriodicRefreshUpdate(),
+	}
+}
+
+// GetSubjectPermissionsScreenConfig returns the config for what a subject
+// can do. The subject is passed as a FilterContext (Field "what-can", kind
+// and namespace in Metadata).
+func GetSubjectPermissionsScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           SubjectPermissionsScreenID,
+		Title:        "Permissions",
+		ResourceType: k8s.ResourceType(SubjectPermissionsScreenID),
+		Columns: []ColumnConfig{
+			{Field: "Scope", Title: "Namespace", MinWidth: 10, MaxWidth: 30, Weight: 1.0, Priority: 1},
+			{Field: "Verbs", Title: "Verbs", MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 1},
+			{Field: "Resources", Title: "Resources", MinWidth: 20, MaxWidth: 120, Weight: 3.0, Priority: 1},
+			{Field: "ResourceNames", Title: "Resource Names", MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 3},
+			{Field: "NonResourceURLs", Title: "Non-Resource URLs", MinWidth: 10, MaxWidth: 40, Weight: 1.0, Priority: 3},
+			{Field: "Role", Title: "Role", MinWidth: 15, MaxWidth: 50, Weight: 1.5, Priority: 2},
+			{Field: "Binding", Title: "Binding", MinWidth: 20, MaxWidth: 60, Weight: 2.0, Priority: 3},
+		},
+		SearchFields: []string{"Scope", "Verbs", "Resources", "ResourceNames", "NonResourceURLs", "Role", "Binding"},
+		Operations: []OperationConfig{
+			{ID: "rules", Name: "Rules", Description: "View the rules of the role of the selected grant", Shortcut: "enter"},
+		},
+		NavigationHandler:     navigateToRulesForGrant(),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomRefresh: refreshRBAC("permissions", func(repo k8s.Repository, ctx *types.FilterContext) ([]interface{}, error) {
+			grants, err := repo.GetSubjectPermissions(ctx.Metadata["kind"], ctx.Metadata["namespace"], ctx.Value)
+			return toItems(grants), err
+		}),
+		CustomUpdate: getPeriodicRefreshUpdate(),
+	}
+}
+
+// refreshRBAC creates the refresh of an RBAC explorer screen from the query
+// of its filter context
+func refreshRBAC(what string, query func(k8s.Repository, *types.FilterContext) ([]interface{}, error)) func(s *ConfigScreen) tea.Cmd {
+	return func(s *ConfigScreen) tea.Cmd {
+		return func() tea.Msg {
+			start := time.Now()
+			if s.filterContext == nil || s.repo == nil {
+				s.items = []interface{}{}
+				s.applyFilter()
+				return types.RefreshCompleteMsg{Duration: 0}
+			}
+
+			items, err := query(s.repo, s.filterContext)
+			if err != nil {
+				return types.ErrorStatusMsg(fmt.Sprintf("Failed to get %s: %v", what, err))
+			}
+			s.items = items
+			s.applyFilter()
+			return types.RefreshCompleteMsg{Duration: time.Since(start)}
+		}
+	}
+}
+
+// toItems converts typed rows to table items
+func toItems[T any](rows []T) []interface{} {
+	items := make([]interface{}, len(rows))
+	for i, row := range rows {
+		items[i] = row
+	}
+	return items
+}
+
+// navigateToRulesForGrant creates handler for grant → rules of its role
+// (Roles are in the namespace of the grant)
+func navigateToRulesForGrant() NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		resource := s.GetSelectedResource()
+		if resource == nil {
+			return nil
+		}
+
+		role, _ := resource["role"].(string)
+		scope, _ := resource["scope"].(string)
+		kind, name, ok := strings.Cut(role, "/")
+		if !ok {
+			return nil
+		}
+		namespace := ""
+		if kind == "Role" {
+			namespace = scope
+		}
+		return navigateToRules(kind, namespace, name)
+	}
+}
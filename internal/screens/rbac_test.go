@@ -0,0 +1,162 @@
+package screens
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+)
+
+// switchMsg runs a navigation command and returns the screen switch
+func switchMsg(t *testing.T, cmd tea.Cmd) types.ScreenSwitchMsg {
+	t.Helper()
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(types.ScreenSwitchMsg)
+	require.True(t, ok, "expected ScreenSwitchMsg")
+	require.NotNil(t, msg.FilterContext)
+	return msg
+}
+
+func TestPolicyRulesScreen(t *testing.T) {
+	screen := refreshedConfigScreen(t, GetPolicyRulesScreenConfig(), k8s.NewDummyRepository(), &types.FilterContext{
+		Field:    "role",
+		Value:    "view",
+		Metadata: map[string]string{"kind": "ClusterRole"},
+	})
+
+	require.Len(t, screen.filtered, 3)
+	rule := screen.filtered[2].(k8s.PolicyRule)
+	assert.Equal(t, "configmaps", rule.Resources)
+	assert.Equal(t, "app-config", rule.ResourceNames)
+	assert.Equal(t, "rules of clusterrole: view", screen.GetFilterContext().Description())
+}
+
+func TestWhoCanScreen(t *testing.T) {
+	screen := refreshedConfigScreen(t, GetWhoCanScreenConfig(), k8s.NewDummyRepository(), &types.FilterContext{
+		Field:    "who-can",
+		Value:    "deployments",
+		Metadata: map[string]string{"verb": "delete", "namespace": "default"},
+	})
+
+	require.Len(t, screen.filtered, 2)
+	assert.Equal(t, "who can delete deployments in default", screen.GetFilterContext().Description())
+
+	// Enter opens the rules of the role granting it
+	screen.table.SetCursor(1)
+	msg := switchMsg(t, screen.handleEnterKey())
+	assert.Equal(t, PolicyRulesScreenID, msg.ScreenID)
+	assert.Equal(t, "edit", msg.FilterContext.Value)
+	assert.Equal(t, map[string]string{"kind": "ClusterRole", "namespace": ""}, msg.FilterContext.Metadata)
+}
+
+func TestSubjectPermissionsScreen(t *testing.T) {
+	screen := refreshedConfigScreen(t, GetSubjectPermissionsScreenConfig(), k8s.NewDummyRepository(), &types.FilterContext{
+		Field:    "what-can",
+		Value:    "deployer",
+		Metadata: map[string]string{"kind": "ServiceAccount", "namespace": "default"},
+	})
+
+	require.Len(t, screen.filtered, 2)
+	grant := screen.filtered[1].(k8s.RBACGrant)
+	assert.Equal(t, "ServiceAccount default/deployer", grant.Subject)
+	assert.Equal(t, "what serviceaccount default/deployer can do", screen.GetFilterContext().Description())
+}
+
+func TestNavigateToRulesForGrant_Role(t *testing.T) {
+	screen := NewConfigScreen(GetSubjectPermissionsScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.items = []interface{}{
+		k8s.RBACGrant{Name: "reader", Scope: "web", Role: "Role/pod-reader"},
+	}
+	screen.applyFilter()
+
+	// Roles are looked up in the namespace of the grant
+	msg := switchMsg(t, screen.handleEnterKey())
+	assert.Equal(t, "pod-reader", msg.FilterContext.Value)
+	assert.Equal(t, map[string]string{"kind": "Role", "namespace": "web"}, msg.FilterContext.Metadata)
+}
+
+func TestRBACNavigation(t *testing.T) {
+	// ServiceAccount → bindings
+	screen := NewConfigScreen(GetServiceAccountsScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.items = []interface{}{
+		k8s.ServiceAccount{ResourceMetadata: k8s.ResourceMetadata{Namespace: "default", Name: "deployer"}},
+	}
+	screen.applyFilter()
+	msg := switchMsg(t, screen.handleEnterKey())
+	assert.Equal(t, "rolebindings", msg.ScreenID)
+	assert.Equal(t, "subject", msg.FilterContext.Field)
+	assert.Equal(t, "deployer", msg.FilterContext.Value)
+	assert.Equal(t, map[string]string{"kind": "ServiceAccount", "namespace": "default"}, msg.FilterContext.Metadata)
+
+	// Binding → role (ClusterRoles are not namespaced)
+	screen = NewConfigScreen(GetRoleBindingsScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.items = []interface{}{
+		k8s.RoleBinding{ResourceMetadata: k8s.ResourceMetadata{Namespace: "default", Name: "deployer-edit"}, Role: "ClusterRole/edit"},
+		k8s.RoleBinding{ResourceMetadata: k8s.ResourceMetadata{Namespace: "web", Name: "api-reader"}, Role: "Role/pod-reader"},
+	}
+	screen.applyFilter()
+	msg = switchMsg(t, screen.handleEnterKey())
+	assert.Equal(t, "clusterroles", msg.ScreenID)
+	assert.Equal(t, "name", msg.FilterContext.Field)
+	assert.Equal(t, "edit", msg.FilterContext.Value)
+	assert.Equal(t, "", msg.FilterContext.Metadata["namespace"])
+	screen.table.SetCursor(1)
+	msg = switchMsg(t, screen.handleEnterKey())
+	assert.Equal(t, "roles", msg.ScreenID)
+	assert.Equal(t, "web", msg.FilterContext.Metadata["namespace"])
+
+	// Role → rules
+	screen = NewConfigScreen(GetRolesScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.items = []interface{}{
+		k8s.Role{ResourceMetadata: k8s.ResourceMetadata{Namespace: "web", Name: "pod-reader"}, Kind: "Role"},
+	}
+	screen.applyFilter()
+	msg = switchMsg(t, screen.handleEnterKey())
+	assert.Equal(t, PolicyRulesScreenID, msg.ScreenID)
+	assert.Equal(t, "role", msg.FilterContext.Field)
+	assert.Equal(t, map[string]string{"kind": "Role", "namespace": "web"}, msg.FilterContext.Metadata)
+}
+
+func TestRefreshWithFilterContext_Subject(t *testing.T) {
+	screen := NewConfigScreen(GetRoleBindingsScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.ApplyFilterContext(&types.FilterContext{
+		Field:    "subject",
+		Value:    "deployer",
+		Metadata: map[string]string{"kind": "ServiceAccount", "namespace": "default"},
+	})
+
+	items, err := screen.refreshWithFilterContext()
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "deployer-edit", items[0].(k8s.RoleBinding).Name)
+	assert.Equal(t, "bindings of serviceaccount: deployer", screen.GetFilterContext().Description())
+}
+
+func TestRefreshWithFilterContext_Name(t *testing.T) {
+	screen := NewConfigScreen(GetDeploymentsScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+	screen.ApplyFilterContext(&types.FilterContext{
+		Field:    "name",
+		Value:    "coredns",
+		Metadata: map[string]string{"kind": "Deployment", "namespace": "kube-system"},
+	})
+
+	items, err := screen.refreshWithFilterContext()
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "coredns", items[0].(k8s.Deployment).Name)
+
+	// Same name in another namespace
+	screen.ApplyFilterContext(&types.FilterContext{
+		Field:    "name",
+		Value:    "coredns",
+		Metadata: map[string]string{"kind": "Deployment", "namespace": "default"},
+	})
+	items, err = screen.refreshWithFilterContext()
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
@@ -1,6 +1,7 @@
 package screens
 
 import (
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -31,7 +32,9 @@ func GetPodsScreenConfig() ScreenConfig {
 			{ID: "logs", Name: "View Logs", Description: "View logs for selected pod", Shortcut: "l"},
 			{ID: "describe", Name: "Describe", Description: "Describe selected pod", Shortcut: "d"},
 			{ID: "delete", Name: "Delete", Description: "Delete selected pod", Shortcut: "x"},
+			{ID: "containers", Name: "Containers", Description: "View containers of selected pod", Shortcut: "enter"},
 		},
+		NavigationHandler:     navigateToContainersForPod(),
 		EnablePeriodicRefresh: true,
 		RefreshInterval:       RefreshInterval,
 		TrackSelection:        true,
@@ -160,10 +163,11 @@ func GetConfigMapsScreenConfig() ScreenConfig {
 		},
 		SearchFields: []string{"Namespace", "Name"},
 		Operations: []OperationConfig{
+			{ID: "data", Name: "Data", Description: "Browse keys of selected configmap", Shortcut: "enter"},
 			{ID: "describe", Name: "Describe", Description: "Describe selected configmap", Shortcut: "d"},
 			{ID: "delete", Name: "Delete", Description: "Delete selected configmap", Shortcut: "x"},
 		},
-		NavigationHandler:     navigateToPodsForVolumeSource("ConfigMap"),
+		NavigationHandler:     navigateToConfigMapData(), // Pods using it: /show-pods
 		EnablePeriodicRefresh: true,
 		RefreshInterval:       RefreshInterval,
 		TrackSelection:        true,
@@ -351,12 +355,19 @@ func GetNodesScreenConfig() ScreenConfig {
 			{ID: "describe", Name: "Describe", Description: "Describe selected node", Shortcut: "d"},
 			{ID: "cordon", Name: "Cordon", Description: "Cordon selected node", Shortcut: "c"},
 			{ID: "drain", Name: "Drain", Description: "Drain selected node", Shortcut: "r"},
+			{ID: "detail", Name: "Detail", Description: "Show node detail and pod allocation", Shortcut: "alt+enter"},
 		},
 		NavigationHandler:     navigateToPodsForNode(),
 		EnablePeriodicRefresh: true,
 		RefreshInterval:       RefreshInterval,
 		TrackSelection:        true,
-		CustomUpdate:          getPeriodicRefreshUpdate(),
+		CustomUpdate: func(s *ConfigScreen, msg tea.Msg) (tea.Model, tea.Cmd) {
+			// alt+enter opens the node detail (plain enter lists the node's pods)
+			if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.Type == tea.KeyEnter && keyMsg.Alt {
+				return s, s.navigate(navigateToNodeDetail())
+			}
+			return getPeriodicRefreshUpdate()(s, msg)
+		},
 	}
 }
 
@@ -407,11 +418,111 @@ func GetPVCsScreenConfig() ScreenConfig {
 		Operations: []OperationConfig{
 			{ID: "describe", Name: "Describe", Description: "Describe selected PVC", Shortcut: "d"},
 			{ID: "yaml", Name: "YAML", Description: "View YAML", Shortcut: "y"},
+			{ID: "volume", Name: "Volume", Description: "Show the PersistentVolume of selected PVC", Shortcut: "alt+enter"},
 		},
 		NavigationHandler:     navigateToPodsForPVC(),
 		EnablePeriodicRefresh: true,
 		RefreshInterval:       RefreshInterval,
 		TrackSelection:        true,
+		CustomUpdate: func(s *ConfigScreen, msg tea.Msg) (tea.Model, tea.Cmd) {
+			// alt+enter opens the bound volume (plain enter lists the pods using the claim)
+			if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.Type == tea.KeyEnter && keyMsg.Alt {
+				return s, s.navigate(navigateToPVForPVC())
+			}
+			return getPeriodicRefreshUpdate()(s, msg)
+		},
+	}
+}
+
+// GetPVsScreenConfig returns the configuration for PersistentVolumes screen
+func GetPVsScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           "persistentvolumes",
+		Title:        "PersistentVolumes",
+		ResourceType: k8s.ResourceTypePersistentVolume,
+		Columns: []ColumnConfig{
+			{Field: "Name", Title: "Name", Width: 40, Priority: 1},
+			{Field: "Capacity", Title: "Capacity", Width: 10, Priority: 1},
+			{Field: "AccessModes", Title: "Access", Width: 14, Priority: 3},
+			{Field: "ReclaimPolicy", Title: "Reclaim", Width: 8, Priority: 2},
+			{Field: "Status", Title: "Status", Width: 10, Priority: 1},
+			{Field: "Claim", Title: "Claim", Width: 0, Priority: 1},
+			{Field: "StorageClass", Title: "StorageClass", Width: 0, Priority: 2},
+			{Field: "Driver", Title: "Driver", Width: 0, Priority: 3},
+			{Field: "Age", Title: "Age", Width: 10, Format: FormatDuration, Priority: 1},
+		},
+		SearchFields: []string{"Name", "Status", "Claim", "StorageClass", "Driver"},
+		Operations: []OperationConfig{
+			{ID: "describe", Name: "Describe", Description: "Describe selected PersistentVolume", Shortcut: "d"},
+			{ID: "yaml", Name: "YAML", Description: "View YAML", Shortcut: "y"},
+			{ID: "storageclass", Name: "StorageClass", Description: "Show the StorageClass of selected PersistentVolume", Shortcut: "alt+enter"},
+		},
+		NavigationHandler:     navigateToAttachmentsForPV(),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomUpdate: func(s *ConfigScreen, msg tea.Msg) (tea.Model, tea.Cmd) {
+			// alt+enter opens the storage class (plain enter lists the volume's attachments)
+			if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.Type == tea.KeyEnter && keyMsg.Alt {
+				return s, s.navigate(navigateToStorageClassForPV())
+			}
+			return getPeriodicRefreshUpdate()(s, msg)
+		},
+	}
+}
+
+// GetStorageClassesScreenConfig returns the configuration for StorageClasses screen
+func GetStorageClassesScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           "storageclasses",
+		Title:        "StorageClasses",
+		ResourceType: k8s.ResourceTypeStorageClass,
+		Columns: []ColumnConfig{
+			{Field: "Name", Title: "Name", Width: 30, Priority: 1},
+			{Field: "Default", Title: "Default", Width: 8, Priority: 2},
+			{Field: "Provisioner", Title: "Provisioner", Width: 0, Priority: 1},
+			{Field: "ReclaimPolicy", Title: "Reclaim", Width: 8, Priority: 1},
+			{Field: "VolumeBindingMode", Title: "Binding Mode", Width: 20, Priority: 2},
+			{Field: "AllowExpansion", Title: "Expansion", Width: 10, Priority: 3},
+			{Field: "Age", Title: "Age", Width: 10, Format: FormatDuration, Priority: 1},
+		},
+		SearchFields: []string{"Name", "Provisioner", "ReclaimPolicy", "VolumeBindingMode"},
+		Operations: []OperationConfig{
+			{ID: "describe", Name: "Describe", Description: "Describe selected StorageClass", Shortcut: "d"},
+			{ID: "yaml", Name: "YAML", Description: "View YAML", Shortcut: "y"},
+		},
+		NavigationHandler:     navigateToPVCsForStorageClass(),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomUpdate:          getPeriodicRefreshUpdate(),
+	}
+}
+
+// GetVolumeAttachmentsScreenConfig returns the configuration for VolumeAttachments screen
+func GetVolumeAttachmentsScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           "volumeattachments",
+		Title:        "VolumeAttachments",
+		ResourceType: k8s.ResourceTypeVolumeAttachment,
+		Columns: []ColumnConfig{
+			{Field: "Name", Title: "Name", Width: 30, Priority: 2},
+			{Field: "PersistentVolume", Title: "PersistentVolume", Width: 40, Priority: 1},
+			{Field: "Node", Title: "Node", Width: 30, Priority: 1},
+			{Field: "Attacher", Title: "Attacher", Width: 0, Priority: 3},
+			{Field: "Attached", Title: "Attached", Width: 9, Priority: 1},
+			{Field: "Error", Title: "Error", Width: 0, Priority: 1},
+			{Field: "Age", Title: "Age", Width: 10, Format: FormatDuration, Priority: 1},
+		},
+		SearchFields: []string{"Name", "PersistentVolume", "Node", "Attacher", "Error"},
+		Operations: []OperationConfig{
+			{ID: "describe", Name: "Describe", Description: "Describe selected VolumeAttachment", Shortcut: "d"},
+			{ID: "yaml", Name: "YAML", Description: "View YAML", Shortcut: "y"},
+		},
+		NavigationHandler:     navigateToNodeForAttachment(),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
 		CustomUpdate:          getPeriodicRefreshUpdate(),
 	}
 }
@@ -498,6 +609,136 @@ func GetHPAsScreenConfig() ScreenConfig {
 	}
 }
 
+// GetServiceAccountsScreenConfig returns the configuration for ServiceAccounts screen
+func GetServiceAccountsScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           "serviceaccounts",
+		Title:        "ServiceAccounts",
+		ResourceType: k8s.ResourceTypeServiceAccount,
+		Columns: []ColumnConfig{
+			{Field: "Namespace", Title: "Namespace", Width: 0, Priority: 2},
+			{Field: "Name", Title: "Name", Width: 40, Priority: 1},
+			{Field: "Secrets", Title: "Secrets", Width: 8, Priority: 1},
+			{Field: "ImagePullSecrets", Title: "Pull Secrets", Width: 12, Priority: 3},
+			{Field: "Automount", Title: "Automount", Width: 10, Priority: 3},
+			{Field: "Age", Title: "Age", Width: 10, Format: FormatDuration, Priority: 1},
+		},
+		SearchFields: []string{"Namespace", "Name"},
+		Operations: []OperationConfig{
+			{ID: "describe", Name: "Describe", Description: "Describe selected ServiceAccount", Shortcut: "d"},
+			{ID: "yaml", Name: "YAML", Description: "View YAML", Shortcut: "y"},
+			{ID: "what-can", Name: "What Can", Description: "Show what the selected ServiceAccount can do", Shortcut: "/what-can"},
+		},
+		NavigationHandler:     navigateToBindingsForServiceAccount(),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomUpdate:          getPeriodicRefreshUpdate(),
+	}
+}
+
+// GetRolesScreenConfig returns the configuration for Roles screen
+func GetRolesScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           "roles",
+		Title:        "Roles",
+		ResourceType: k8s.ResourceTypeRole,
+		Columns: []ColumnConfig{
+			{Field: "Namespace", Title: "Namespace", Width: 0, Priority: 2},
+			{Field: "Name", Title: "Name", Width: 0, Priority: 1},
+			{Field: "Rules", Title: "Rules", Width: 8, Priority: 1},
+			{Field: "Age", Title: "Age", Width: 10, Format: FormatDuration, Priority: 1},
+		},
+		SearchFields: []string{"Namespace", "Name"},
+		Operations: []OperationConfig{
+			{ID: "describe", Name: "Describe", Description: "Describe selected Role", Shortcut: "d"},
+			{ID: "yaml", Name: "YAML", Description: "View YAML", Shortcut: "y"},
+		},
+		NavigationHandler:     navigateToRulesForRole("Role"),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomUpdate:          getPeriodicRefreshUpdate(),
+	}
+}
+
+// GetClusterRolesScreenConfig returns the configuration for ClusterRoles screen
+func GetClusterRolesScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           "clusterroles",
+		Title:        "ClusterRoles",
+		ResourceType: k8s.ResourceTypeClusterRole,
+		Columns: []ColumnConfig{
+			{Field: "Name", Title: "Name", Width: 0, Priority: 1},
+			{Field: "Rules", Title: "Rules", Width: 8, Priority: 1},
+			{Field: "Aggregated", Title: "Aggregated", Width: 10, Priority: 3},
+			{Field: "Age", Title: "Age", Width: 10, Format: FormatDuration, Priority: 1},
+		},
+		SearchFields: []string{"Name"},
+		Operations: []OperationConfig{
+			{ID: "describe", Name: "Describe", Description: "Describe selected ClusterRole", Shortcut: "d"},
+			{ID: "yaml", Name: "YAML", Description: "View YAML", Shortcut: "y"},
+		},
+		NavigationHandler:     navigateToRulesForRole("ClusterRole"),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomUpdate:          getPeriodicRefreshUpdate(),
+	}
+}
+
+// GetRoleBindingsScreenConfig returns the configuration for RoleBindings screen
+func GetRoleBindingsScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           "rolebindings",
+		Title:        "RoleBindings",
+		ResourceType: k8s.ResourceTypeRoleBinding,
+		Columns: []ColumnConfig{
+			{Field: "Namespace", Title: "Namespace", Width: 0, Priority: 2},
+			{Field: "Name", Title: "Name", Width: 30, Priority: 1},
+			{Field: "Kind", Title: "Kind", Width: 18, Priority: 3},
+			{Field: "Role", Title: "Role", Width: 30, Priority: 1},
+			{Field: "Subjects", Title: "Subjects", Width: 0, Priority: 1},
+			{Field: "Age", Title: "Age", Width: 10, Format: FormatDuration, Priority: 1},
+		},
+		SearchFields: []string{"Namespace", "Name", "Role", "Subjects"},
+		Operations: []OperationConfig{
+			{ID: "describe", Name: "Describe", Description: "Describe selected RoleBinding", Shortcut: "d"},
+			{ID: "yaml", Name: "YAML", Description: "View YAML", Shortcut: "y"},
+		},
+		NavigationHandler:     navigateToRoleForBinding(),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomUpdate:          getPeriodicRefreshUpdate(),
+	}
+}
+
+// GetClusterRoleBindingsScreenConfig returns the configuration for ClusterRoleBindings screen
+func GetClusterRoleBindingsScreenConfig() ScreenConfig {
+	return ScreenConfig{
+		ID:           "clusterrolebindings",
+		Title:        "ClusterRoleBindings",
+		ResourceType: k8s.ResourceTypeClusterRoleBinding,
+		Columns: []ColumnConfig{
+			{Field: "Name", Title: "Name", Width: 40, Priority: 1},
+			{Field: "Role", Title: "Role", Width: 40, Priority: 1},
+			{Field: "Subjects", Title: "Subjects", Width: 0, Priority: 1},
+			{Field: "Age", Title: "Age", Width: 10, Format: FormatDuration, Priority: 1},
+		},
+		SearchFields: []string{"Name", "Role", "Subjects"},
+		Operations: []OperationConfig{
+			{ID: "describe", Name: "Describe", Description: "Describe selected ClusterRoleBinding", Shortcut: "d"},
+			{ID: "yaml", Name: "YAML", Description: "View YAML", Shortcut: "y"},
+		},
+		NavigationHandler:     navigateToRoleForBinding(),
+		EnablePeriodicRefresh: true,
+		RefreshInterval:       RefreshInterval,
+		TrackSelection:        true,
+		CustomUpdate:          getPeriodicRefreshUpdate(),
+	}
+}
+
 // GetCRDsScreenConfig returns the config for the CRDs screen
 func GetCRDsScreenConfig() ScreenConfig {
 	return ScreenConfig{
@@ -579,3 +820,60 @@ func GetOutputScreenConfig(buffer *components.OutputBuffer) ScreenConfig {
 		TrackSelection: false,
 	}
 }
+
+// resourceAliases maps the short names accepted by GetListScreenConfig
+var resourceAliases = map[string]string{
+	"pvcs": "persistentvolumeclaims",
+	"hpas": "horizontalpodautoscalers",
+	"crds": "customresourcedefinitions",
+	"ns":   "namespaces",
+	"sa":   "serviceaccounts",
+	"pvs":  "persistentvolumes",
+	"sc":   "storageclasses",
+}
+
+// ListScreenConfigs returns the configs of the resource list screens
+func ListScreenConfigs() []ScreenConfig {
+	return []ScreenConfig{
+		GetPodsScreenConfig(),
+		GetDeploymentsScreenConfig(),
+		GetServicesScreenConfig(),
+		GetConfigMapsScreenConfig(),
+		GetSecretsScreenConfig(),
+		GetNamespacesScreenConfig(),
+		GetCRDsScreenConfig(),
+		GetStatefulSetsScreenConfig(),
+		GetDaemonSetsScreenConfig(),
+		GetJobsScreenConfig(),
+		GetCronJobsScreenConfig(),
+		GetNodesScreenConfig(),
+		GetReplicaSetsScreenConfig(),
+		GetPVCsScreenConfig(),
+		GetPVsScreenConfig(),
+		GetStorageClassesScreenConfig(),
+		GetVolumeAttachmentsScreenConfig(),
+		GetIngressesScreenConfig(),
+		GetEndpointsScreenConfig(),
+		GetHPAsScreenConfig(),
+		GetServiceAccountsScreenConfig(),
+		GetRolesScreenConfig(),
+		GetClusterRolesScreenConfig(),
+		GetRoleBindingsScreenConfig(),
+		GetClusterRoleBindingsScreenConfig(),
+	}
+}
+
+// GetListScreenConfig returns the list screen config of a resource (screen
+// ID or alias such as pvcs)
+func GetListScreenConfig(resource string) (ScreenConfig, bool) {
+	resource = strings.ToLower(resource)
+	if alias, ok := resourceAliases[resource]; ok {
+		resource = alias
+	}
+	for _, cfg := range ListScreenConfigs() {
+		if cfg.ID == resource {
+			return cfg, true
+		}
+	}
+	return ScreenConfig{}, false
+}
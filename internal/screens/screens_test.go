@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
 	"github.com/renato0307/k1/internal/ui"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -244,12 +245,14 @@ func TestScreenConfigs_NavigationHandlers(t *testing.T) {
 		name            string
 		getConfig       func() ScreenConfig
 		shouldHaveNav   bool
-		expectedNavType string // "owner", "node", "service", "namespace", "volume", "cronjob"
+		expectedNavType string // "pod", "owner", "node", "service", "namespace", "volume", "configmap", "cronjob"
+		expectedScreen  string // Screen switched to (checked when set)
 	}{
 		{
-			name:          "Pods should not have navigation handler",
-			getConfig:     GetPodsScreenConfig,
-			shouldHaveNav: false,
+			name:            "Pods should navigate to containers",
+			getConfig:       GetPodsScreenConfig,
+			shouldHaveNav:   true,
+			expectedNavType: "pod",
 		},
 		{
 			name:            "Deployments should navigate to pods",
@@ -264,10 +267,11 @@ func TestScreenConfigs_NavigationHandlers(t *testing.T) {
 			expectedNavType: "service",
 		},
 		{
-			name:            "ConfigMaps should navigate to pods",
+			name:            "ConfigMaps should navigate to key browser",
 			getConfig:       GetConfigMapsScreenConfig,
 			shouldHaveNav:   true,
-			expectedNavType: "volume",
+			expectedNavType: "configmap",
+			expectedScreen:  ConfigMapDataScreenID,
 		},
 		{
 			name:            "Secrets should navigate to pods",
@@ -358,6 +362,8 @@ func TestScreenConfigs_NavigationHandlers(t *testing.T) {
 
 				// Add mock data based on screen type
 				switch tt.expectedNavType {
+				case "pod":
+					screen.items = []interface{}{k8s.Pod{ResourceMetadata: k8s.ResourceMetadata{Namespace: "test", Name: "test-pod"}}}
 				case "owner":
 					screen.items = []interface{}{k8s.Deployment{ResourceMetadata: k8s.ResourceMetadata{Namespace: "test", Name: "test-deploy"}}}
 				case "node":
@@ -366,7 +372,7 @@ func TestScreenConfigs_NavigationHandlers(t *testing.T) {
 					screen.items = []interface{}{k8s.Service{ResourceMetadata: k8s.ResourceMetadata{Namespace: "test", Name: "test-svc"}}}
 				case "namespace":
 					screen.items = []interface{}{k8s.Namespace{ResourceMetadata: k8s.ResourceMetadata{Name: "test-ns"}}}
-				case "volume":
+				case "volume", "configmap":
 					screen.items = []interface{}{k8s.ConfigMap{ResourceMetadata: k8s.ResourceMetadata{Namespace: "test", Name: "test-cm"}}}
 				case "cronjob":
 					screen.items = []interface{}{k8s.CronJob{ResourceMetadata: k8s.ResourceMetadata{Namespace: "test", Name: "test-cron"}}}
@@ -384,10 +390,38 @@ func TestScreenConfigs_NavigationHandlers(t *testing.T) {
 
 				// Call the handler
 				cmd := config.NavigationHandler(screen)
-				assert.NotNil(t, cmd, "Navigation handler should return a command")
+				require.NotNil(t, cmd, "Navigation handler should return a command")
+				if tt.expectedScreen != "" {
+					switchMsg, ok := cmd().(types.ScreenSwitchMsg)
+					require.True(t, ok, "Navigation handler should switch screens")
+					assert.Equal(t, tt.expectedScreen, switchMsg.ScreenID)
+				}
 			} else {
 				assert.Nil(t, config.NavigationHandler, "Screen should not have navigation handler")
 			}
 		})
 	}
 }
+
+func TestGetListScreenConfig(t *testing.T) {
+	tests := []struct {
+		resource string
+		id       string
+		ok       bool
+	}{
+		{"pods", "pods", true},
+		{"Deployments", "deployments", true},
+		{"pvcs", "persistentvolumeclaims", true},
+		{"hpas", "horizontalpodautoscalers", true},
+		{"help", "", false},
+		{"unknown", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resource, func(t *testing.T) {
+			cfg, ok := GetListScreenConfig(tt.resource)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.id, cfg.ID)
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package screens
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/commands"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/logging"
+	"github.com/renato0307/k1/internal/types"
+)
+
+const (
+	// SecretDataScreenID is the screen identifier for the decoded Secret viewer
+	SecretDataScreenID = "secret-data"
+
+	// secretMask replaces values that have not been revealed
+	secretMask = "••••••••"
+)
+
+// SecretDataEntry is a row of the decoded Secret viewer (one per data key)
+type SecretDataEntry struct {
+	Key    string
+	Format string // Non-sensitive summary (format, certificate subject/expiry, registries)
+	Size   string
+	Value  string // Masked unless revealed
+}
+
+// secretDataState holds the decoded payload and per-key reveal state of the
+// Secret currently shown. Payloads live only here (never in table rows or the
+// informer cache) and are dropped when the screen is left or another Secret
+// is opened.
+type secretDataState struct {
+	namespace string
+	name      string
+	data      map[string][]byte
+	revealed  map[string]bool
+}
+
+// GetSecretDataScreenConfig returns the config for the decoded Secret viewer.
+// The Secret to show is passed as a FilterContext (Field "data").
+func GetSecretDataScreenConfig() ScreenConfig {
+	state := &secretDataState{}
+
+	return ScreenConfig{
+		ID:           SecretDataScreenID,
+		Title:        "Secret Data",
+		ResourceType: k8s.ResourceType(SecretDataScreenID),
+		Columns: []ColumnConfig{
+			{Field: "Key", Title: "Key", MinWidth: 15, MaxWidth: 40, Weight: 1.0, Priority: 1},
+			{Field: "Format", Title: "Format", MinWidth: 15, MaxWidth: 60, Weight: 1.5, Priority: 2},
+			{Field: "Size", Title: "Size", MinWidth: 8, MaxWidth: 10, Weight: 0.3, Priority: 3},
+			{Field: "Value", Title: "Value", MinWidth: 20, MaxWidth: 120, Weight: 2.5, Priority: 1},
+		},
+		SearchFields: []string{"Key", "Format"},
+		Operations: []OperationConfig{
+			{ID: "reveal", Name: "Reveal", Description: "Reveal/mask selected value", Shortcut: "space"},
+			{ID: "copy", Name: "Copy", Description: "Copy selected value to clipboard", Shortcut: "c"},
+			{ID: "view", Name: "View", Description: "View selected value (pretty-printed)", Shortcut: "enter"},
+		},
+		NavigationHandler: viewSecretValue(state),
+		CustomReset: func(s *ConfigScreen) {
+			state.clear()
+			s.items = []interface{}{}
+			s.applyFilter()
+		},
+		CustomRefresh: func(s *ConfigScreen) tea.Cmd {
+			return func() tea.Msg {
+				start := time.Now()
+				if s.filterContext == nil || s.repo == nil {
+					s.items = []interface{}{}
+					s.applyFilter()
+					return types.RefreshCompleteMsg{Duration: 0}
+				}
+
+				namespace := s.filterContext.Metadata["namespace"]
+				name := s.filterContext.Value
+				data, err := s.repo.GetSecretData(namespace, name)
+				if err != nil {
+					return types.ErrorStatusMsg(fmt.Sprintf("Failed to fetch secret: %v", err))
+				}
+
+				// Reset reveal state when a different Secret is opened
+				if state.namespace != namespace || state.name != name {
+					state.revealed = make(map[string]bool)
+				}
+				state.namespace = namespace
+				state.name = name
+				state.data = data
+
+				s.items = state.entries()
+				s.applyFilter()
+				return types.RefreshCompleteMsg{Duration: time.Since(start)}
+			}
+		},
+		CustomUpdate: func(s *ConfigScreen, msg tea.Msg) (tea.Model, tea.Cmd) {
+			if keyMsg, ok := msg.(tea.KeyMsg); ok {
+				switch keyMsg.String() {
+				case " ":
+					return s, toggleSecretReveal(s, state)
+				case "c":
+					return s, copySecretValue(s, state)
+				}
+			}
+			return s.DefaultUpdate(msg)
+		},
+	}
+}
+
+// clear drops the decoded payload and reveal state
+func (st *secretDataState) clear() {
+	st.namespace = ""
+	st.name = ""
+	st.data = nil
+	st.revealed = make(map[string]bool)
+}
+
+// entries builds table rows from the decoded payload (sorted by key)
+func (st *secretDataState) entries() []interface{} {
+	keys := make([]string, 0, len(st.data))
+	for key := range st.data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	items := make([]interface{}, len(keys))
+	for i, key := range keys {
+		value := st.data[key]
+		display := secretMask
+		if st.revealed[key] {
+			display = previewData(key, value)
+		}
+		items[i] = SecretDataEntry{
+			Key:    key,
+			Format: summarizeData(key, value),
+			Size:   formatDataSize(len(value)),
+			Value:  display,
+		}
+	}
+	return items
+}
+
+// selectedDataKey returns the data key of the selected row (Secret and
+// ConfigMap viewers)
+func selectedDataKey(s *ConfigScreen) string {
+	resource := s.GetSelectedResource()
+	if resource == nil {
+		return ""
+	}
+	key, _ := resource["key"].(string)
+	return key
+}
+
+// auditSecretAccess writes a secret value access to the k1 log
+func auditSecretAccess(s *ConfigScreen, st *secretDataState, action, key string) {
+	contextName := ""
+	if s.repo != nil {
+		contextName = s.repo.GetContext()
+	}
+	logging.Info("Secret value accessed",
+		"action", action,
+		"context", contextName,
+		"namespace", st.namespace,
+		"secret", st.name,
+		"key", key,
+	)
+}
+
+// toggleSecretReveal reveals or masks the selected value
+func toggleSecretReveal(s *ConfigScreen, st *secretDataState) tea.Cmd {
+	key := selectedDataKey(s)
+	if key == "" {
+		return nil
+	}
+
+	st.revealed[key] = !st.revealed[key]
+	if st.revealed[key] {
+		auditSecretAccess(s, st, "reveal", key)
+	}
+
+	// Rebuild rows in place (cursor stays on the same key)
+	cursor := s.table.Cursor()
+	s.items = st.entries()
+	s.applyFilter()
+	s.table.SetCursor(cursor)
+	return nil
+}
+
+// copySecretValue copies the selected decoded value to the clipboard
+func copySecretValue(s *ConfigScreen, st *secretDataState) tea.Cmd {
+	key := selectedDataKey(s)
+	value, ok := st.data[key]
+	if key == "" || !ok {
+		return nil
+	}
+
+	auditSecretAccess(s, st, "copy", key)
+	// CopyToClipboard's message echoes the copied text - never show secret values
+	if _, err := commands.CopyToClipboard(string(value)); err != nil {
+		return func() tea.Msg {
+			return types.ErrorStatusMsg(err.Error())
+		}
+	}
+	return func() tea.Msg {
+		return types.SuccessMsg(fmt.Sprintf("Copied %s to clipboard", key))
+	}
+}
+
+// viewSecretValue opens the selected value pretty-printed in the full-screen
+// viewer (counts as a reveal)
+func viewSecretValue(st *secretDataState) NavigationFunc {
+	return func(s *ConfigScreen) tea.Cmd {
+		key := selectedDataKey(s)
+		value, ok := st.data[key]
+		if key == "" || !ok {
+			return nil
+		}
+
+		auditSecretAccess(s, st, "view", key)
+		content := prettyPrintData(key, value)
+		displayName := strings.Join([]string{st.namespace, st.name, key}, "/")
+		return func() tea.Msg {
+			return types.ShowFullScreenMsg{
+				ViewType:     3, // Data
+				ResourceName: displayName,
+				Content:      content,
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package screens
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/types"
+	"github.com/renato0307/k1/internal/ui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSecretDataScreen opens the viewer on a dummy secret
+func newTestSecretDataScreen(t *testing.T) *ConfigScreen {
+	t.Helper()
+	return refreshedConfigScreen(t, GetSecretDataScreenConfig(), k8s.NewDummyRepository(), &types.FilterContext{
+		Field:    "data",
+		Value:    "db-creds",
+		Metadata: map[string]string{"namespace": "default", "kind": "Secret"},
+	})
+}
+
+// findSecretEntry returns the row for a key
+func findSecretEntry(t *testing.T, screen *ConfigScreen, key string) (int, SecretDataEntry) {
+	t.Helper()
+	for i, item := range screen.filtered {
+		if entry := item.(SecretDataEntry); entry.Key == key {
+			return i, entry
+		}
+	}
+	t.Fatalf("key %s not found", key)
+	return -1, SecretDataEntry{}
+}
+
+func TestSecretDataScreen_MaskedByDefault(t *testing.T) {
+	screen := newTestSecretDataScreen(t)
+
+	require.Len(t, screen.filtered, 3)
+	for _, item := range screen.filtered {
+		assert.Equal(t, secretMask, item.(SecretDataEntry).Value)
+	}
+
+	_, entry := findSecretEntry(t, screen, "config")
+	assert.Equal(t, "JSON", entry.Format)
+}
+
+func TestSecretDataScreen_ToggleReveal(t *testing.T) {
+	screen := newTestSecretDataScreen(t)
+	index, _ := findSecretEntry(t, screen, "password")
+	screen.table.SetCursor(index)
+
+	space := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}}
+	screen.Update(space)
+	_, entry := findSecretEntry(t, screen, "password")
+	assert.Equal(t, "s3cr3t", entry.Value)
+	assert.Equal(t, index, screen.table.Cursor(), "cursor should stay on the revealed key")
+
+	// Other keys stay masked
+	_, other := findSecretEntry(t, screen, "username")
+	assert.Equal(t, secretMask, other.Value)
+
+	screen.Update(space)
+	_, entry = findSecretEntry(t, screen, "password")
+	assert.Equal(t, secretMask, entry.Value)
+}
+
+func TestSecretDataScreen_EnterOpensPrettyPrintedValue(t *testing.T) {
+	screen := newTestSecretDataScreen(t)
+	index, _ := findSecretEntry(t, screen, "config")
+	screen.table.SetCursor(index)
+
+	_, cmd := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+
+	msg, ok := cmd().(types.ShowFullScreenMsg)
+	require.True(t, ok)
+	assert.Equal(t, 3, msg.ViewType)
+	assert.Equal(t, "default/db-creds/config", msg.ResourceName)
+	assert.Contains(t, msg.Content, "\"replicas\": 3")
+}
+
+func TestSecretDataScreen_NoSecretSelected(t *testing.T) {
+	screen := NewConfigScreen(GetSecretDataScreenConfig(), k8s.NewDummyRepository(), ui.GetTheme("charm"))
+
+	msg := screen.Refresh()()
+	_, ok := msg.(types.RefreshCompleteMsg)
+	assert.True(t, ok)
+	assert.Empty(t, screen.filtered)
+}
+
+func TestSecretDataScreen_LeaveDropsValues(t *testing.T) {
+	screen := newTestSecretDataScreen(t)
+	index, _ := findSecretEntry(t, screen, "password")
+	screen.table.SetCursor(index)
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+
+	screen.Leave()
+	assert.Empty(t, screen.filtered)
+	assert.Empty(t, screen.table.Rows())
+
+	// Coming back refetches with every value masked again
+	screen.Refresh()()
+	_, entry := findSecretEntry(t, screen, "password")
+	assert.Equal(t, secretMask, entry.Value)
+}
+
+func TestSecretDataScreen_FilterContextChangeDropsValues(t *testing.T) {
+	screen := newTestSecretDataScreen(t)
+	index, _ := findSecretEntry(t, screen, "password")
+	screen.table.SetCursor(index)
+	screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+
+	// Same Secret: rows are kept
+	screen.ApplyFilterContext(&types.FilterContext{
+		Field:    "data",
+		Value:    "db-creds",
+		Metadata: map[string]string{"namespace": "default", "kind": "Secret"},
+	})
+	_, entry := findSecretEntry(t, screen, "password")
+	assert.Equal(t, "s3cr3t", entry.Value)
+
+	screen.ApplyFilterContext(&types.FilterContext{
+		Field:    "data",
+		Value:    "api-token",
+		Metadata: map[string]string{"namespace": "default", "kind": "Secret"},
+	})
+	assert.Empty(t, screen.filtered)
+}
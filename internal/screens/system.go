@@ -116,14 +116,20 @@ func (s *SystemScreen) refresh() tea.Cmd {
 		var totalUpdates int64
 		var totalDeletes int64
 		syncedCount := 0
+		forbiddenCount := 0
 
 		rows := make([]table.Row, 0, len(stats)+2) // +2 for separator and totals
 		for _, stat := range stats {
 			syncedStr := "Yes"
-			if !stat.Synced {
-				syncedStr = "No"
-			} else {
+			switch {
+			case stat.Synced:
 				syncedCount++
+			case stat.Forbidden:
+				// RBAC denies listing: the informer will never sync
+				syncedStr = "Forbidden"
+				forbiddenCount++
+			default:
+				syncedStr = "No"
 			}
 
 			memoryMB := fmt.Sprintf("%.2f MB", float64(stat.MemoryBytes)/1024/1024)
@@ -167,8 +173,12 @@ func (s *SystemScreen) refresh() tea.Cmd {
 		// Add totals row
 		totalMemoryMB := fmt.Sprintf("%.2f MB", float64(totalMemory)/1024/1024)
 		syncedSummary := fmt.Sprintf("%d/%d", syncedCount, len(stats))
+		totalLabel := "TOTAL"
+		if forbiddenCount > 0 {
+			totalLabel = fmt.Sprintf("TOTAL (%d forbidden)", forbiddenCount)
+		}
 		rows = append(rows, table.Row{
-			"TOTAL",
+			totalLabel,
 			fmt.Sprintf("%d", totalCount),
 			totalMemoryMB,
 			syncedSummary,
@@ -201,3 +201,32 @@ func TestSystemScreen_FilterContext(t *testing.T) {
 	ctx := screen.GetFilterContext()
 	assert.Nil(t, ctx)
 }
+
+// forbiddenStatsRepository reports secrets as forbidden to the user
+type forbiddenStatsRepository struct {
+	*k8s.DummyRepository
+}
+
+func (r *forbiddenStatsRepository) GetResourceStats() []k8s.ResourceStats {
+	return []k8s.ResourceStats{
+		{ResourceType: k8s.ResourceTypePod, Count: 4, Synced: true},
+		{ResourceType: k8s.ResourceTypeSecret, Forbidden: true, ForbiddenReason: "cannot list secrets at cluster scope"},
+		{ResourceType: k8s.ResourceTypeJob},
+	}
+}
+
+func TestSystemScreen_Forbidden(t *testing.T) {
+	repo := &forbiddenStatsRepository{DummyRepository: k8s.NewDummyRepository()}
+	screen := NewSystemScreen(repo, ui.GetTheme("charm"))
+
+	_, ok := screen.refresh()().(types.RefreshCompleteMsg)
+	require.True(t, ok)
+
+	rows := screen.table.Rows()
+	require.Len(t, rows, 5)
+	assert.Equal(t, "Yes", rows[0][3])
+	assert.Equal(t, "Forbidden", rows[1][3], "forbidden types are not reported as not synced")
+	assert.Equal(t, "No", rows[2][3])
+	assert.Equal(t, "TOTAL (1 forbidden)", rows[4][0])
+	assert.Equal(t, "1/3", rows[4][3])
+}
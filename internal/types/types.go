@@ -6,6 +6,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/renato0307/k1/internal/k8s"
+	"github.com/renato0307/k1/internal/llm"
 )
 
 // Screen represents a view in the application
@@ -102,6 +103,39 @@ func (f *FilterContext) Description() string {
 		return "filtered by " + kind + ": " + f.Value
 	case "secret":
 		return "filtered by " + kind + ": " + f.Value
+	case "data":
+		return "keys of " + kind + ": " + f.Value
+	case "pod":
+		return "containers of " + kind + ": " + f.Value
+	case "detail":
+		return "details of " + kind + ": " + f.Value
+	case "pulse":
+		return "pulse: " + f.Metadata["title"]
+	case "storageclass":
+		return "filtered by " + kind + ": " + f.Value
+	case "pv":
+		return "filtered by " + kind + ": " + f.Value
+	case "name":
+		return "filtered by " + kind + ": " + f.Value
+	case "subject":
+		return "bindings of " + kind + ": " + f.Value
+	case "role":
+		return "rules of " + kind + ": " + f.Value
+	case "who-can":
+		if namespace := f.Metadata["namespace"]; namespace != "" {
+			return "who can " + f.Metadata["verb"] + " " + f.Value + " in " + namespace
+		}
+		return "who can " + f.Metadata["verb"] + " " + f.Value
+	case "what-can":
+		if namespace := f.Metadata["namespace"]; namespace != "" {
+			return "what " + kind + " " + namespace + "/" + f.Value + " can do"
+		}
+		return "what " + kind + " " + f.Value + " can do"
+	case "tree":
+		if kind == "" {
+			return "tree of " + f.Value
+		}
+		return "tree of " + kind + ": " + f.Value
 	default:
 		return "filtered by " + f.Value
 	}
@@ -114,6 +148,7 @@ type ScreenSwitchMsg struct {
 	CommandBarFilter string         // Optional command bar fuzzy filter to restore
 	IsBackNav        bool           // True if navigating back via ESC
 	PushHistory      bool           // True if should push current screen to history
+	SelectKey        string         // Optional resource to select once listed (namespace/name)
 }
 
 type RefreshCompleteMsg struct {
@@ -140,6 +175,7 @@ type CommandMetadata struct {
 	Namespace      string           // "default"
 	Duration       time.Duration    // Execution time
 	Timestamp      time.Time        // When executed
+	Mutating       bool             // Changes the cluster (recorded in the audit log)
 }
 
 type StatusMsg struct {
@@ -184,9 +220,31 @@ type ClearFilterMsg struct{}
 
 // ShowFullScreenMsg triggers display of full-screen content
 type ShowFullScreenMsg struct {
-	ViewType     int // 0=YAML, 1=Describe, 2=Logs
+	ViewType     int // 0=YAML, 1=Describe, 2=Logs, 3=Data, 4=Diagnosis, 5=Diff, 6=Output
 	ResourceName string
 	Content      string
+	Diff         *DiffContent // Set for Diff view (Content is unused)
+}
+
+// DiffContent holds both sides of a resource diff, cleaned in full and
+// spec-only variants so the full-screen view can toggle between them
+type DiffContent struct {
+	LeftTitle  string
+	RightTitle string
+	Left       string
+	Right      string
+	LeftSpec   string
+	RightSpec  string
+}
+
+// LLMTranslationMsg carries the result of an /ai translation
+type LLMTranslationMsg struct {
+	Prompt      string
+	Translation *llm.Translation // nil on error
+	Analysis    *llm.Analysis    // Safety gate result (nil on error)
+	Run         tea.Cmd          // Runs the vetted command (nil when rejected)
+	Context     string           // Context the command runs against
+	Err         error
 }
 
 // ExitFullScreenMsg returns from full-screen view to list
@@ -223,6 +281,12 @@ type ContextSwitchCompleteMsg struct {
 	NewContext string
 }
 
+// ContextsAggregatedMsg signals that aggregated (all contexts) mode was
+// turned on or off (screens must be re-created to read through the pool)
+type ContextsAggregatedMsg struct {
+	Contexts []string // Merged contexts (empty when aggregated mode is off)
+}
+
 // ContextRetryMsg requests retry of failed context
 type ContextRetryMsg struct {
 	ContextName string
@@ -230,5 +294,20 @@ type ContextRetryMsg struct {
 
 // DynamicScreenCreateMsg requests creation of dynamic screen for CRD instances
 type DynamicScreenCreateMsg struct {
-	CRD any // CustomResourceDefinition instance
+	CRD       any    // CustomResourceDefinition instance
+	SelectKey string // Optional resource to select once listed (namespace/name)
+}
+
+// ExportTableMsg requests the current screen to export its filtered and
+// sorted rows (all columns, including those hidden by the terminal width)
+type ExportTableMsg struct {
+	Format    string // csv, json or md
+	Path      string // Destination file ("" writes to the clipboard)
+	Formatted bool   // Export display strings instead of raw values
+}
+
+// EditConfigMapKeyMsg requests the ConfigMap key browser to open a key in
+// the user's editor (sent by /edit-key once context policies allowed it)
+type EditConfigMapKeyMsg struct {
+	Key string
 }